@@ -0,0 +1,319 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/providers"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+const (
+	providerVaultKV = "vault-kv"
+	providerBundle  = "bundle"
+
+	bundlePassphraseEnv = "KMS_MIGRATOR_BUNDLE_PASSPHRASE"
+	vaultTokenEnv       = "_VAULT_TOKEN"
+	vaultPasswordEnv    = "_VAULT_USERPASS_PASSWORD"
+	awsSecretKeyEnv     = "_AWS_SECRET_KEY"
+
+	permFile os.FileMode = 0o600
+)
+
+// providerFlags holds the configuration of one side of the migration.
+// Secrets are read from environment variables prefixed with SRC or DST.
+type providerFlags struct {
+	prefix           string
+	kind             *string
+	localStoragePath *string
+	vaultAddr        *string
+	vaultMountPath   *string
+	vaultUserPass    *bool
+	awsAccessKey     *string
+	awsRegion        *string
+}
+
+func newProviderFlags(name string, description string) *providerFlags {
+	return &providerFlags{
+		prefix:           strings.ToUpper(name),
+		kind:             flag.String(name, "", fmt.Sprintf("%s key provider: localstorage, vault, vault-kv, aws or bundle", description)),
+		localStoragePath: flag.String(name+"-local-storage-path", "./localstoragekeys", "local storage keys folder"),
+		vaultAddr:        flag.String(name+"-vault-addr", "http://localhost:8200", "vault address"),
+		vaultMountPath:   flag.String(name+"-vault-mount-path", "iden3", "vault iden3 plugin mount path"),
+		vaultUserPass:    flag.Bool(name+"-vault-userpass", false, "use vault userpass auth instead of a token"),
+		awsAccessKey:     flag.String(name+"-aws-access-key", "", "aws kms access key"),
+		awsRegion:        flag.String(name+"-aws-region", "", "aws kms region"),
+	}
+}
+
+var (
+	fSrc         = newProviderFlags("src", "source")
+	fDst         = newProviderFlags("dst", "destination")
+	fDID         = flag.String("did", "", "did to migrate, if this is empty, the keys of all the identities will be migrated")
+	fDatabaseURL = flag.String("database-url", os.Getenv("ISSUER_DATABASE_URL"), "issuer database url, used to list the identities when -did is empty")
+	fBundleFile  = flag.String("bundle-file", "./keys/keys.bundle.json", "encrypted key bundle file")
+	fDryRun      = flag.Bool("dry-run", false, "list the keys that would be migrated without importing them")
+)
+
+// This is a tool to migrate BabyJubJub and Ethereum keys between key providers.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log.Config(log.LevelDebug, log.OutputText, os.Stdout)
+	flag.Parse()
+
+	if err := run(ctx); err != nil {
+		log.Error(ctx, "key migration failed", "err", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	if *fSrc.kind == "" || *fDst.kind == "" {
+		return errors.New("-src and -dst are required")
+	}
+	if *fSrc.kind == providerBundle && *fDst.kind == providerBundle {
+		return errors.New("source and destination cannot be both bundles")
+	}
+
+	keys, err := exportKeys(ctx)
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "keys exported", "keys", len(keys), "source", *fSrc.kind)
+
+	if *fDryRun {
+		for _, key := range keys {
+			log.Info(ctx, "key to migrate", "identity", key.Identity, "type", key.KeyType, "keyID", key.KeyID, "publicKey", key.PublicKey)
+		}
+		return nil
+	}
+
+	if *fDst.kind == providerBundle {
+		return writeBundle(ctx, keys)
+	}
+
+	return importKeys(ctx, keys)
+}
+
+func exportKeys(ctx context.Context) ([]kms.ExportedKey, error) {
+	if *fSrc.kind == providerBundle {
+		keys, err := readBundle()
+		if err != nil || *fDID == "" {
+			return keys, err
+		}
+		filtered := make([]kms.ExportedKey, 0, len(keys))
+		for _, key := range keys {
+			if key.Identity == *fDID {
+				filtered = append(filtered, key)
+			}
+		}
+		return filtered, nil
+	}
+
+	identities, err := identitiesToMigrate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyStore, err := openKeyStore(ctx, fSrc)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []kms.ExportedKey
+	for _, identity := range identities {
+		for _, kp := range keyStore {
+			identityKeys, err := kms.ExportIdentityKeys(ctx, kp, identity)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, identityKeys...)
+		}
+	}
+	return keys, nil
+}
+
+func importKeys(ctx context.Context, keys []kms.ExportedKey) error {
+	keyStore, err := openKeyStore(ctx, fDst)
+	if err != nil {
+		return err
+	}
+
+	imported, skipped := 0, 0
+	for _, key := range keys {
+		kp, ok := keyStore[key.KeyType]
+		if !ok {
+			return fmt.Errorf("destination does not support %s keys", key.KeyType)
+		}
+
+		existing, err := kms.FindImportedKey(ctx, kp, key)
+		if err != nil {
+			log.Warn(ctx, "cannot check if key already exists in destination", "err", err, "keyID", key.KeyID)
+		}
+		if existing != nil {
+			log.Info(ctx, "key already exists in destination, skipping", "identity", key.Identity, "keyID", existing.ID)
+			skipped++
+			continue
+		}
+
+		keyID, err := kms.ImportKey(ctx, kp, key)
+		if err != nil {
+			return err
+		}
+		log.Info(ctx, "key imported and verified", "identity", key.Identity, "type", key.KeyType, "keyID", keyID.ID)
+		imported++
+	}
+
+	log.Info(ctx, "keys migrated", "imported", imported, "skipped", skipped, "destination", *fDst.kind)
+	return nil
+}
+
+func identitiesToMigrate(ctx context.Context) ([]w3c.DID, error) {
+	if *fDID != "" {
+		did, err := w3c.ParseDID(*fDID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid did: %w", err)
+		}
+		return []w3c.DID{*did}, nil
+	}
+
+	if *fDatabaseURL == "" {
+		return nil, errors.New("-database-url or -did is required")
+	}
+	storage, err := db.NewStorage(*fDatabaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to database: %w", err)
+	}
+	defer func(storage *db.Storage) {
+		if err := storage.Close(); err != nil {
+			log.Error(ctx, "error closing database connection", "err", err)
+		}
+	}(storage)
+
+	identities, err := repositories.NewIdentity().Get(ctx, storage.Pgx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get identities: %w", err)
+	}
+
+	dids := make([]w3c.DID, 0, len(identities))
+	for _, identity := range identities {
+		did, err := w3c.ParseDID(identity.Identifier)
+		if err != nil {
+			log.Warn(ctx, "skipping identity with invalid did", "did", identity.Identifier, "err", err)
+			continue
+		}
+		dids = append(dids, *did)
+	}
+	return dids, nil
+}
+
+// openKeyStore returns the BabyJubJub and Ethereum key providers for the configured backend
+func openKeyStore(ctx context.Context, f *providerFlags) (map[kms.KeyType]kms.KeyProvider, error) {
+	switch *f.kind {
+	case config.LocalStorage:
+		filePath := filepath.Join(*f.localStoragePath, kms.LocalStorageFileName)
+		if _, err := os.Stat(filePath); errors.Is(err, os.ErrNotExist) {
+			if err := os.MkdirAll(*f.localStoragePath, os.ModePerm); err != nil {
+				return nil, err
+			}
+			if err := os.WriteFile(filePath, []byte("[]"), permFile); err != nil {
+				return nil, err
+			}
+		}
+		fileManager := kms.NewLocalStorageFileManager(filePath)
+		return map[kms.KeyType]kms.KeyProvider{
+			kms.KeyTypeBabyJubJub: kms.NewLocalStorageBJJKeyProvider(kms.KeyTypeBabyJubJub, fileManager),
+			kms.KeyTypeEthereum:   kms.NewLocalStorageEthKeyProvider(kms.KeyTypeEthereum, fileManager),
+		}, nil
+	case config.Vault, providerVaultKV:
+		vaultCli, err := providers.VaultClient(ctx, providers.Config{
+			Address:             *f.vaultAddr,
+			UserPassAuthEnabled: *f.vaultUserPass,
+			Token:               os.Getenv(f.prefix + vaultTokenEnv),
+			Pass:                os.Getenv(f.prefix + vaultPasswordEnv),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot initialize vault client: %w", err)
+		}
+		if *f.kind == providerVaultKV {
+			return map[kms.KeyType]kms.KeyProvider{
+				kms.KeyTypeBabyJubJub: kms.NewVaultBJJKeyProvider(vaultCli, kms.KeyTypeBabyJubJub),
+				kms.KeyTypeEthereum:   kms.NewVaultEthProvider(vaultCli, kms.KeyTypeEthereum),
+			}, nil
+		}
+		bjjKeyProvider, err := kms.NewVaultPluginIden3KeyProvider(vaultCli, *f.vaultMountPath, kms.KeyTypeBabyJubJub)
+		if err != nil {
+			return nil, err
+		}
+		ethKeyProvider, err := kms.NewVaultPluginIden3KeyProvider(vaultCli, *f.vaultMountPath, kms.KeyTypeEthereum)
+		if err != nil {
+			return nil, err
+		}
+		return map[kms.KeyType]kms.KeyProvider{
+			kms.KeyTypeBabyJubJub: bjjKeyProvider,
+			kms.KeyTypeEthereum:   ethKeyProvider,
+		}, nil
+	case config.AWS:
+		secretKey := os.Getenv(f.prefix + awsSecretKeyEnv)
+		if *f.awsAccessKey == "" || secretKey == "" || *f.awsRegion == "" {
+			return nil, fmt.Errorf("aws access key, %s and aws region have to be provided", f.prefix+awsSecretKeyEnv)
+		}
+		ethKeyProvider, err := kms.NewAwsEthKeyProvider(ctx, kms.KeyTypeEthereum, "", kms.AwEthKeyProviderConfig{
+			AccessKey: *f.awsAccessKey,
+			SecretKey: secretKey,
+			Region:    *f.awsRegion,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return map[kms.KeyType]kms.KeyProvider{kms.KeyTypeEthereum: ethKeyProvider}, nil
+	default:
+		return nil, fmt.Errorf("unknown key provider %q, valid values are %s, %s, %s, %s and %s",
+			*f.kind, config.LocalStorage, config.Vault, providerVaultKV, config.AWS, providerBundle)
+	}
+}
+
+func writeBundle(ctx context.Context, keys []kms.ExportedKey) error {
+	bundle, err := kms.EncryptKeyBundle(keys, os.Getenv(bundlePassphraseEnv))
+	if err != nil {
+		return fmt.Errorf("cannot encrypt key bundle (is %s set?): %w", bundlePassphraseEnv, err)
+	}
+	content, err := json.MarshalIndent(bundle, "", " ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(*fBundleFile), os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.WriteFile(*fBundleFile, content, permFile); err != nil {
+		return err
+	}
+	log.Info(ctx, "encrypted key bundle written", "file", *fBundleFile, "keys", len(keys))
+	return nil
+}
+
+func readBundle() ([]kms.ExportedKey, error) {
+	content, err := os.ReadFile(*fBundleFile)
+	if err != nil {
+		return nil, err
+	}
+	var bundle kms.KeyBundle
+	if err := json.Unmarshal(content, &bundle); err != nil {
+		return nil, fmt.Errorf("cannot parse key bundle: %w", err)
+	}
+	return bundle.Decrypt(os.Getenv(bundlePassphraseEnv))
+}
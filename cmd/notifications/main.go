@@ -7,10 +7,6 @@ import (
 	"os/signal"
 	"syscall"
 
-	"github.com/iden3/iden3comm/v2"
-	"github.com/iden3/iden3comm/v2/packers"
-	"github.com/iden3/iden3comm/v2/protocol"
-
 	"github.com/wakeup-labs/issuer-node/internal/buildinfo"
 	"github.com/wakeup-labs/issuer-node/internal/cache"
 	"github.com/wakeup-labs/issuer-node/internal/config"
@@ -24,7 +20,6 @@ import (
 	"github.com/wakeup-labs/issuer-node/internal/loader"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 	"github.com/wakeup-labs/issuer-node/internal/network"
-	"github.com/wakeup-labs/issuer-node/internal/providers"
 	"github.com/wakeup-labs/issuer-node/internal/pubsub"
 	"github.com/wakeup-labs/issuer-node/internal/repositories"
 	"github.com/wakeup-labs/issuer-node/internal/reversehash"
@@ -67,14 +62,7 @@ func main() {
 	connectionsRepository := repositories.NewConnection()
 	claimsRepository := repositories.NewClaim()
 
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
-		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		TLSEnabled:          cfg.KeyStore.TLSEnabled,
-		CertPath:            cfg.KeyStore.CertPath,
-	}
+	vaultCfg := cfg.KeyStore.VaultConfig()
 
 	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
 	if err != nil {
@@ -144,21 +132,22 @@ func newCredentialsService(ctx context.Context, cfg *config.Configuration, stora
 
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
+	onchainIssuer := gateways.NewOnchainIssuer(*networkResolver, cfg.PublishingKeyPath)
 	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
 
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
 	qrService := services.NewQrStoreService(cachex)
 
-	mediaTypeManager := services.NewMediaTypeManager(
-		map[iden3comm.ProtocolMessage][]string{
-			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			protocol.RevocationStatusRequestMessageType: {"*"},
-		},
-		*cfg.MediaTypeManager.Enabled,
-	)
+	mediaTypePolicy, err := services.LoadMediaTypePolicy(ctx, cfg.MediaTypeManager.PolicyPath)
+	if err != nil {
+		log.Error(ctx, "cannot load the media type policy", "err", err)
+		return nil, err
+	}
+	mediaTypeManager := services.NewMediaTypeManagerWithPolicy(mediaTypePolicy, *cfg.MediaTypeManager.Enabled)
 
 	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, ps, *networkResolver, rhsFactory, revocationStatusResolver)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	signingPolicy := services.NewSigningPolicy(cfg.SigningPolicy, claimsRepository, repositories.NewApproval(), storage)
+	claimsService := services.NewClaim(claimsRepository, repositories.NewLink(*storage), identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, onchainIssuer, mediaTypeManager, signingPolicy, cfg.UniversalLinks)
 
 	return claimsService, nil
 }
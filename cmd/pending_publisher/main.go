@@ -8,10 +8,6 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/iden3/iden3comm/v2"
-	"github.com/iden3/iden3comm/v2/packers"
-	"github.com/iden3/iden3comm/v2/protocol"
-
 	"github.com/wakeup-labs/issuer-node/internal/buildinfo"
 	"github.com/wakeup-labs/issuer-node/internal/cache"
 	"github.com/wakeup-labs/issuer-node/internal/config"
@@ -22,7 +18,6 @@ import (
 	"github.com/wakeup-labs/issuer-node/internal/loader"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 	"github.com/wakeup-labs/issuer-node/internal/network"
-	"github.com/wakeup-labs/issuer-node/internal/providers"
 	"github.com/wakeup-labs/issuer-node/internal/pubsub"
 	"github.com/wakeup-labs/issuer-node/internal/repositories"
 	"github.com/wakeup-labs/issuer-node/internal/reversehash"
@@ -73,14 +68,7 @@ func main() {
 	// TODO: Cache only if cfg.APIUI.SchemaCache == true
 	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
 
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
-		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		TLSEnabled:          cfg.KeyStore.TLSEnabled,
-		CertPath:            cfg.KeyStore.CertPath,
-	}
+	vaultCfg := cfg.KeyStore.VaultConfig()
 
 	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
 	if err != nil {
@@ -111,17 +99,18 @@ func main() {
 
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
+	onchainIssuer := gateways.NewOnchainIssuer(*networkResolver, cfg.PublishingKeyPath)
 
-	mediaTypeManager := services.NewMediaTypeManager(
-		map[iden3comm.ProtocolMessage][]string{
-			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			protocol.RevocationStatusRequestMessageType: {"*"},
-		},
-		*cfg.MediaTypeManager.Enabled,
-	)
+	mediaTypePolicy, err := services.LoadMediaTypePolicy(ctx, cfg.MediaTypeManager.PolicyPath)
+	if err != nil {
+		log.Error(ctx, "cannot load the media type policy", "err", err)
+		return
+	}
+	mediaTypeManager := services.NewMediaTypeManagerWithPolicy(mediaTypePolicy, *cfg.MediaTypeManager.Enabled)
 
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver)
-	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	signingPolicy := services.NewSigningPolicy(cfg.SigningPolicy, claimsRepo, repositories.NewApproval(), storage)
+	claimsService := services.NewClaim(claimsRepo, repositories.NewLink(*storage), identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, onchainIssuer, mediaTypeManager, signingPolicy, cfg.UniversalLinks)
 
 	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
 	proofService := initProofService(circuitsLoaderService)
@@ -131,12 +120,12 @@ func main() {
 		log.Error(ctx, "error creating transaction service", "err", err)
 		panic("error creating transaction service")
 	}
-	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
+	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath, ps)
 	if err != nil {
 		log.Error(ctx, "error creating publish gateway", "err", err)
 		panic("error creating publish gateway")
 	}
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps)
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps, signingPolicy)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
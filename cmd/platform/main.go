@@ -12,10 +12,6 @@ import (
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	auth "github.com/iden3/go-iden3-auth/v2"
-	authLoaders "github.com/iden3/go-iden3-auth/v2/loaders"
-	"github.com/iden3/iden3comm/v2"
-	"github.com/iden3/iden3comm/v2/packers"
-	iden3commProtocol "github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/wakeup-labs/issuer-node/internal/api"
 	"github.com/wakeup-labs/issuer-node/internal/buildinfo"
@@ -26,11 +22,12 @@ import (
 	"github.com/wakeup-labs/issuer-node/internal/errors"
 	"github.com/wakeup-labs/issuer-node/internal/gateways"
 	"github.com/wakeup-labs/issuer-node/internal/health"
+	httpPkg "github.com/wakeup-labs/issuer-node/internal/http"
 	"github.com/wakeup-labs/issuer-node/internal/loader"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 	"github.com/wakeup-labs/issuer-node/internal/network"
 	"github.com/wakeup-labs/issuer-node/internal/packagemanager"
-	"github.com/wakeup-labs/issuer-node/internal/providers"
+	"github.com/wakeup-labs/issuer-node/internal/payments"
 	"github.com/wakeup-labs/issuer-node/internal/pubsub"
 	"github.com/wakeup-labs/issuer-node/internal/repositories"
 	"github.com/wakeup-labs/issuer-node/internal/reversehash"
@@ -73,14 +70,7 @@ func main() {
 	// TODO: Cache only if cfg.APIUI.SchemaCache == true
 	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
 
-	vaultCfg := providers.Config{
-		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
-		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
-		Address:             cfg.KeyStore.Address,
-		Token:               cfg.KeyStore.Token,
-		TLSEnabled:          cfg.KeyStore.TLSEnabled,
-		CertPath:            cfg.KeyStore.CertPath,
-	}
+	vaultCfg := cfg.KeyStore.VaultConfig()
 
 	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
 	if err != nil {
@@ -118,19 +108,24 @@ func main() {
 	schemaRepository := repositories.NewSchema(*storage)
 	linkRepository := repositories.NewLink(*storage)
 	sessionRepository := repositories.NewSessionCached(cachex)
+	approvalRepository := repositories.NewApproval()
+	proposalRuleRepository := repositories.NewProposalRule()
+	paymentRepository := repositories.NewPayment()
+	agentMessageRepository := repositories.NewAgentMessage()
+	connectionMessageRepository := repositories.NewConnectionMessage()
 
 	// services initialization
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
 	qrService := services.NewQrStoreService(cachex)
 	connectionsService := services.NewConnection(connectionsRepository, claimsRepository, storage)
+	signingPolicy := services.NewSigningPolicy(cfg.SigningPolicy, claimsRepository, approvalRepository, storage)
 
-	mediaTypeManager := services.NewMediaTypeManager(
-		map[iden3comm.ProtocolMessage][]string{
-			iden3commProtocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			iden3commProtocol.RevocationStatusRequestMessageType: {"*"},
-		},
-		*cfg.MediaTypeManager.Enabled,
-	)
+	mediaTypePolicy, err := services.LoadMediaTypePolicy(ctx, cfg.MediaTypeManager.PolicyPath)
+	if err != nil {
+		log.Error(ctx, "cannot load the media type policy", "err", err)
+		return
+	}
+	mediaTypeManager := services.NewMediaTypeManagerWithPolicy(mediaTypePolicy, *cfg.MediaTypeManager.Enabled)
 
 	universalDIDResolverUrl := auth.UniversalResolverURL
 	if cfg.UniversalDIDResolver.UniversalResolverURL != nil && *cfg.UniversalDIDResolver.UniversalResolverURL != "" {
@@ -144,7 +139,7 @@ func main() {
 		return
 	}
 
-	verificationKeyLoader := &authLoaders.FSKeyLoader{Dir: cfg.Circuit.Path + "/authV2"}
+	verificationKeyLoader := circuitLoaders.NewVerificationKeys(cfg.Circuit.Path)
 	verifier, err := auth.NewVerifier(verificationKeyLoader, networkResolver.GetStateResolvers(), auth.WithDIDResolver(universalDIDResolverHandler))
 	if err != nil {
 		log.Error(ctx, "failed init verifier", "err", err)
@@ -152,11 +147,12 @@ func main() {
 	}
 
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
+	onchainIssuer := gateways.NewOnchainIssuer(*networkResolver, cfg.PublishingKeyPath)
 	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionsRepository, storage, verifier, sessionRepository, ps, *networkResolver, rhsFactory, revocationStatusResolver)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	claimsService := services.NewClaim(claimsRepository, linkRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, onchainIssuer, mediaTypeManager, signingPolicy, cfg.UniversalLinks)
 	proofService := services.NewProver(circuitsLoaderService)
 	schemaService := services.NewSchema(schemaRepository, schemaLoader)
-	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepository, linkRepository, schemaRepository, schemaLoader, sessionRepository, ps, identityService, *networkResolver, cfg.UniversalLinks)
+	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepository, linkRepository, schemaRepository, paymentRepository, schemaLoader, sessionRepository, ps, identityService, *networkResolver, cfg.UniversalLinks)
 
 	transactionService, err := gateways.NewTransaction(*networkResolver)
 	if err != nil {
@@ -165,13 +161,21 @@ func main() {
 	}
 	accountService := services.NewAccountService(*networkResolver)
 
-	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
+	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath, ps)
 	if err != nil {
 		log.Error(ctx, "error creating publish gateway", "err", err)
 		return
 	}
 
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps)
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps, signingPolicy)
+	approvalService := services.NewApproval(approvalRepository, signingPolicy, claimsService, publisher, storage, cfg.HTTPBasicAuth, cfg.SigningPolicy)
+	agentPacker := services.NewAgentPacker(keyStore, identityService, claimsService, universalDIDResolverHandler, cfg.Agent.PackedResponses)
+	proposalService := services.NewProposal(proposalRuleRepository, schemaRepository, linkService, identityService, mediaTypeManager, storage, cfg.ServerUrl)
+	paymentService := services.NewPayment(paymentRepository, schemaRepository, linkService, identityService, mediaTypeManager, payments.NewVerifier(*networkResolver), *networkResolver, storage, cfg.ServerUrl)
+	agentMessageService := services.NewAgentMessage(agentMessageRepository, storage, cfg.Agent)
+	notificationGateway := gateways.NewPushNotificationClient(httpPkg.DefaultHTTPClientWithRetry)
+	connectionMessageService := services.NewConnectionMessage(connectionMessageRepository, connectionsService, claimsService, agentMessageService, notificationGateway, identityService, mediaTypeManager, verifier, storage, cfg.ServerUrl)
+	issuanceRequestService := services.NewIssuanceRequest(claimsService, identityService, connectionsService, services.NewIssuanceApprover(cfg.IssuanceRequests), mediaTypeManager, cfg.ServerUrl)
 
 	serverHealth := health.New(health.Monitors{
 		"postgres": storage.Ping,
@@ -199,8 +203,8 @@ func main() {
 	)
 	api.HandlerWithOptions(
 		api.NewStrictHandlerWithOptions(
-			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publisher, packageManager, *networkResolver, serverHealth, schemaService, linkService),
-			middlewares(ctx, cfg.HTTPBasicAuth),
+			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publisher, packageManager, *networkResolver, serverHealth, schemaService, linkService, signingPolicy, approvalService, agentPacker, proposalService, issuanceRequestService, paymentService, agentMessageService, connectionMessageService, mediaTypeManager),
+			middlewares(ctx, cfg.HTTPBasicAuth, cfg.SigningPolicy),
 			api.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -229,9 +233,10 @@ func main() {
 	log.Info(ctx, "Shutting down")
 }
 
-func middlewares(ctx context.Context, auth config.HTTPBasicAuth) []api.StrictMiddlewareFunc {
+func middlewares(ctx context.Context, auth config.HTTPBasicAuth, signingPolicy config.SigningPolicy) []api.StrictMiddlewareFunc {
 	return []api.StrictMiddlewareFunc{
 		api.LogMiddleware(ctx),
 		api.BasicAuthMiddleware(ctx, auth.User, auth.Password),
+		api.ApproverAuthMiddleware(ctx, signingPolicy.ApproverUser, signingPolicy.ApproverPassword),
 	}
 }
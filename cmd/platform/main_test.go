@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wakeup-labs/issuer-node/internal/api"
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/errors"
+)
+
+// authServer answers the endpoints used by the test, any other endpoint panics
+type authServer struct {
+	api.StrictServerInterface
+}
+
+func (s *authServer) GetIdentities(_ context.Context, _ api.GetIdentitiesRequestObject) (api.GetIdentitiesResponseObject, error) {
+	return api.GetIdentities200JSONResponse{}, nil
+}
+
+func (s *authServer) GetApprovalRequests(_ context.Context, _ api.GetApprovalRequestsRequestObject) (api.GetApprovalRequestsResponseObject, error) {
+	return api.GetApprovalRequests200JSONResponse{}, nil
+}
+
+func TestMiddlewares(t *testing.T) {
+	ctx := context.Background()
+	auth := config.HTTPBasicAuth{User: "user", Password: "password"}
+	signingPolicy := config.SigningPolicy{ApproverUser: "approver", ApproverPassword: "approverPassword"}
+
+	mux := chi.NewRouter()
+	handler := api.HandlerWithOptions(
+		api.NewStrictHandlerWithOptions(
+			&authServer{},
+			middlewares(ctx, auth, signingPolicy),
+			api.StrictHTTPServerOptions{
+				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
+				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
+			}),
+		api.ChiServerOptions{
+			BaseRouter:       mux,
+			ErrorHandlerFunc: api.ErrorHandlerFunc,
+		})
+
+	const (
+		identities = "/v2/identities"
+		approvals  = "/v2/identities/did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR/approvals"
+	)
+
+	for _, tc := range []struct {
+		name     string
+		url      string
+		user     string
+		password string
+		expected int
+	}{
+		{name: "basic auth endpoint without credentials", url: identities, expected: http.StatusUnauthorized},
+		{name: "basic auth endpoint with wrong credentials", url: identities, user: "user", password: "wrong", expected: http.StatusUnauthorized},
+		{name: "basic auth endpoint with the approver credentials", url: identities, user: "approver", password: "approverPassword", expected: http.StatusUnauthorized},
+		{name: "basic auth endpoint with credentials", url: identities, user: "user", password: "password", expected: http.StatusOK},
+		{name: "approver endpoint without credentials", url: approvals, expected: http.StatusUnauthorized},
+		{name: "approver endpoint with the basic auth credentials", url: approvals, user: "user", password: "password", expected: http.StatusUnauthorized},
+		{name: "approver endpoint with the approver credentials", url: approvals, user: "approver", password: "approverPassword", expected: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			if tc.user != "" {
+				req.SetBasicAuth(tc.user, tc.password)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.expected, rr.Code)
+		})
+	}
+}
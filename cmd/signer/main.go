@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/wakeup-labs/issuer-node/internal/buildinfo"
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+var build = buildinfo.Revision()
+
+const (
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 10 * time.Second
+)
+
+// The signer wraps the configured key providers and exposes them to the issuer node
+// `remote` key provider, so the API pods never have key material or Vault tokens.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log.Info(ctx, "starting signer...", "revision", build)
+
+	cfg, err := config.LoadSigner()
+	if err != nil {
+		log.Error(ctx, "cannot load config", "err", err)
+		return
+	}
+	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+
+	vaultCfg := cfg.KeyStore.VaultConfig()
+
+	keyStore, err := config.KeyStoreConfig(ctx, &config.Configuration{KeyStore: cfg.KeyStore, Ethereum: cfg.Ethereum}, vaultCfg)
+	if err != nil {
+		log.Error(ctx, "cannot initialize key store", "err", err)
+		return
+	}
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.ServerPort),
+		Handler:           kms.NewRemoteSignerHandler(keyStore),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	if !cfg.InsecureHTTP {
+		clientCAs, err := kms.LoadCertPool(cfg.ClientCACertPath)
+		if err != nil {
+			log.Error(ctx, "cannot load client CA certificate", "err", err)
+			return
+		}
+		server.TLSConfig = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		log.Info(ctx, "signer started", "port", cfg.ServerPort, "mtls", !cfg.InsecureHTTP)
+		var err error
+		if cfg.InsecureHTTP {
+			err = server.ListenAndServe()
+		} else {
+			err = server.ListenAndServeTLS(cfg.TLSCertPath, cfg.TLSKeyPath)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Error(ctx, "starting signer server", "err", err)
+		}
+	}()
+
+	<-quit
+	log.Info(ctx, "Shutting down")
+	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, shutdownTimeout)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error(ctx, "error shutting down signer server", "err", err)
+	}
+}
@@ -1,9 +1,21 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"strings"
 
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 )
 
@@ -11,25 +23,64 @@ import (
 func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error) {
 	if request.Body == nil || *request.Body == "" {
 		log.Debug(ctx, "agent empty request")
-		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with an empty request"}}, nil
+		return Agent400JSONResponse{problemReport(errors.New("cannot proceed with an empty request"))}, nil
 	}
 
 	basicMessage, mediatype, err := s.packageManager.Unpack([]byte(*request.Body))
 	if err != nil {
 		log.Debug(ctx, "agent bad request", "err", err, "body", *request.Body)
-		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with the given request"}}, nil
+		return Agent400JSONResponse{problemReport(errors.New("cannot proceed with the given request"))}, nil
 	}
 
+	threadID := basicMessage.ThreadID
+	if threadID == "" {
+		threadID = basicMessage.ID
+	}
 	req, err := ports.NewAgentRequest(basicMessage)
 	if err != nil {
 		log.Error(ctx, "agent parsing request", "err", err)
-		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		return Agent400JSONResponse{problemReport(&services.ProblemReportError{Err: err, ThreadID: threadID, From: basicMessage.To, To: basicMessage.From})}, nil
 	}
 
-	agent, err := s.claimService.Agent(ctx, req, mediatype)
+	if err := s.agentMessageService.Receive(ctx, []byte(*request.Body), basicMessage, mediatype); err != nil {
+		log.Error(ctx, "agent receiving message", "err", err)
+		return Agent400JSONResponse{s.agentProblemReport(ctx, &services.ProblemReportError{Err: err, ThreadID: threadID, From: basicMessage.To, To: basicMessage.From})}, nil
+	}
+
+	var agent *domain.Agent
+	switch req.Type {
+	case protocol.CredentialProposalRequestMessageType:
+		agent, err = s.proposalService.Agent(ctx, req, mediatype)
+	case protocol.CredentialIssuanceRequestMessageType:
+		agent, err = s.issuanceRequests.Agent(ctx, req, mediatype)
+	case protocol.CredentialPaymentMessageType:
+		agent, err = s.paymentService.Agent(ctx, req, mediatype)
+	case protocol.ProofGenerationResponseMessageType:
+		agent, err = s.connectionMessageService.Agent(ctx, req, mediatype)
+	default:
+		agent, err = s.claimService.Agent(ctx, req, mediatype)
+	}
 	if err != nil {
 		log.Error(ctx, "agent error", "err", err)
-		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		_ = s.agentMessageService.Discard(ctx, basicMessage)
+		return Agent400JSONResponse{s.agentProblemReport(ctx, err)}, nil
+	}
+	if agent == nil {
+		return Agent202Response{}, nil
+	}
+
+	envelope, responseMediaType, err := s.agentPacker.Pack(ctx, agent, mediatype, acceptedMediaTypes(request.Params.Accept))
+	if err != nil {
+		log.Error(ctx, "agent packing response", "err", err)
+		_ = s.agentMessageService.Discard(ctx, basicMessage)
+		return Agent500JSONResponse{N500JSONResponse{"cannot pack the response"}}, nil
+	}
+	s.saveAgentResponse(ctx, req, threadID, agent.ID, agent.Type, responseMediaType, agent.Body)
+	switch responseMediaType {
+	case packers.MediaTypeSignedMessage:
+		return Agent200Applicationiden3commSignedJsonResponse{Body: bytes.NewReader(envelope), ContentLength: int64(len(envelope))}, nil
+	case packers.MediaTypeEncryptedMessage:
+		return Agent200Applicationiden3commEncryptedJsonResponse{Body: bytes.NewReader(envelope), ContentLength: int64(len(envelope))}, nil
 	}
 	return Agent200JSONResponse{
 		Body:     agent.Body,
@@ -61,11 +112,26 @@ func (s *Server) AgentV1(ctx context.Context, request AgentV1RequestObject) (Age
 		return AgentV1400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
 
+	if req.Type == domain.AckMessageType {
+		return AgentV1400JSONResponse{N400JSONResponse{"ack messages are only accepted by /v2/agent"}}, nil
+	}
+
+	if err := s.agentMessageService.Receive(ctx, []byte(*request.Body), basicMessage, mediatype); err != nil {
+		log.Error(ctx, "agent receiving message", "err", err)
+		return AgentV1400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
+
 	agent, err := s.claimService.Agent(ctx, req, mediatype)
 	if err != nil {
 		log.Error(ctx, "agent error", "err", err)
+		_ = s.agentMessageService.Discard(ctx, basicMessage)
 		return AgentV1400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
+	threadID := basicMessage.ThreadID
+	if threadID == "" {
+		threadID = basicMessage.ID
+	}
+	s.saveAgentResponse(ctx, req, threadID, agent.ID, agent.Type, packers.MediaTypePlainMessage, agent.Body)
 	return AgentV1200JSONResponse{
 		Body:     agent.Body,
 		From:     agent.From,
@@ -76,3 +142,71 @@ func (s *Server) AgentV1(ctx context.Context, request AgentV1RequestObject) (Age
 		Type:     string(agent.Type),
 	}, nil
 }
+
+// problemReport returns the problem-report message for the agent error
+func problemReport(err error) N400ProblemReportJSONResponse {
+	report := services.NewProblemReport(err)
+	response := N400ProblemReportJSONResponse{
+		Id:    report.ID,
+		Typ:   string(report.Typ),
+		Type:  string(report.Type),
+		Thid:  common.ToPointer(report.ThreadID),
+		Pthid: report.ParentThreadID,
+		Body: ProblemReportBody{
+			Code:    string(report.Body.Code),
+			Comment: common.ToPointer(report.Body.Comment),
+		},
+	}
+	if report.From != "" {
+		response.From = common.ToPointer(report.From)
+	}
+	if report.To != "" {
+		response.To = common.ToPointer(report.To)
+	}
+	return response
+}
+
+// agentProblemReport returns the problem-report message for the agent error and saves it in the thread of the failing message
+func (s *Server) agentProblemReport(ctx context.Context, err error) N400ProblemReportJSONResponse {
+	response := problemReport(err)
+	var problem *services.ProblemReportError
+	if errors.As(err, &problem) {
+		issuerDID, parseErr := w3c.ParseDID(problem.From)
+		if parseErr != nil {
+			return response
+		}
+		holderDID, parseErr := w3c.ParseDID(problem.To)
+		if parseErr != nil {
+			return response
+		}
+		req := &ports.AgentRequest{IssuerDID: issuerDID, UserDID: holderDID}
+		s.saveAgentResponse(ctx, req, problem.ThreadID, response.Id, protocol.ProblemReportMessageType, packers.MediaTypePlainMessage, response.Body)
+	}
+	return response
+}
+
+// saveAgentResponse saves the response in the thread of the request. Responses without thread start their own. Failures are only logged, the holder gets the response anyway.
+func (s *Server) saveAgentResponse(ctx context.Context, req *ports.AgentRequest, threadID string, id string, messageType iden3comm.ProtocolMessage, mediaType iden3comm.MediaType, body any) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		log.Error(ctx, "marshalling agent response", "err", err, "id", id)
+		return
+	}
+	message := domain.NewAgentMessage(domain.AgentMessageOutbound, id, threadID, *req.IssuerDID, req.UserDID.String(), messageType, mediaType, raw)
+	_ = s.agentMessageService.Send(ctx, message)
+}
+
+// acceptedMediaTypes returns the media types of the Accept header in order of preference
+func acceptedMediaTypes(accept *string) []iden3comm.MediaType {
+	if accept == nil {
+		return nil
+	}
+	var mediaTypes []iden3comm.MediaType
+	for _, value := range strings.Split(*accept, ",") {
+		mediaType, _, _ := strings.Cut(value, ";")
+		if mediaType = strings.TrimSpace(mediaType); mediaType != "" {
+			mediaTypes = append(mediaTypes, iden3comm.MediaType(mediaType))
+		}
+	}
+	return mediaTypes
+}
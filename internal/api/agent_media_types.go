@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// GetAgentMediaTypes returns the media types accepted by the agent of the identity for each message type
+func (s *Server) GetAgentMediaTypes(_ context.Context, request GetAgentMediaTypesRequestObject) (GetAgentMediaTypesResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetAgentMediaTypes400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	policy := s.mediatypeManager.Policy()
+	messageTypes := make([]AgentMessageMediaTypes, 0)
+	for message, mediaTypes := range policy.AllowList(did) {
+		messageTypes = append(messageTypes, AgentMessageMediaTypes{
+			MessageType: string(message),
+			MediaTypes:  mediaTypes,
+			Overridden:  policy.IsOverridden(did, message),
+		})
+	}
+	sort.Slice(messageTypes, func(i, j int) bool { return messageTypes[i].MessageType < messageTypes[j].MessageType })
+
+	return GetAgentMediaTypes200JSONResponse{
+		Enabled:      s.mediatypeManager.Enabled(),
+		MessageTypes: messageTypes,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_GetAgentMediaTypes(t *testing.T) {
+	const did = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		did      string
+		httpCode int
+	}
+	for _, tc := range []testConfig{
+		{name: "No auth header", auth: authWrong, did: did, httpCode: http.StatusUnauthorized},
+		{name: "Invalid did", auth: authOk, did: "did:wrong", httpCode: http.StatusBadRequest},
+		{name: "Media types", auth: authOk, did: did, httpCode: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/agent/media-types", tc.did), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+			if tc.httpCode != http.StatusOK {
+				return
+			}
+
+			var response AgentMediaTypes
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.True(t, response.Enabled)
+			mediaTypes := make(map[string][]string)
+			for _, messageType := range response.MessageTypes {
+				assert.False(t, messageType.Overridden)
+				mediaTypes[messageType.MessageType] = messageType.MediaTypes
+			}
+			assert.Equal(t, []string{string(packers.MediaTypeZKPMessage)}, mediaTypes[string(protocol.CredentialFetchRequestMessageType)])
+			assert.Equal(t, []string{"*"}, mediaTypes[string(protocol.RevocationStatusRequestMessageType)])
+		})
+	}
+}
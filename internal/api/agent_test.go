@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+// agentMessagesMock keeps the agent messages in memory, rejecting the duplicated ones like the agent_messages table
+type agentMessagesMock struct {
+	ports.AgentMessageRepository
+	messages map[string]*domain.AgentMessage
+}
+
+func (r *agentMessagesMock) key(issuerDID w3c.DID, direction domain.AgentMessageDirection, messageID string) string {
+	return issuerDID.String() + "|" + string(direction) + "|" + messageID
+}
+
+func (r *agentMessagesMock) Save(_ context.Context, _ db.Querier, message *domain.AgentMessage) error {
+	key := r.key(message.IssuerDID, message.Direction, message.MessageID)
+	if _, ok := r.messages[key]; ok {
+		return repositories.ErrAgentMessageDuplicated
+	}
+	r.messages[key] = message
+	return nil
+}
+
+func (r *agentMessagesMock) Delete(_ context.Context, _ db.Querier, issuerDID w3c.DID, direction domain.AgentMessageDirection, messageID string) error {
+	delete(r.messages, r.key(issuerDID, direction, messageID))
+	return nil
+}
+
+// agentClaimsMock answers the revocation status requests with an empty body
+type agentClaimsMock struct {
+	ports.ClaimService
+}
+
+func (c *agentClaimsMock) Agent(_ context.Context, req *ports.AgentRequest, _ iden3comm.MediaType) (*domain.Agent, error) {
+	return &domain.Agent{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.RevocationStatusResponseMessageType,
+		ThreadID: req.ThreadID,
+		From:     req.IssuerDID.String(),
+		To:       req.UserDID.String(),
+	}, nil
+}
+
+// agentPackerMock fails to pack the responses while failing is set
+type agentPackerMock struct {
+	failing bool
+}
+
+func (p *agentPackerMock) Pack(_ context.Context, _ *domain.Agent, _ iden3comm.MediaType, _ []iden3comm.MediaType) ([]byte, iden3comm.MediaType, error) {
+	if p.failing {
+		return nil, "", errors.New("packing failed")
+	}
+	return []byte("{}"), packers.MediaTypePlainMessage, nil
+}
+
+func TestServer_Agent_Retry(t *testing.T) {
+	ctx := context.Background()
+	packageManager := iden3comm.NewPackageManager()
+	require.NoError(t, packageManager.RegisterPackers(&packers.PlainMessagePacker{}))
+	repository := &agentMessagesMock{messages: make(map[string]*domain.AgentMessage)}
+	packer := &agentPackerMock{failing: true}
+	server := &Server{
+		packageManager:      packageManager,
+		agentMessageService: services.NewAgentMessage(repository, &db.Storage{}, cfg.Agent),
+		claimService:        &agentClaimsMock{},
+		agentPacker:         packer,
+	}
+
+	body := `{
+		"id": "` + uuid.NewString() + `",
+		"typ": "application/iden3comm-plain-json",
+		"type": "https://iden3-communication.io/revocation/1.0/request-status",
+		"body": {"revocation_nonce": 0},
+		"from": "did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq",
+		"to": "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+	}`
+
+	response, err := server.Agent(ctx, AgentRequestObject{Body: &body})
+	require.NoError(t, err)
+	assert.IsType(t, Agent500JSONResponse{}, response)
+	assert.Empty(t, repository.messages)
+
+	// the holder sends the same message again once the response can be packed
+	packer.failing = false
+	response, err = server.Agent(ctx, AgentRequestObject{Body: &body})
+	require.NoError(t, err)
+	assert.IsType(t, Agent200JSONResponse{}, response)
+	assert.Len(t, repository.messages, 2)
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+// GetAgentThread returns the messages of an agent thread of the identity
+func (s *Server) GetAgentThread(ctx context.Context, request GetAgentThreadRequestObject) (GetAgentThreadResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetAgentThread400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	messages, err := s.agentMessageService.GetThread(ctx, *did, request.ThreadID)
+	if err != nil {
+		log.Error(ctx, "getting agent thread", "err", err, "did", did.String(), "threadID", request.ThreadID)
+		return GetAgentThread500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	if len(messages) == 0 {
+		return GetAgentThread404JSONResponse{N404JSONResponse{Message: "thread not found"}}, nil
+	}
+	return GetAgentThread200JSONResponse(toAgentMessagesResponse(messages)), nil
+}
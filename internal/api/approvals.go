@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+// GetApprovalRequests returns the approval requests of the identity
+func (s *Server) GetApprovalRequests(ctx context.Context, request GetApprovalRequestsRequestObject) (GetApprovalRequestsResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetApprovalRequests400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	var status *domain.ApprovalStatus
+	if request.Params.Status != nil {
+		status = (*domain.ApprovalStatus)(request.Params.Status)
+	}
+
+	requests, err := s.approvalService.GetAll(ctx, *did, status)
+	if err != nil {
+		log.Error(ctx, "getting approval requests", "err", err, "did", did.String())
+		return GetApprovalRequests500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetApprovalRequests200JSONResponse(toApprovalRequestsResponse(requests)), nil
+}
+
+// GetApprovalRequest returns an approval request
+func (s *Server) GetApprovalRequest(ctx context.Context, request GetApprovalRequestRequestObject) (GetApprovalRequestResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetApprovalRequest400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	approval, err := s.approvalService.GetByID(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrApprovalRequestNotFound) {
+			return GetApprovalRequest404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting approval request", "err", err, "id", request.Id)
+		return GetApprovalRequest500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetApprovalRequest200JSONResponse(toApprovalRequestResponse(approval)), nil
+}
+
+// ApproveRequest approves a pending request and executes the operation
+func (s *Server) ApproveRequest(ctx context.Context, request ApproveRequestRequestObject) (ApproveRequestResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return ApproveRequest400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	approval, err := s.approvalService.Approve(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrApprovalRequestNotFound) {
+			return ApproveRequest404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		errs := []error{
+			repositories.ErrApprovalRequestNotPending,
+			services.ErrDailyIssuanceLimitReached,
+			services.ErrOutsidePublishWindow,
+			services.ErrApprovalOperationMismatch,
+		}
+		for _, e := range errs {
+			if errors.Is(err, e) {
+				return ApproveRequest400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+		}
+		log.Error(ctx, "approving request", "err", err, "id", request.Id)
+		return ApproveRequest500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return ApproveRequest200JSONResponse(toApprovalRequestResponse(approval)), nil
+}
+
+// RejectRequest rejects a pending request
+func (s *Server) RejectRequest(ctx context.Context, request RejectRequestRequestObject) (RejectRequestResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return RejectRequest400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	approval, err := s.approvalService.Reject(ctx, *did, request.Id, request.Body.Reason)
+	if err != nil {
+		if errors.Is(err, repositories.ErrApprovalRequestNotFound) {
+			return RejectRequest404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, repositories.ErrApprovalRequestNotPending) {
+			return RejectRequest400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "rejecting request", "err", err, "id", request.Id)
+		return RejectRequest500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return RejectRequest200JSONResponse(toApprovalRequestResponse(approval)), nil
+}
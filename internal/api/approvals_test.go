@@ -0,0 +1,130 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
+	"github.com/wakeup-labs/issuer-node/internal/db/tests"
+)
+
+func TestServer_ApprovalRequests(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		schema     = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	)
+	ctx := context.Background()
+
+	server := newTestServer(t, nil)
+	approverUser, _ := authApprover()
+	policyCfg := config.SigningPolicy{ApprovalSchemas: []string{schema}, ApproverUser: approverUser}
+	server.signingPolicy = services.NewSigningPolicy(policyCfg, server.Repos.claims, server.Repos.approvals, server.Infra.db)
+	server.approvalService = services.NewApproval(server.Repos.approvals, server.signingPolicy, server.Services.credentials, server.publisherGateway, server.Infra.db, config.HTTPBasicAuth{User: "user"}, policyCfg)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did := iden.Identifier
+
+	createCredential := func(t *testing.T) ApprovalRequest {
+		t.Helper()
+		body := CreateCredentialRequest{
+			CredentialSchema: schema,
+			Type:             "KYCAgeCredential",
+			CredentialSubject: map[string]any{
+				"id":           "did:opid:optimism:sepolia:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+				"birthday":     19960424,
+				"documentType": 2,
+			},
+			Expiration: common.ToPointer(int64(1903357766)),
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var response ApprovalRequest
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+
+	review := func(t *testing.T, id string, action string, auth func() (string, string)) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/approvals/%s/%s", did, id, action), bytes.NewBufferString(`{"reason":"wrong data"}`))
+		require.NoError(t, err)
+		req.SetBasicAuth(auth())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("credentials of the schema are queued", func(t *testing.T) {
+		approval := createCredential(t)
+		assert.Equal(t, CredentialIssuance, approval.Operation)
+		assert.Equal(t, ApprovalRequestStatusPending, approval.Status)
+		assert.Equal(t, "user", approval.RequestedBy)
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/approvals?status=pending", did), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authApprover())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetApprovalRequests200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		ids := make([]string, len(response))
+		for i := range response {
+			ids[i] = response[i].Id.String()
+		}
+		assert.Contains(t, ids, approval.Id.String())
+	})
+
+	t.Run("the api user cannot approve", func(t *testing.T) {
+		approval := createCredential(t)
+		rr := review(t, approval.Id.String(), "approve", authOk)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("approve issues the credential", func(t *testing.T) {
+		approval := createCredential(t)
+		rr := review(t, approval.Id.String(), "approve", authApprover)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response ApproveRequest200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, ApprovalRequestStatusApproved, response.Status)
+		assert.Equal(t, approverUser, *response.ReviewedBy)
+		require.NotNil(t, response.ResultId)
+
+		rr = review(t, approval.Id.String(), "approve", authApprover)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("reject never issues the credential", func(t *testing.T) {
+		approval := createCredential(t)
+		rr := review(t, approval.Id.String(), "reject", authApprover)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response RejectRequest200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, ApprovalRequestStatusRejected, response.Status)
+		assert.Equal(t, "wrong data", *response.Reason)
+		assert.Nil(t, response.ResultId)
+
+		rr = review(t, approval.Id.String(), "approve", authApprover)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	jsonSuite "github.com/iden3/go-schema-processor/v2/json"
 	"github.com/iden3/go-schema-processor/verifiable"
 
+	"github.com/wakeup-labs/issuer-node/internal/common"
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
 	"github.com/wakeup-labs/issuer-node/internal/core/services"
@@ -98,6 +100,16 @@ func (s *Server) CreateConnection(ctx context.Context, request CreateConnectionR
 		CreatedAt:  time.Now(),
 		ModifiedAt: time.Now(),
 	}
+	if request.Body.Metadata != nil {
+		if err := conn.SetMetadata(*request.Body.Metadata); err != nil {
+			return CreateConnection400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+	}
+	if request.Body.Tags != nil {
+		if err := conn.SetTags(*request.Body.Tags); err != nil {
+			return CreateConnection400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+	}
 
 	err = s.connectionsService.Create(ctx, conn)
 	if err != nil {
@@ -108,6 +120,33 @@ func (s *Server) CreateConnection(ctx context.Context, request CreateConnectionR
 	return CreateConnection201JSONResponse{}, nil
 }
 
+// UpdateConnection changes the metadata and tags of a connection
+func (s *Server) UpdateConnection(ctx context.Context, request UpdateConnectionRequestObject) (UpdateConnectionResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return UpdateConnection400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	updateReq := &ports.UpdateConnectionRequest{Tags: request.Body.Tags}
+	if request.Body.Metadata != nil {
+		updateReq.Metadata = common.ToPointer(map[string]string(*request.Body.Metadata))
+	}
+	_, err = s.connectionsService.Update(ctx, request.Id, *issuerDID, updateReq)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return UpdateConnection400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidConnectionMetadata) {
+			return UpdateConnection400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "update connection", "err", err, "req", request.Id.String())
+		return UpdateConnection500JSONResponse{N500JSONResponse{"There was an error updating the connection"}}, nil
+	}
+
+	return UpdateConnection200JSONResponse{Message: "Connection updated"}, nil
+}
+
 // DeleteConnection deletes a connection
 func (s *Server) DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error) {
 	issuerDID, err := w3c.ParseDID(request.Identifier)
@@ -171,6 +210,118 @@ func (s *Server) GetConnection(ctx context.Context, request GetConnectionRequest
 	return GetConnection200JSONResponse(resp), nil
 }
 
+// SendConnectionMessage sends a message to the holder of a connection
+func (s *Server) SendConnectionMessage(ctx context.Context, request SendConnectionMessageRequestObject) (SendConnectionMessageResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return SendConnectionMessage400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	req := &ports.SendConnectionMessageRequest{
+		Type:     ports.ConnectionMessageType(request.Body.Type),
+		ThreadID: request.Body.ThreadID,
+	}
+	switch req.Type {
+	case ports.ConnectionMessageBasic:
+		if request.Body.Content != nil {
+			req.Content = *request.Body.Content
+		}
+	case ports.ConnectionMessageCredentialOffer:
+		if request.Body.CredentialID == nil {
+			return SendConnectionMessage400JSONResponse{N400JSONResponse{"credentialID is required for credential offers"}}, nil
+		}
+		req.CredentialID = *request.Body.CredentialID
+	case ports.ConnectionMessageProofRequest:
+		if request.Body.ProofRequests != nil {
+			req.ProofRequests = toProofRequests(*request.Body.ProofRequests)
+		}
+	}
+
+	message, err := s.connectionMessageService.Send(ctx, *issuerDID, request.Id, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrConnectionDoesNotExist):
+			return SendConnectionMessage400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
+		case errors.Is(err, services.ErrCredentialNotFound):
+			return SendConnectionMessage400JSONResponse{N400JSONResponse{"The given credential does not exist"}}, nil
+		case errors.Is(err, services.ErrInvalidConnectionMessage), errors.Is(err, services.ErrEmptyMTPProof):
+			return SendConnectionMessage400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "send connection message", "err", err, "req", request.Id.String())
+		return SendConnectionMessage500JSONResponse{N500JSONResponse{"There was an error sending the message"}}, nil
+	}
+
+	return SendConnectionMessage201JSONResponse(toConnectionMessageResponse(message)), nil
+}
+
+// GetConnectionMessages returns the messages sent to the holder of a connection
+func (s *Server) GetConnectionMessages(ctx context.Context, request GetConnectionMessagesRequestObject) (GetConnectionMessagesResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetConnectionMessages400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	messages, err := s.connectionMessageService.GetAll(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionMessages400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection messages", "err", err, "req", request.Id.String())
+		return GetConnectionMessages500JSONResponse{N500JSONResponse{"There was an error retrieving the messages"}}, nil
+	}
+
+	resp := make(GetConnectionMessages200JSONResponse, len(messages))
+	for i, message := range messages {
+		resp[i] = toConnectionMessageResponse(message)
+	}
+	return resp, nil
+}
+
+// GetConnectionHistory returns the history of the connection
+func (s *Server) GetConnectionHistory(ctx context.Context, request GetConnectionHistoryRequestObject) (GetConnectionHistoryResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetConnectionHistory400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if request.Params.Page != nil && *request.Params.Page <= 0 {
+		return GetConnectionHistory400JSONResponse{N400JSONResponse{Message: "page must be greater than 0"}}, nil
+	}
+
+	var types []domain.ConnectionEventType
+	if request.Params.Type != nil {
+		for _, t := range *request.Params.Type {
+			switch t {
+			case ConnectionEventTypeCreated, ConnectionEventTypeAuthenticated, ConnectionEventTypeDidDocumentChanged, ConnectionEventTypePushServiceChanged:
+				types = append(types, domain.ConnectionEventType(t))
+			default:
+				return GetConnectionHistory400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("unknown event type %q", t)}}, nil
+			}
+		}
+	}
+
+	filter := ports.NewGetConnectionHistoryRequest(types, request.Params.Page, request.Params.MaxResults)
+	events, total, err := s.connectionsService.GetHistory(ctx, request.Id, *issuerDID, filter)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionHistory400JSONResponse{N400JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection history", "err", err, "req", request.Id.String())
+		return GetConnectionHistory500JSONResponse{N500JSONResponse{"There was an error retrieving the connection history"}}, nil
+	}
+
+	items := make([]ConnectionEvent, len(events))
+	for i, event := range events {
+		items[i] = toConnectionEventResponse(event)
+	}
+	return GetConnectionHistory200JSONResponse{
+		Items: items,
+		Meta:  newPaginatedMetadata(filter.Pagination, total),
+	}, nil
+}
+
 // DeleteConnectionCredentials deletes all the credentials of the given connection
 func (s *Server) DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error) {
 	issuerDID, err := w3c.ParseDID(request.Identifier)
@@ -223,7 +374,22 @@ func getConnectionsFilter(req GetConnectionsRequestObject) (*ports.NewGetAllConn
 			}
 		}
 	}
-	return ports.NewGetAllRequest(req.Params.Credentials, req.Params.Query, req.Params.Page, req.Params.MaxResults, orderBy), nil
+	var tags []string
+	if req.Params.Tags != nil {
+		tags = *req.Params.Tags
+	}
+	var metadata map[string]string
+	if req.Params.Metadata != nil {
+		metadata = make(map[string]string, len(*req.Params.Metadata))
+		for _, entry := range *req.Params.Metadata {
+			key, value, found := strings.Cut(entry, ":")
+			if !found || strings.TrimSpace(key) == "" {
+				return nil, errors.New("wrong metadata value, it must be key:value")
+			}
+			metadata[strings.TrimSpace(key)] = value
+		}
+	}
+	return ports.NewGetAllRequest(req.Params.Credentials, req.Params.Query, tags, metadata, req.Params.Page, req.Params.MaxResults, orderBy), nil
 }
 
 func checkJSONIsNotNull(message []byte) bool {
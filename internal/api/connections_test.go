@@ -13,13 +13,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
-	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2/protocol"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/wakeup-labs/issuer-node/internal/common"
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db/tests"
 	"github.com/wakeup-labs/issuer-node/internal/repositories"
 )
 
@@ -530,6 +532,165 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 	}
 }
 
+func TestServer_UpdateConnection(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	issuerDID, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+
+	fixture := repositories.NewFixture(storage)
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	type expected struct {
+		httpCode int
+		message  string
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		connID   uuid.UUID
+		body     UpdateConnectionRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:   "No auth header",
+			auth:   authWrong,
+			connID: connID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "should get an error, non existing connection",
+			auth:   authOk,
+			connID: uuid.New(),
+			body: UpdateConnectionRequest{
+				Tags: &[]string{"vip"},
+			},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "The given connection does not exist",
+			},
+		},
+		{
+			name:   "should get an error, empty tag",
+			auth:   authOk,
+			connID: connID,
+			body: UpdateConnectionRequest{
+				Tags: &[]string{" "},
+			},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid connection metadata: tags must have between 1 and 64 characters",
+			},
+		},
+		{
+			name:   "should update the metadata and tags",
+			auth:   authOk,
+			connID: connID,
+			body: UpdateConnectionRequest{
+				Metadata: &ConnectionMetadata{"customerId": "crm-1234"},
+				Tags:     &[]string{"vip", "beta"},
+			},
+			expected: expected{
+				httpCode: http.StatusOK,
+				message:  "Connection updated",
+			},
+		},
+		{
+			name:   "should keep the tags not sent",
+			auth:   authOk,
+			connID: connID,
+			body: UpdateConnectionRequest{
+				Metadata: &ConnectionMetadata{"customerId": "crm-1234", "department": "sales"},
+			},
+			expected: expected{
+				httpCode: http.StatusOK,
+				message:  "Connection updated",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/connections/%s", issuerDID, tc.connID)
+			req, err := http.NewRequest(http.MethodPatch, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response UpdateConnection200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			case http.StatusBadRequest:
+				var response UpdateConnection400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	t.Run("should filter the connections by tags and metadata", func(t *testing.T) {
+		for _, tc := range []struct {
+			params   string
+			httpCode int
+			expected int
+		}{
+			{params: "tags=vip&tags=beta", httpCode: http.StatusOK, expected: 1},
+			{params: "tags=vip&tags=other", httpCode: http.StatusOK, expected: 0},
+			{params: "metadata=customerId:crm-1234&metadata=department:sales", httpCode: http.StatusOK, expected: 1},
+			{params: "metadata=department:support", httpCode: http.StatusOK, expected: 0},
+			{params: "query=crm-12", httpCode: http.StatusOK, expected: 1},
+			{params: "metadata=department", httpCode: http.StatusBadRequest},
+		} {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/connections?%s", issuerDID, tc.params), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.httpCode, rr.Code, tc.params)
+			if tc.httpCode != http.StatusOK {
+				continue
+			}
+			var response GetConnections200JSONResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			require.Len(t, response.Items, tc.expected, tc.params)
+			if tc.expected > 0 {
+				assert.Equal(t, connID.String(), response.Items[0].Id)
+				assert.Equal(t, ConnectionMetadata{"customerId": "crm-1234", "department": "sales"}, response.Items[0].Metadata)
+				assert.Equal(t, []string{"beta", "vip"}, response.Items[0].Tags)
+			}
+		}
+	})
+}
+
 func TestServer_GetConnectionsDefaultSort(t *testing.T) {
 	const (
 		method     = "opid"
@@ -715,3 +876,439 @@ func createConnections(t *testing.T, issuerDID *w3c.DID, fixture *repositories.F
 	}
 	return connections
 }
+
+func TestServer_SendConnectionMessage(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	issuerDID, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+	userDIDWithoutPush, err := w3c.ParseDID("did:opid:optimism:sepolia:2qFjTM4kX3J6AYzHBY1Q3ztnxv1UfNaaNUGw8TKo4N")
+	require.NoError(t, err)
+
+	fixture := repositories.NewFixture(storage)
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		UserDoc:    json.RawMessage(`{"id": "did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5", "service": [{"id": "did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5#push", "type": "push-notification", "metadata": {"devices": [{"alg": "RSA-OAEP-512", "ciphertext": "someToken"}]}, "serviceEndpoint": "https://push.example.com/api/v1"}], "@context": ["https://www.w3.org/ns/did/v1"]}`),
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+	connIDWithoutPush := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDIDWithoutPush,
+		UserDoc:    json.RawMessage(`{"id": "did:opid:optimism:sepolia:2qFjTM4kX3J6AYzHBY1Q3ztnxv1UfNaaNUGw8TKo4N", "@context": ["https://www.w3.org/ns/did/v1"]}`),
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	credentialSubject := map[string]any{
+		"id":           userDID.String(),
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	merklizedRootPosition := "value"
+	credential, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(issuerDID, nil, schema, credentialSubject, nil, "KYCAgeCredential", nil, nil, &merklizedRootPosition, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+	require.NoError(t, err)
+
+	type expected struct {
+		httpCode int
+		message  string
+		status   ConnectionMessageStatus
+		reason   *string
+		typ      string
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		connID   uuid.UUID
+		body     SendConnectionMessageRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:   "No auth header",
+			auth:   authWrong,
+			connID: connID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "should get an error, non existing connection",
+			auth:   authOk,
+			connID: uuid.New(),
+			body:   SendConnectionMessageRequest{Type: BasicMessage, Content: common.ToPointer("hello")},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "The given connection does not exist",
+			},
+		},
+		{
+			name:   "should get an error, basic message without content",
+			auth:   authOk,
+			connID: connID,
+			body:   SendConnectionMessageRequest{Type: BasicMessage},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid connection message: the content of the basic message is required",
+			},
+		},
+		{
+			name:   "should get an error, proof request without proofs",
+			auth:   authOk,
+			connID: connID,
+			body:   SendConnectionMessageRequest{Type: ProofRequest},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid connection message: at least one proof request is required",
+			},
+		},
+		{
+			name:   "should get an error, offer without credential",
+			auth:   authOk,
+			connID: connID,
+			body:   SendConnectionMessageRequest{Type: CredentialOffer},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "credentialID is required for credential offers",
+			},
+		},
+		{
+			name:   "should get an error, offer of a non existing credential",
+			auth:   authOk,
+			connID: connID,
+			body:   SendConnectionMessageRequest{Type: CredentialOffer, CredentialID: common.ToPointer(uuid.New())},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "The given credential does not exist",
+			},
+		},
+		{
+			name:   "should get an error, offer of a credential of another holder",
+			auth:   authOk,
+			connID: connIDWithoutPush,
+			body:   SendConnectionMessageRequest{Type: CredentialOffer, CredentialID: &credential.ID},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid connection message: the credential was not issued to the holder of the connection",
+			},
+		},
+		{
+			name:   "should send a basic message",
+			auth:   authOk,
+			connID: connID,
+			body:   SendConnectionMessageRequest{Type: BasicMessage, Content: common.ToPointer("hello")},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				status:   ConnectionMessageStatusSent,
+				typ:      string(domain.BasicMessageType),
+			},
+		},
+		{
+			name:   "should send a proof request",
+			auth:   authOk,
+			connID: connID,
+			body: SendConnectionMessageRequest{Type: ProofRequest, ProofRequests: &[]ZeroKnowledgeProofRequest{
+				{
+					CircuitId: "credentialAtomicQuerySigV2",
+					Id:        common.ToPointer(uint32(1)),
+					Query: map[string]interface{}{
+						"allowedIssuers": []string{"*"},
+						"type":           "KYCAgeCredential",
+					},
+				},
+			}},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				status:   ConnectionMessageStatusSent,
+				typ:      string(protocol.ProofGenerationRequestMessageType),
+			},
+		},
+		{
+			name:   "should send a credential offer",
+			auth:   authOk,
+			connID: connID,
+			body:   SendConnectionMessageRequest{Type: CredentialOffer, CredentialID: &credential.ID},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				status:   ConnectionMessageStatusSent,
+				typ:      string(protocol.CredentialOfferMessageType),
+			},
+		},
+		{
+			name:   "should record the message that can not be delivered",
+			auth:   authOk,
+			connID: connIDWithoutPush,
+			body:   SendConnectionMessageRequest{Type: BasicMessage, Content: common.ToPointer("hello")},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				status:   ConnectionMessageStatusFailed,
+				reason:   common.ToPointer("no push service in did document"),
+				typ:      string(domain.BasicMessageType),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/connections/%s/messages", issuerDID, tc.connID)
+			req, err := http.NewRequest(http.MethodPost, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			switch tc.expected.httpCode {
+			case http.StatusCreated:
+				var response SendConnectionMessage201JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.connID, response.ConnectionID)
+				assert.Equal(t, response.Id.String(), response.ThreadID)
+				assert.Equal(t, tc.expected.status, response.Status)
+				assert.Equal(t, tc.expected.reason, response.Reason)
+				assert.Equal(t, tc.expected.typ, response.Type)
+			case http.StatusBadRequest:
+				var response SendConnectionMessage400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	t.Run("should get the messages of the connection", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/connections/%s/messages", issuerDID, connID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+
+		handler.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetConnectionMessages200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response, 3)
+		assert.Equal(t, string(protocol.CredentialOfferMessageType), response[0].Type)
+
+		thread, err := server.agentMessageService.GetThread(ctx, *issuerDID, response[0].ThreadID)
+		require.NoError(t, err)
+		require.Len(t, thread, 1)
+		assert.Equal(t, domain.AgentMessageOutbound, thread[0].Direction)
+
+		offered, err := server.Services.credentials.GetByID(ctx, issuerDID, credential.ID)
+		require.NoError(t, err)
+		require.NotNil(t, offered.DeliveryStatus)
+		assert.Equal(t, domain.CredentialDeliveryOffered, *offered.DeliveryStatus)
+	})
+
+	t.Run("should check the thread of the message", func(t *testing.T) {
+		send := func(connID uuid.UUID, threadID string) *httptest.ResponseRecorder {
+			rr := httptest.NewRecorder()
+			body := SendConnectionMessageRequest{Type: BasicMessage, Content: common.ToPointer("hello"), ThreadID: &threadID}
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/connections/%s/messages", issuerDID, connID), tests.JSONBody(t, body))
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			return rr
+		}
+
+		messages, err := server.connectionMessageService.GetAll(ctx, *issuerDID, connID)
+		require.NoError(t, err)
+		require.NotEmpty(t, messages)
+		threadID := messages[0].ThreadID
+
+		for _, tc := range []struct {
+			connID   uuid.UUID
+			threadID string
+			message  string
+		}{
+			{connID: connID, threadID: uuid.NewString(), message: "invalid connection message: the thread does not exist"},
+			{connID: connIDWithoutPush, threadID: threadID, message: "invalid connection message: the thread is not of the connection"},
+		} {
+			rr := send(tc.connID, tc.threadID)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			var response SendConnectionMessage400JSONResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, tc.message, response.Message)
+		}
+
+		rr := send(connID, threadID)
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response SendConnectionMessage201JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, threadID, response.ThreadID)
+	})
+}
+
+func TestServer_GetConnectionHistory(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		push       = `{"id": "did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5#push", "type": "push-notification", "metadata": {"devices": [{"alg": "RSA-OAEP-512", "ciphertext": "someToken"}]}, "serviceEndpoint": "https://push.example.com/api/v1"}`
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	issuerDID, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+
+	for _, userDoc := range []string{
+		`{"id": "did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5", "@context": ["https://www.w3.org/ns/did/v1"]}`,
+		`{"id": "did:opid:optimism:sepolia:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5", "service": [` + push + `], "@context": ["https://www.w3.org/ns/did/v1"]}`,
+	} {
+		require.NoError(t, server.connectionsService.Create(ctx, &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *issuerDID,
+			UserDID:    *userDID,
+			UserDoc:    json.RawMessage(userDoc),
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		}))
+	}
+	conn, err := server.connectionsService.GetByUserID(ctx, *issuerDID, *userDID)
+	require.NoError(t, err)
+
+	type expected struct {
+		httpCode int
+		message  string
+		types    []ConnectionEventType
+		total    uint
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		connID   uuid.UUID
+		params   string
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:   "No auth header",
+			auth:   authWrong,
+			connID: conn.ID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "should get an error, non existing connection",
+			auth:   authOk,
+			connID: uuid.New(),
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "The given connection does not exist",
+			},
+		},
+		{
+			name:   "should get an error, wrong page",
+			auth:   authOk,
+			connID: conn.ID,
+			params: "page=0",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "page must be greater than 0",
+			},
+		},
+		{
+			name:   "should get an error, unknown type",
+			auth:   authOk,
+			connID: conn.ID,
+			params: "type=deleted",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  `unknown event type "deleted"`,
+			},
+		},
+		{
+			name:   "should get the history",
+			auth:   authOk,
+			connID: conn.ID,
+			expected: expected{
+				httpCode: http.StatusOK,
+				types:    []ConnectionEventType{ConnectionEventTypeDidDocumentChanged, ConnectionEventTypePushServiceChanged, ConnectionEventTypeCreated},
+				total:    3,
+			},
+		},
+		{
+			name:   "should get the push service changes",
+			auth:   authOk,
+			connID: conn.ID,
+			params: "type=pushServiceChanged&type=authenticated",
+			expected: expected{
+				httpCode: http.StatusOK,
+				types:    []ConnectionEventType{ConnectionEventTypePushServiceChanged},
+				total:    1,
+			},
+		},
+		{
+			name:   "should get the second page",
+			auth:   authOk,
+			connID: conn.ID,
+			params: "page=2&max_results=2",
+			expected: expected{
+				httpCode: http.StatusOK,
+				types:    []ConnectionEventType{ConnectionEventTypeCreated},
+				total:    3,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/connections/%s/history?%s", issuerDID, tc.connID, tc.params)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response GetConnectionHistory200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.total, response.Meta.Total)
+				types := make([]ConnectionEventType, len(response.Items))
+				for i, event := range response.Items {
+					assert.Equal(t, conn.ID, event.ConnectionID)
+					types[i] = event.Type
+				}
+				// the events of the same save have the same time
+				assert.ElementsMatch(t, tc.expected.types, types)
+				if len(types) == 3 {
+					assert.Equal(t, ConnectionEventTypeCreated, types[2])
+					assert.NotNil(t, response.Items[2].UserDoc)
+					assert.Nil(t, response.Items[2].PushService)
+				}
+			case http.StatusBadRequest:
+				var response GetConnectionHistory400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+}
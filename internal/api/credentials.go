@@ -49,9 +49,17 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		expiration = common.ToPointer(time.Unix(*request.Body.Expiration, 0))
 	}
 
+	issuanceMode := domain.CredentialIssuanceOffchain
+	if request.Body.IssuanceMode != nil {
+		issuanceMode = domain.CredentialIssuanceMode(*request.Body.IssuanceMode)
+		if !issuanceMode.IsValid() {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("unsupported issuance mode: %s", issuanceMode)}}, nil
+		}
+	}
+
 	claimRequestProofs := ports.ClaimRequestProofs{}
 	if request.Body.Proofs == nil {
-		claimRequestProofs.BJJSignatureProof2021 = true
+		claimRequestProofs.BJJSignatureProof2021 = !issuanceMode.IsOnchain()
 		claimRequestProofs.Iden3SparseMerkleTreeProof = true
 	} else {
 		for _, proof := range *request.Body.Proofs {
@@ -83,13 +91,26 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		return CreateCredential400JSONResponse{N400JSONResponse{Message: "error getting reverse hash service settings"}}, nil
 	}
 
-	if !s.networkResolver.IsCredentialStatusTypeSupported(rhsSettings.Mode, *credentialStatusType) {
+	// the status of the credentials issued on chain is resolved by the on-chain identity contract
+	if !issuanceMode.IsOnchain() && !s.networkResolver.IsCredentialStatusTypeSupported(rhsSettings.Mode, *credentialStatusType) {
 		log.Warn(ctx, "unsupported credential status type", "req", request)
 		return CreateCredential400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("Credential Status Type '%s' is not supported by the issuer", *credentialStatusType)}}, nil
 	}
 
 	req := ports.NewCreateClaimRequest(did, request.Body.ClaimID, request.Body.CredentialSchema, request.Body.CredentialSubject, expiration, request.Body.Type, request.Body.Version, request.Body.SubjectPosition, request.Body.MerklizedRootPosition, claimRequestProofs, nil, false, *credentialStatusType, toVerifiableRefreshService(request.Body.RefreshService), request.Body.RevNonce,
 		toVerifiableDisplayMethod(request.Body.DisplayMethod))
+	req.IssuanceMode = issuanceMode
+
+	if s.signingPolicy.RequiresCredentialApproval(req.Schema) {
+		approval, err := s.approvalService.RequestCredentialIssuance(ctx, req)
+		if err != nil {
+			if errors.Is(err, services.ErrDailyIssuanceLimitReached) {
+				return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+			return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateCredential202JSONResponse(toApprovalRequestResponse(approval)), nil
+	}
 
 	resp, err := s.claimService.Save(ctx, req)
 	if err != nil {
@@ -109,6 +130,9 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 			services.ErrDisplayMethodLacksURL,
 			services.ErrUnsupportedDisplayMethodType,
 			services.ErrWrongCredentialSubjectID,
+			services.ErrDailyIssuanceLimitReached,
+			services.ErrOnchainIssuanceSignatureProof,
+			domain.ErrOnchainIssuanceNotConfigured,
 		}
 		for _, e := range errs {
 			if errors.Is(err, e) {
@@ -134,6 +158,9 @@ func (s *Server) RevokeCredential(ctx context.Context, request RevokeCredentialR
 				Message: "the credential does not exist",
 			}}, nil
 		}
+		if errors.Is(err, services.ErrOnchainCredentialRevocation) {
+			return RevokeCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 
 		return RevokeCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
@@ -353,13 +380,24 @@ func toVerifiableDisplayMethod(s *DisplayMethod) *verifiable.DisplayMethod {
 }
 
 func toGetCredential200Response(w3cCredential *verifiable.W3CCredential, cred *domain.Claim) Credential {
-	return Credential{
+	credential := Credential{
 		Vc:         *w3cCredential,
 		Id:         cred.ID.String(),
 		Revoked:    cred.Revoked,
 		SchemaHash: cred.SchemaHash,
 		ProofTypes: getProofs(cred),
 	}
+	if cred.DeliveryStatus != nil {
+		credential.DeliveryStatus = common.ToPointer(CredentialDeliveryStatus(*cred.DeliveryStatus))
+	}
+	if cred.DeliveryUpdatedAt != nil {
+		credential.DeliveryUpdatedAt = common.ToPointer(TimeUTC(*cred.DeliveryUpdatedAt))
+	}
+	if cred.IssuanceMode != "" {
+		credential.IssuanceMode = common.ToPointer(CredentialIssuanceMode(cred.IssuanceMode))
+	}
+	credential.OnchainTxId = cred.OnchainTxID
+	return credential
 }
 
 func getCredentialsFilter(ctx context.Context, req GetCredentialsRequestObject) (*ports.ClaimsFilter, error) {
@@ -384,6 +422,13 @@ func getCredentialsFilter(ctx context.Context, req GetCredentialsRequestObject)
 			return nil, errors.New("wrong type value. Allowed values: [all, revoked, expired]")
 		}
 	}
+	if req.Params.DeliveryStatus != nil {
+		status := domain.CredentialDeliveryStatus(*req.Params.DeliveryStatus)
+		if !status.IsValid() {
+			return nil, errors.New("wrong deliveryStatus value. Allowed values: [offered, fetched, acked]")
+		}
+		filter.DeliveryStatus = &status
+	}
 	if req.Params.Query != nil {
 		filter.FTSQuery = *req.Params.Query
 	}
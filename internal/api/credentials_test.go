@@ -416,6 +416,46 @@ func TestServer_CreateCredential(t *testing.T) {
 				httpCode: http.StatusBadRequest,
 			},
 		},
+		{
+			name: "On-chain issuance without an on-chain identity contract",
+			auth: authOk,
+			did:  did,
+			body: CreateCredentialRequest{
+				CredentialSchema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+				Type:             "KYCAgeCredential",
+				CredentialSubject: map[string]any{
+					"id":           "did:opid:optimism:sepolia:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi",
+					"birthday":     19960425,
+					"documentType": 2,
+				},
+				Expiration:   common.ToPointer(time.Now().Unix()),
+				IssuanceMode: common.ToPointer(Onchain),
+			},
+			expected: expected{
+				response: CreateCredential400JSONResponse{N400JSONResponse{Message: "the identity is not configured to issue credentials on chain"}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Wrong issuance mode",
+			auth: authOk,
+			did:  did,
+			body: CreateCredentialRequest{
+				CredentialSchema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+				Type:             "KYCAgeCredential",
+				CredentialSubject: map[string]any{
+					"id":           "did:opid:optimism:sepolia:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi",
+					"birthday":     19960425,
+					"documentType": 2,
+				},
+				Expiration:   common.ToPointer(time.Now().Unix()),
+				IssuanceMode: common.ToPointer(CredentialIssuanceMode("sidechain")),
+			},
+			expected: expected{
+				response: CreateCredential400JSONResponse{N400JSONResponse{Message: "unsupported issuance mode: sidechain"}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
 		{
 			name: "Wrong id for credential receiver",
 			auth: authOk,
@@ -1592,3 +1632,76 @@ func validateCredential(t *testing.T, resp, tc Credential) {
 	require.True(t, ok)
 	assert.EqualValues(t, responseCredentialStatus, credentialStatusTC)
 }
+
+func TestServer_GetCredentialsDeliveryStatus(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		typeC      = "KYCAgeCredential"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	)
+	ctx := context.Background()
+
+	server := newTestServer(t, nil)
+	identity, err := server.identityService.Create(ctx, "https://localhost.com", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	credentialSubject := map[string]any{
+		"id":           "did:opid:optimism:sepolia:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	merklizedRootPosition := "index"
+	newCredential := func() *domain.Claim {
+		claim, err := server.claimService.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition,
+			ports.ClaimRequestProofs{BJJSignatureProof2021: true, Iden3SparseMerkleTreeProof: false}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil))
+		require.NoError(t, err)
+		return claim
+	}
+	notOffered := newCredential()
+	offered := newCredential()
+	_, err = server.claimService.GetCredentialQrCode(ctx, did, offered.ID, "https://issuer.test")
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	type testConfig struct {
+		name           string
+		deliveryStatus string
+		httpCode       int
+		ids            []string
+	}
+	for _, tc := range []testConfig{
+		{name: "offered", deliveryStatus: "offered", httpCode: http.StatusOK, ids: []string{offered.ID.String()}},
+		{name: "fetched", deliveryStatus: "fetched", httpCode: http.StatusOK, ids: []string{}},
+		{name: "acked", deliveryStatus: "acked", httpCode: http.StatusOK, ids: []string{}},
+		{name: "wrong status", deliveryStatus: "delivered", httpCode: http.StatusBadRequest},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials?deliveryStatus=%s", did, tc.deliveryStatus), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+			if tc.httpCode != http.StatusOK {
+				return
+			}
+
+			var response GetCredentials200JSONResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			ids := make([]string, 0, len(response.Items))
+			for _, credential := range response.Items {
+				ids = append(ids, credential.Id)
+				require.NotNil(t, credential.DeliveryStatus)
+				assert.Equal(t, tc.deliveryStatus, string(*credential.DeliveryStatus))
+				assert.NotEqual(t, notOffered.ID.String(), credential.Id)
+			}
+			assert.Equal(t, tc.ids, ids)
+		})
+	}
+}
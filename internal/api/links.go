@@ -3,39 +3,82 @@ package api
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2/protocol"
 
+	"github.com/wakeup-labs/issuer-node/internal/common"
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
 	"github.com/wakeup-labs/issuer-node/internal/core/services"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 	"github.com/wakeup-labs/issuer-node/internal/repositories"
+	"github.com/wakeup-labs/issuer-node/internal/sqltools"
 )
 
 // GetLinks - Returns a list of links based on a search criteria.
 func (s *Server) GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error) {
-	var err error
 	issuerDID, err := w3c.ParseDID(request.Identifier)
 	if err != nil {
 		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
 		return GetLinks400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
 	}
-	status := ports.LinkAll
-	if request.Params.Status != nil {
-		if status, err = ports.LinkTypeReqFromString(string(*request.Params.Status)); err != nil {
-			log.Warn(ctx, "unknown request type getting links", "err", err, "type", request.Params.Status)
-			return GetLinks400JSONResponse{N400JSONResponse{Message: "unknown request type. Allowed: all|active|inactive|exceed"}}, nil
-		}
+	filter, err := getLinksFilter(ctx, request)
+	if err != nil {
+		return GetLinks400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 	}
-	links, err := s.linkService.GetAll(ctx, *issuerDID, status, request.Params.Query, s.cfg.ServerUrl)
+	links, total, err := s.linkService.GetAll(ctx, *issuerDID, filter, s.cfg.ServerUrl)
 	if err != nil {
 		log.Error(ctx, "getting links", "err", err, "req", request)
+		return GetLinks500JSONResponse{N500JSONResponse{Message: "error getting links"}}, nil
 	}
 
-	return GetLinks200JSONResponse(getLinkResponses(links)), err
+	return GetLinks200JSONResponse{
+		Items: getLinkResponses(links),
+		Meta:  newPaginatedMetadata(filter.Pagination, total),
+	}, nil
+}
+
+func getLinksFilter(ctx context.Context, req GetLinksRequestObject) (*ports.LinksFilter, error) {
+	var status *ports.LinkStatus
+	if req.Params.Status != nil {
+		linkStatus, err := ports.LinkTypeReqFromString(string(*req.Params.Status))
+		if err != nil {
+			log.Warn(ctx, "unknown request type getting links", "err", err, "type", req.Params.Status)
+			return nil, errors.New("unknown request type. Allowed: all|active|inactive|exceed")
+		}
+		status = &linkStatus
+	}
+	if req.Params.Page != nil && *req.Params.Page <= 0 {
+		return nil, errors.New("page must be greater than 0")
+	}
+	orderBy := sqltools.OrderByFilters{}
+	if req.Params.Sort != nil {
+		for _, sortBy := range *req.Params.Sort {
+			var err error
+			field, desc := strings.CutPrefix(strings.TrimSpace(string(sortBy)), "-")
+			switch GetLinksParamsSort(field) {
+			case GetLinksParamsSortCreatedAt:
+				err = orderBy.Add(ports.LinksCreatedAt, desc)
+			case GetLinksParamsSortValidUntil:
+				err = orderBy.AddWithNullsLast(ports.LinksValidUntil, desc)
+			case GetLinksParamsSortIssuedClaims:
+				err = orderBy.Add(ports.LinksIssuedClaims, desc)
+			case GetLinksParamsSortSchemaType:
+				err = orderBy.Add(ports.LinksSchemaType, desc)
+			default:
+				return nil, errors.New("wrong sort by value")
+			}
+			if err != nil {
+				return nil, errors.New("repeated sort by value field")
+			}
+		}
+	}
+	return ports.NewLinksFilter(status, req.Params.Query, req.Params.SchemaID, req.Params.CreatedFrom, req.Params.CreatedTo,
+		req.Params.Page, maxResultsOrDefault(req.Params.MaxResults), orderBy), nil
 }
 
 // CreateLink - creates a link for issuing a credential
@@ -68,12 +111,17 @@ func (s *Server) CreateLink(ctx context.Context, request CreateLinkRequestObject
 		}
 	}
 
+	// The link service reports the errors loading the schema
+	if schema, err := s.schemaService.GetByID(ctx, *issuerDID, request.Body.SchemaID); err == nil && s.signingPolicy.RequiresCredentialApproval(schema.URL) {
+		return CreateLink400JSONResponse{N400JSONResponse{Message: "credentials of this schema require approval and cannot be issued with links"}}, nil
+	}
+
 	var expirationDate *time.Time
 	if request.Body.CredentialExpiration != nil {
 		expirationDate = request.Body.CredentialExpiration
 	}
 
-	createdLink, err := s.linkService.Save(ctx, *issuerDID, request.Body.LimitedClaims, request.Body.Expiration, request.Body.SchemaID, expirationDate, request.Body.SignatureProof, request.Body.MtProof, credSubject, toVerifiableRefreshService(request.Body.RefreshService), toDisplayMethodService(request.Body.DisplayMethod))
+	createdLink, err := s.linkService.Save(ctx, *issuerDID, request.Body.LimitedClaims, request.Body.Expiration, request.Body.SchemaID, expirationDate, request.Body.SignatureProof, request.Body.MtProof, credSubject, toVerifiableRefreshService(request.Body.RefreshService), toDisplayMethodService(request.Body.DisplayMethod), toLinkDerivation(request.Body.Derivation), toLinkAccess(request.Body))
 	if err != nil {
 		log.Error(ctx, "error saving the link", "err", err.Error())
 		if errors.Is(err, services.ErrLoadingSchema) {
@@ -89,20 +137,34 @@ func (s *Server) CreateLink(ctx context.Context, request CreateLinkRequestObject
 func (s *Server) CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error) {
 	if request.Body == nil || *request.Body == "" {
 		log.Error(ctx, "empty request body auth-callback request")
-		return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
+		return CreateLinkQrCodeCallback400JSONResponse{problemReport(errors.New("Cannot proceed with empty body"))}, nil
 	}
 
 	issuerDID, err := w3c.ParseDID(request.Identifier)
 	if err != nil {
 		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
-		return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+		return CreateLinkQrCodeCallback400JSONResponse{problemReport(errors.New("invalid issuer did"))}, nil
 	}
 
-	offer, err := s.linkService.ProcessCallBack(ctx, *issuerDID, *request.Body, request.Params.LinkID, s.cfg.ServerUrl)
+	offer, err := s.linkService.ProcessCallBack(ctx, *issuerDID, *request.Body, request.Params.LinkID, request.Params.AllowlistKey, request.Params.InviteCode, s.cfg.ServerUrl)
 	if err != nil {
+		var paymentRequired *services.PaymentRequiredError
+		if errors.As(err, &paymentRequired) {
+			return CreateLinkQrCodeCallback402JSONResponse(*paymentRequired.Request), nil
+		}
 		log.Error(ctx, "error issuing the claim", "error", err)
 		if errors.Is(err, services.ErrLinkAlreadyExpired) || errors.Is(err, services.ErrLinkMaxExceeded) || errors.Is(err, services.ErrLinkInactive) {
-			return CreateLinkQrCodeCallback400JSONResponse{N400JSONResponse{Message: "error: " + err.Error()}}, nil
+			return CreateLinkQrCodeCallback400JSONResponse{problemReport(err)}, nil
+		}
+		if errors.Is(err, services.ErrLinkHolderNotAllowed) || errors.Is(err, services.ErrLinkAllowlistKeyNotFound) ||
+			errors.Is(err, services.ErrLinkAllowlistEntryClaimed) || errors.Is(err, repositories.ErrLinkAllowlistEntryDuplicated) {
+			return CreateLinkQrCodeCallback400JSONResponse{problemReport(err)}, nil
+		}
+		if errors.Is(err, domain.ErrLinkDerivation) {
+			return CreateLinkQrCodeCallback400JSONResponse{problemReport(err)}, nil
+		}
+		if errors.Is(err, services.ErrLinkInviteRequired) || errors.Is(err, services.ErrLinkInviteInvalid) {
+			return CreateLinkQrCodeCallback400JSONResponse{problemReport(err)}, nil
 		}
 		return CreateLinkQrCodeCallback500JSONResponse{
 			N500JSONResponse{
@@ -165,21 +227,88 @@ func (s *Server) GetLink(ctx context.Context, request GetLinkRequestObject) (Get
 	return GetLink200JSONResponse(getLinkResponse(link)), nil
 }
 
-// ActivateLink - Activates or deactivates a link
-func (s *Server) ActivateLink(ctx context.Context, request ActivateLinkRequestObject) (ActivateLinkResponseObject, error) {
+// UpdateLink - Activates or deactivates a link and changes its mutable fields
+func (s *Server) UpdateLink(ctx context.Context, request UpdateLinkRequestObject) (UpdateLinkResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return UpdateLink400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if request.Body.Expiration != nil && request.Body.Expiration.Before(time.Now()) {
+		return UpdateLink400JSONResponse{N400JSONResponse{Message: "invalid claimLinkExpiration. Cannot be a date time prior current time."}}, nil
+	}
+
+	update := ports.LinkUpdate{
+		Active:               request.Body.Active,
+		ValidUntil:           request.Body.Expiration,
+		MaxIssuance:          request.Body.LimitedClaims,
+		CredentialExpiration: request.Body.CredentialExpiration,
+		RefreshService:       toVerifiableRefreshService(request.Body.RefreshService),
+		DisplayMethod:        toDisplayMethodService(request.Body.DisplayMethod),
+		Allowlist:            request.Body.Allowlist,
+		InviteOnly:           request.Body.InviteOnly,
+	}
+	if request.Body.CredentialSubject != nil {
+		if len(*request.Body.CredentialSubject) == 0 {
+			return UpdateLink400JSONResponse{N400JSONResponse{Message: "you must provide at least one attribute"}}, nil
+		}
+		update.CredentialSubject = domain.CredentialSubject(*request.Body.CredentialSubject)
+	}
+	if request.Body.Unset != nil {
+		for _, field := range *request.Body.Unset {
+			update.Unset = append(update.Unset, ports.LinkField(field))
+		}
+	}
+
+	if _, err := s.linkService.Update(ctx, *issuerDID, request.Id, update); err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) || errors.Is(err, services.ErrLinkAlreadyActive) || errors.Is(err, services.ErrLinkAlreadyInactive) ||
+			errors.Is(err, services.ErrInvalidLinkUpdate) || errors.Is(err, services.ErrInvalidCredentialSubject) ||
+			errors.Is(err, services.ErrLinkAlreadyExpired) || errors.Is(err, services.ErrLinkMaxExceeded) || isLinkCredentialError(err) {
+			return UpdateLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "error updating link", "err", err, "id", request.Id)
+		return UpdateLink500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdateLink200JSONResponse{Message: "Link updated"}, nil
+}
+
+// CloneLink - Creates a new link from an existing one
+func (s *Server) CloneLink(ctx context.Context, request CloneLinkRequestObject) (CloneLinkResponseObject, error) {
 	issuerDID, err := w3c.ParseDID(request.Identifier)
 	if err != nil {
 		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
-		return ActivateLink400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+		return CloneLink400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	link, err := s.linkService.Clone(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return CloneLink404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, services.ErrLinkCloneExpired) || isLinkCredentialError(err) {
+			return CloneLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "error cloning the link", "err", err, "id", request.Id)
+		return CloneLink500JSONResponse{N500JSONResponse{Message: "unexpected error while cloning the link"}}, nil
 	}
-	if err := s.linkService.Activate(ctx, *issuerDID, request.Id, request.Body.Active); err != nil {
-		if errors.Is(err, repositories.ErrLinkDoesNotExist) || errors.Is(err, services.ErrLinkAlreadyActive) || errors.Is(err, services.ErrLinkAlreadyInactive) {
-			return ActivateLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	return CloneLink201JSONResponse{Id: link.ID.String()}, nil
+}
+
+// isLinkCredentialError returns true if the error means the credential of the link is not valid
+func isLinkCredentialError(err error) bool {
+	for _, target := range []error{
+		services.ErrInvalidCredentialSubject,
+		domain.ErrInvalidLinkDerivation,
+		services.ErrRefreshServiceLacksExpirationTime,
+		services.ErrRefreshServiceLacksURL,
+		services.ErrUnsupportedRefreshServiceType,
+		services.ErrDisplayMethodLacksURL,
+		services.ErrUnsupportedDisplayMethodType,
+	} {
+		if errors.Is(err, target) {
+			return true
 		}
-		log.Error(ctx, "error activating or deactivating link", err.Error(), "id", request.Id)
-		return ActivateLink500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
-	return ActivateLink200JSONResponse{Message: "Link updated"}, nil
+	return false
 }
 
 // CreateLinkOffer - Creates a link offer (qr code)
@@ -189,11 +318,22 @@ func (s *Server) CreateLinkOffer(ctx context.Context, req CreateLinkOfferRequest
 		log.Error(ctx, "parsing issuer did", "err", err, "did", req.Identifier)
 		return CreateLinkOffer400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
 	}
-	createLinkQrCodeResponse, err := s.linkService.CreateQRCode(ctx, *issuerDID, req.Id, s.cfg.ServerUrl)
+	var createLinkQrCodeResponse *ports.CreateQRCodeResponse
+	if req.Params.AllowlistKey != nil {
+		createLinkQrCodeResponse, err = s.linkService.CreateAllowlistQRCode(ctx, *issuerDID, req.Id, *req.Params.AllowlistKey, s.cfg.ServerUrl)
+	} else {
+		createLinkQrCodeResponse, err = s.linkService.CreateQRCode(ctx, *issuerDID, req.Id, s.cfg.ServerUrl)
+	}
 	if err != nil {
 		if errors.Is(err, services.ErrLinkNotFound) {
 			return CreateLinkOffer404JSONResponse{N404JSONResponse{Message: "error: link not found"}}, nil
 		}
+		if errors.Is(err, services.ErrLinkAllowlistKeyNotFound) {
+			return CreateLinkOffer404JSONResponse{N404JSONResponse{Message: "error: " + err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkAllowlistEntryClaimed) {
+			return CreateLinkOffer400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrLinkAlreadyExpired) || errors.Is(err, services.ErrLinkMaxExceeded) || errors.Is(err, services.ErrLinkInactive) {
 			return CreateLinkOffer404JSONResponse{N404JSONResponse{Message: "error: " + err.Error()}}, nil
 		}
@@ -213,6 +353,231 @@ func (s *Server) CreateLinkOffer(ctx context.Context, req CreateLinkOfferRequest
 	}, nil
 }
 
+// GetLinkAllowlist - Returns the allowlist of a link
+func (s *Server) GetLinkAllowlist(ctx context.Context, request GetLinkAllowlistRequestObject) (GetLinkAllowlistResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetLinkAllowlist400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	entries, err := s.linkService.GetAllowlist(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkAllowlist404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link allowlist", "err", err, "id", request.Id)
+		return GetLinkAllowlist500JSONResponse{N500JSONResponse{Message: "error getting link allowlist"}}, nil
+	}
+	return GetLinkAllowlist200JSONResponse(toLinkAllowlistEntries(entries)), nil
+}
+
+// GetLinkInvites - Returns the invites of a link
+func (s *Server) GetLinkInvites(ctx context.Context, request GetLinkInvitesRequestObject) (GetLinkInvitesResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetLinkInvites400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	invites, err := s.linkService.GetInvites(ctx, *issuerDID, request.Id, s.cfg.ServerUrl)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkInvites404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link invites", "err", err, "id", request.Id)
+		return GetLinkInvites500JSONResponse{N500JSONResponse{Message: "error getting link invites"}}, nil
+	}
+	return GetLinkInvites200JSONResponse(toLinkInvites(invites)), nil
+}
+
+// CreateLinkInvites - Generates invite codes for a link
+func (s *Server) CreateLinkInvites(ctx context.Context, request CreateLinkInvitesRequestObject) (CreateLinkInvitesResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CreateLinkInvites400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	maxUses := 1
+	if request.Body.MaxUses != nil {
+		maxUses = *request.Body.MaxUses
+	}
+	invites, err := s.linkService.CreateInvites(ctx, *issuerDID, request.Id, request.Body.Count, maxUses, s.cfg.ServerUrl)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return CreateLinkInvites404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidLinkInvites) {
+			return CreateLinkInvites400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating link invites", "err", err, "id", request.Id)
+		return CreateLinkInvites500JSONResponse{N500JSONResponse{Message: "error creating link invites"}}, nil
+	}
+	return CreateLinkInvites201JSONResponse(toLinkInvites(invites)), nil
+}
+
+// RevokeLinkInvite - Revokes an invite of a link
+func (s *Server) RevokeLinkInvite(ctx context.Context, request RevokeLinkInviteRequestObject) (RevokeLinkInviteResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return RevokeLinkInvite400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if err := s.linkService.RevokeInvite(ctx, *issuerDID, request.Id, request.InviteID); err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return RevokeLinkInvite404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, services.ErrLinkInviteNotFound) {
+			return RevokeLinkInvite404JSONResponse{N404JSONResponse{Message: "invite not found or already revoked"}}, nil
+		}
+		log.Error(ctx, "revoking link invite", "err", err, "id", request.Id, "invite", request.InviteID)
+		return RevokeLinkInvite500JSONResponse{N500JSONResponse{Message: "error revoking link invite"}}, nil
+	}
+	return RevokeLinkInvite200JSONResponse{Message: "invite revoked"}, nil
+}
+
+// GetLinkUsage - Returns the credentials issued by a link
+func (s *Server) GetLinkUsage(ctx context.Context, request GetLinkUsageRequestObject) (GetLinkUsageResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetLinkUsage400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	usages, err := s.linkService.GetUsage(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkUsage404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link usage", "err", err, "id", request.Id)
+		return GetLinkUsage500JSONResponse{N500JSONResponse{Message: "error getting link usage"}}, nil
+	}
+	res := make(GetLinkUsage200JSONResponse, len(usages))
+	for i, usage := range usages {
+		res[i] = LinkUsage{
+			HolderDID:    usage.HolderDID,
+			CredentialID: usage.ClaimID,
+			CreatedAt:    TimeUTC(usage.CreatedAt),
+		}
+	}
+	return res, nil
+}
+
+// GetLinkStats - Returns the events of the funnel of a link by buckets of time
+func (s *Server) GetLinkStats(ctx context.Context, request GetLinkStatsRequestObject) (GetLinkStatsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetLinkStats400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	req := newLinkStatsRequest(request.Params.From, request.Params.To, (*string)(request.Params.Interval))
+	req.LinkID = &request.Id
+	stats, err := s.linkService.GetStats(ctx, *issuerDID, req)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkStats404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidLinkStats) {
+			return GetLinkStats400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting link stats", "err", err, "id", request.Id)
+		return GetLinkStats500JSONResponse{N500JSONResponse{Message: "error getting link stats"}}, nil
+	}
+	return GetLinkStats200JSONResponse(toLinkStatsResponse(stats)), nil
+}
+
+// newLinkStatsRequest returns the stats of the last 30 days by day, unless the range or the interval are given
+func newLinkStatsRequest(from *time.Time, to *time.Time, interval *string) ports.LinkStatsRequest {
+	req := ports.LinkStatsRequest{To: time.Now().UTC(), Interval: domain.LinkStatsDay}
+	if to != nil {
+		req.To = *to
+	}
+	req.From = req.To.AddDate(0, 0, -30)
+	if from != nil {
+		req.From = *from
+	}
+	if interval != nil {
+		req.Interval = domain.LinkStatsInterval(*interval)
+	}
+	return req
+}
+
+// UploadLinkAllowlist - Adds the entries of a JSON or CSV body to the allowlist of a link
+func (s *Server) UploadLinkAllowlist(ctx context.Context, request UploadLinkAllowlistRequestObject) (UploadLinkAllowlistResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return UploadLinkAllowlist400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	var entries []*domain.LinkAllowlistEntry
+	switch {
+	case request.JSONBody != nil:
+		entries = make([]*domain.LinkAllowlistEntry, 0, len(*request.JSONBody))
+		for _, reqEntry := range *request.JSONBody {
+			entry, err := domain.NewLinkAllowlistEntry(request.Id, domain.LinkAllowlistKeyType(reqEntry.KeyType), reqEntry.Key, domain.CredentialSubject(reqEntry.CredentialSubject))
+			if err != nil {
+				return UploadLinkAllowlist400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+			entries = append(entries, entry)
+		}
+	case request.Body != nil:
+		if entries, err = domain.ParseLinkAllowlistCSV(request.Body, request.Id); err != nil {
+			return UploadLinkAllowlist400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+	default:
+		return UploadLinkAllowlist400JSONResponse{N400JSONResponse{Message: "the allowlist must be sent as application/json or text/csv"}}, nil
+	}
+
+	entries, err = s.linkService.UploadAllowlist(ctx, *issuerDID, request.Id, entries)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return UploadLinkAllowlist404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidLinkAllowlist) || errors.Is(err, repositories.ErrLinkAllowlistEntryDuplicated) {
+			return UploadLinkAllowlist400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "uploading link allowlist", "err", err, "id", request.Id)
+		return UploadLinkAllowlist500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UploadLinkAllowlist201JSONResponse(toLinkAllowlistEntries(entries)), nil
+}
+
+func toLinkAllowlistEntries(entries []*domain.LinkAllowlistEntry) []LinkAllowlistEntry {
+	res := make([]LinkAllowlistEntry, len(entries))
+	for i, entry := range entries {
+		var claimedAt *TimeUTC
+		if entry.ClaimedAt != nil {
+			claimedAt = common.ToPointer(TimeUTC(*entry.ClaimedAt))
+		}
+		res[i] = LinkAllowlistEntry{
+			Id:                entry.ID,
+			KeyType:           LinkAllowlistKeyType(entry.KeyType),
+			Key:               entry.Key,
+			CredentialSubject: CredentialSubject(entry.CredentialSubject),
+			HolderDID:         entry.HolderDID,
+			CredentialID:      entry.ClaimID,
+			ClaimedAt:         claimedAt,
+			CreatedAt:         TimeUTC(entry.CreatedAt),
+		}
+	}
+	return res
+}
+
+func toLinkInvites(invites []*domain.LinkInvite) []LinkInvite {
+	res := make([]LinkInvite, len(invites))
+	for i, invite := range invites {
+		res[i] = LinkInvite{
+			Id:            invite.ID,
+			Code:          invite.Code,
+			MaxUses:       invite.MaxUses,
+			Uses:          invite.Uses,
+			Revoked:       invite.IsRevoked(),
+			CreatedAt:     TimeUTC(invite.CreatedAt),
+			DeepLink:      invite.DeepLink,
+			UniversalLink: invite.UniversalLink,
+		}
+	}
+	return res
+}
+
 func toDisplayMethodService(s *DisplayMethod) *verifiable.DisplayMethod {
 	if s == nil {
 		return nil
@@ -222,3 +587,64 @@ func toDisplayMethodService(s *DisplayMethod) *verifiable.DisplayMethod {
 		Type: verifiable.DisplayMethodType(s.Type),
 	}
 }
+
+func toLinkAccess(req *CreateLinkRequest) ports.LinkAccess {
+	var access ports.LinkAccess
+	if req.Allowlist != nil {
+		access.Allowlist = *req.Allowlist
+	}
+	if req.InviteOnly != nil {
+		access.InviteOnly = *req.InviteOnly
+	}
+	return access
+}
+
+func toLinkDerivation(d *LinkDerivation) *domain.LinkDerivation {
+	if d == nil {
+		return nil
+	}
+	derivation := &domain.LinkDerivation{
+		ProofRequests: toProofRequests(d.ProofRequests),
+		Attributes:    make([]domain.LinkDerivedAttribute, len(d.Attributes)),
+	}
+	for i, attr := range d.Attributes {
+		derivation.Attributes[i] = domain.LinkDerivedAttribute{
+			Attribute: attr.Attribute,
+			Rule:      domain.LinkDerivationRule(attr.Rule),
+		}
+		if attr.Field != nil {
+			derivation.Attributes[i].Field = *attr.Field
+		}
+		if attr.Values != nil {
+			derivation.Attributes[i].Values = *attr.Values
+		}
+		if attr.Default != nil {
+			derivation.Attributes[i].Default = *attr.Default
+		}
+		if attr.MinAge != nil {
+			derivation.Attributes[i].MinAge = *attr.MinAge
+		}
+		if attr.Value != nil {
+			derivation.Attributes[i].Value = *attr.Value
+		}
+	}
+	return derivation
+}
+
+func toProofRequests(reqs []ZeroKnowledgeProofRequest) []protocol.ZeroKnowledgeProofRequest {
+	proofRequests := make([]protocol.ZeroKnowledgeProofRequest, len(reqs))
+	for i, req := range reqs {
+		proofRequests[i] = protocol.ZeroKnowledgeProofRequest{
+			CircuitID: req.CircuitId,
+			Optional:  req.Optional,
+			Query:     req.Query,
+		}
+		if req.Id != nil {
+			proofRequests[i].ID = *req.Id
+		}
+		if req.Params != nil {
+			proofRequests[i].Params = *req.Params
+		}
+	}
+	return proofRequests
+}
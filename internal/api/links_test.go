@@ -21,6 +21,7 @@ import (
 	"github.com/wakeup-labs/issuer-node/internal/common"
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
 	"github.com/wakeup-labs/issuer-node/internal/db/tests"
 )
 
@@ -228,7 +229,7 @@ func TestServer_CreateLink(t *testing.T) {
 	}
 }
 
-func TestServer_ActivateLink(t *testing.T) {
+func TestServer_UpdateLink(t *testing.T) {
 	const (
 		method     = "opid"
 		blockchain = "optimism"
@@ -248,13 +249,13 @@ func TestServer_ActivateLink(t *testing.T) {
 	assert.NoError(t, err)
 
 	tomorrow := time.Now().Add(24 * time.Hour)
-	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, CredentialSubject{"birthday": 19790911, "documentType": 12}, nil, nil)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, CredentialSubject{"birthday": 19790911, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
 
 	type expected struct {
-		response ActivateLinkResponseObject
+		response UpdateLinkResponseObject
 		httpCode int
 	}
 
@@ -262,7 +263,7 @@ func TestServer_ActivateLink(t *testing.T) {
 		name     string
 		id       uuid.UUID
 		auth     func() (string, string)
-		body     ActivateLinkJSONBody
+		body     UpdateLinkJSONRequestBody
 		expected expected
 	}
 
@@ -279,11 +280,11 @@ func TestServer_ActivateLink(t *testing.T) {
 			name: "Claim link does not exist",
 			auth: authOk,
 			id:   uuid.New(),
-			body: ActivateLinkJSONBody{
-				Active: true,
+			body: UpdateLinkJSONRequestBody{
+				Active: common.ToPointer(true),
 			},
 			expected: expected{
-				response: ActivateLink400JSONResponse{N400JSONResponse{Message: "link does not exist"}},
+				response: UpdateLink400JSONResponse{N400JSONResponse{Message: "link does not exist"}},
 				httpCode: http.StatusBadRequest,
 			},
 		},
@@ -291,11 +292,11 @@ func TestServer_ActivateLink(t *testing.T) {
 			name: "Claim link already activated",
 			auth: authOk,
 			id:   link.ID,
-			body: ActivateLinkJSONBody{
-				Active: true,
+			body: UpdateLinkJSONRequestBody{
+				Active: common.ToPointer(true),
 			},
 			expected: expected{
-				response: ActivateLink400JSONResponse{N400JSONResponse{Message: "link is already active"}},
+				response: UpdateLink400JSONResponse{N400JSONResponse{Message: "link is already active"}},
 				httpCode: http.StatusBadRequest,
 			},
 		},
@@ -303,11 +304,11 @@ func TestServer_ActivateLink(t *testing.T) {
 			name: "Happy path",
 			auth: authOk,
 			id:   link.ID,
-			body: ActivateLinkJSONBody{
-				Active: false,
+			body: UpdateLinkJSONRequestBody{
+				Active: common.ToPointer(false),
 			},
 			expected: expected{
-				response: ActivateLink200JSONResponse{Message: "Link updated"},
+				response: UpdateLink200JSONResponse{Message: "Link updated"},
 				httpCode: http.StatusOK,
 			},
 		},
@@ -315,14 +316,77 @@ func TestServer_ActivateLink(t *testing.T) {
 			name: "Claim link already deactivated",
 			auth: authOk,
 			id:   link.ID,
-			body: ActivateLinkJSONBody{
-				Active: false,
+			body: UpdateLinkJSONRequestBody{
+				Active: common.ToPointer(false),
 			},
 			expected: expected{
-				response: ActivateLink400JSONResponse{N400JSONResponse{Message: "link is already inactive"}},
+				response: UpdateLink400JSONResponse{N400JSONResponse{Message: "link is already inactive"}},
 				httpCode: http.StatusBadRequest,
 			},
 		},
+		{
+			name: "Invalid credential subject",
+			auth: authOk,
+			id:   link.ID,
+			body: UpdateLinkJSONRequestBody{
+				CredentialSubject: &CredentialSubject{"birthday": "yesterday", "documentType": 12},
+			},
+			expected: expected{
+				response: UpdateLink400JSONResponse{N400JSONResponse{Message: "credential subject does not match the provided schema"}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Invalid limited claims",
+			auth: authOk,
+			id:   link.ID,
+			body: UpdateLinkJSONRequestBody{
+				LimitedClaims: common.ToPointer(0),
+			},
+			expected: expected{
+				response: UpdateLink400JSONResponse{N400JSONResponse{Message: "invalid link update: limitedClaims must be higher than 0"}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Refresh service without credential expiration",
+			auth: authOk,
+			id:   link.ID,
+			body: UpdateLinkJSONRequestBody{
+				RefreshService: &RefreshService{Id: "https://refresh.example.com", Type: Iden3RefreshService2023},
+			},
+			expected: expected{
+				response: UpdateLink400JSONResponse{N400JSONResponse{Message: "credential request with refresh service lacks expiration time"}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Expiration in the past",
+			auth: authOk,
+			id:   link.ID,
+			body: UpdateLinkJSONRequestBody{
+				Expiration: common.ToPointer(time.Now().Add(-time.Hour)),
+			},
+			expected: expected{
+				response: UpdateLink400JSONResponse{N400JSONResponse{Message: "invalid claimLinkExpiration. Cannot be a date time prior current time."}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Update fields and activate",
+			auth: authOk,
+			id:   link.ID,
+			body: UpdateLinkJSONRequestBody{
+				Active:            common.ToPointer(true),
+				LimitedClaims:     common.ToPointer(20),
+				CredentialSubject: &CredentialSubject{"birthday": 19790911, "documentType": 13},
+				Unset:             &[]UpdateLinkRequestUnset{UpdateLinkRequestUnsetExpiration},
+			},
+			expected: expected{
+				response: UpdateLink200JSONResponse{Message: "Link updated"},
+				httpCode: http.StatusOK,
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
@@ -340,17 +404,24 @@ func TestServer_ActivateLink(t *testing.T) {
 			case http.StatusOK:
 				var response GenericMessage
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
-				expected, ok := tc.expected.response.(ActivateLink200JSONResponse)
+				expected, ok := tc.expected.response.(UpdateLink200JSONResponse)
 				assert.True(t, ok)
 				assert.Equal(t, expected.Message, response.Message)
 
 			case http.StatusBadRequest:
-				var response ActivateLink400JSONResponse
+				var response UpdateLink400JSONResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				assert.EqualValues(t, tc.expected.response, response)
 			}
 		})
 	}
+
+	updated, err := server.Services.links.GetByID(ctx, *did, link.ID, cfg.ServerUrl)
+	require.NoError(t, err)
+	assert.True(t, updated.Active)
+	assert.Nil(t, updated.ValidUntil)
+	assert.Equal(t, common.ToPointer(20), updated.MaxIssuance)
+	assert.EqualValues(t, json.Number("13"), updated.CredentialSubject["documentType"])
 }
 
 // TestServer_GetLink does an end 2 end test for the get link endpoint.
@@ -378,11 +449,11 @@ func TestServer_GetLink(t *testing.T) {
 	tomorrow := time.Now().Add(24 * time.Hour)
 	yesterday := time.Now().Add(-24 * time.Hour)
 
-	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	require.NoError(t, err)
 	hash, _ := link.Schema.Hash.MarshalText()
 
-	linkExpired, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	linkExpired, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -543,6 +614,8 @@ func TestServer_GetAllLinks(t *testing.T) {
 			ID:   "https://display.xyz",
 			Type: verifiable.Iden3BasicDisplayMethodV1,
 		},
+		nil,
+		ports.LinkAccess{},
 	)
 	require.NoError(t, err)
 	linkActive := getLinkResponse(link1)
@@ -558,13 +631,15 @@ func TestServer_GetAllLinks(t *testing.T) {
 			ID:   "https://display.xyz",
 			Type: verifiable.Iden3BasicDisplayMethodV1,
 		},
+		nil,
+		ports.LinkAccess{},
 	)
 	require.NoError(t, err)
 	linkExpired := getLinkResponse(link2)
 	require.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 
-	link3, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, &tomorrow, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link3, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, &tomorrow, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	link3.Active = false
 	require.NoError(t, err)
 	require.NoError(t, server.Services.links.Activate(ctx, *did, link3.ID, false))
@@ -575,12 +650,14 @@ func TestServer_GetAllLinks(t *testing.T) {
 	handler := getHandler(ctx, server)
 	type expected struct {
 		response []Link
+		total    uint
 		httpCode int
 	}
 	type testConfig struct {
 		name     string
 		query    *string
 		status   *GetLinksParamsStatus
+		params   string
 		auth     func() (string, string)
 		expected expected
 	}
@@ -606,7 +683,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 			auth: authOk,
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkInactive, linkExpired, linkActive},
+				response: []Link{linkInactive, linkExpired, linkActive},
 			},
 		},
 		{
@@ -615,7 +692,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 			status: common.ToPointer(GetLinksParamsStatus("all")),
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkInactive, linkExpired, linkActive},
+				response: []Link{linkInactive, linkExpired, linkActive},
 			},
 		},
 		{
@@ -624,7 +701,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 			status: common.ToPointer(GetLinksParamsStatus("active")),
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkActive},
+				response: []Link{linkActive},
 			},
 		},
 		{
@@ -633,7 +710,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 			status: common.ToPointer(GetLinksParamsStatus("exceeded")),
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkInactive, linkExpired},
+				response: []Link{linkInactive, linkExpired},
 			},
 		},
 		{
@@ -642,7 +719,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 			status: common.ToPointer(GetLinksParamsStatus("inactive")),
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkInactive},
+				response: []Link{linkInactive},
 			},
 		},
 		{
@@ -652,7 +729,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 			status: common.ToPointer(GetLinksParamsStatus("exceeded")),
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkInactive, linkExpired},
+				response: []Link{linkInactive, linkExpired},
 			},
 		},
 		{
@@ -662,7 +739,60 @@ func TestServer_GetAllLinks(t *testing.T) {
 			status: common.ToPointer(GetLinksParamsStatus("exceeded")),
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkInactive, linkExpired},
+				response: []Link{linkInactive, linkExpired},
+			},
+		},
+		{
+			name:   "Wrong page",
+			auth:   authOk,
+			params: "page=0",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:   "Wrong sort",
+			auth:   authOk,
+			params: "sort=-schemaHash",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:   "Happy path. Second page, sorted by creation",
+			auth:   authOk,
+			params: "page=2&max_results=2&sort=createdAt",
+			expected: expected{
+				httpCode: http.StatusOK,
+				response: []Link{linkInactive},
+				total:    3,
+			},
+		},
+		{
+			name:   "Happy path. Sorted by expiration, the latest first",
+			auth:   authOk,
+			params: "sort=-validUntil,createdAt",
+			expected: expected{
+				httpCode: http.StatusOK,
+				response: []Link{linkActive, linkExpired, linkInactive},
+			},
+		},
+		{
+			name:   "Happy path. Links of other schema",
+			auth:   authOk,
+			params: "schemaID=" + uuid.NewString(),
+			expected: expected{
+				httpCode: http.StatusOK,
+				response: []Link{},
+			},
+		},
+		{
+			name:   "Happy path. Links created before",
+			auth:   authOk,
+			params: "createdTo=" + url.QueryEscape(link2.CreatedAt.Format(time.RFC3339Nano)),
+			expected: expected{
+				httpCode: http.StatusOK,
+				response: []Link{linkActive},
 			},
 		},
 		{
@@ -672,7 +802,7 @@ func TestServer_GetAllLinks(t *testing.T) {
 			status: common.ToPointer(GetLinksParamsStatus("exceeded")),
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLinks200JSONResponse{linkInactive, linkExpired},
+				response: []Link{linkInactive, linkExpired},
 			},
 		},
 	} {
@@ -685,6 +815,9 @@ func TestServer_GetAllLinks(t *testing.T) {
 			if tc.query != nil {
 				endpoint.RawQuery = endpoint.RawQuery + "&query=" + *tc.query
 			}
+			if tc.params != "" {
+				endpoint.RawQuery = endpoint.RawQuery + "&" + tc.params
+			}
 
 			req, err := http.NewRequest(http.MethodGet, endpoint.String(), nil)
 			req.SetBasicAuth(tc.auth())
@@ -697,8 +830,13 @@ func TestServer_GetAllLinks(t *testing.T) {
 			case http.StatusOK:
 				var response GetLinks200JSONResponse
 				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
-				if assert.Equal(t, len(tc.expected.response), len(response)) {
-					for i, resp := range response {
+				if tc.expected.total != 0 {
+					assert.Equal(t, tc.expected.total, response.Meta.Total)
+				} else {
+					assert.Equal(t, uint(len(tc.expected.response)), response.Meta.Total)
+				}
+				if assert.Equal(t, len(tc.expected.response), len(response.Items)) {
+					for i, resp := range response.Items {
 						assert.Equal(t, tc.expected.response[i].Id, resp.Id)
 						assert.Equal(t, tc.expected.response[i].Status, resp.Status)
 						assert.Equal(t, tc.expected.response[i].IssuedClaims, resp.IssuedClaims)
@@ -746,7 +884,7 @@ func TestServer_DeleteLink(t *testing.T) {
 
 	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
-	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	assert.NoError(t, err)
 	handler := getHandler(ctx, server)
 
@@ -844,7 +982,7 @@ func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
 
 	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
-	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	assert.NoError(t, err)
 	handler := getHandler(ctx, server)
 
@@ -930,11 +1068,11 @@ func TestServer_CreateLinkOffer(t *testing.T) {
 	validUntil := common.ToPointer(time.Now().Add(365 * 24 * time.Hour))
 	credentialExpiration := common.ToPointer(validUntil.Add(365 * 24 * time.Hour))
 
-	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	assert.NoError(t, err)
 
 	yesterday := time.Now().Add(-24 * time.Hour)
-	linkExpired, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	linkExpired, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -1037,3 +1175,721 @@ func TestServer_CreateLinkOffer(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_UploadLinkAllowlist(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		uri        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCCountryOfResidenceCredential"
+		holderDID  = "did:iden3:polygon:amoy:x7Z95VkUuyo6mqraJw2VGwCfqTzdqhM1RVjRHzcpK"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(uri, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription")))
+	require.NoError(t, err)
+	link, err := server.Services.links.Save(ctx, *did, nil, nil, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{Allowlist: true})
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		message  string
+		entries  int
+	}
+
+	type testConfig struct {
+		name        string
+		auth        func() (string, string)
+		linkID      uuid.UUID
+		contentType string
+		body        string
+		expected    expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:        "No auth header",
+			auth:        authWrong,
+			linkID:      link.ID,
+			contentType: "text/csv",
+			body:        "did,documentType\n" + holderDID + ",2\n",
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:        "Wrong link id",
+			auth:        authOk,
+			linkID:      uuid.New(),
+			contentType: "text/csv",
+			body:        "did,documentType\n" + holderDID + ",2\n",
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "link not found",
+			},
+		},
+		{
+			name:        "Wrong key type",
+			auth:        authOk,
+			linkID:      link.ID,
+			contentType: "text/csv",
+			body:        "email,documentType\nme@example.com,2\n",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid link allowlist: the first column must be one of did, emailHash or code",
+			},
+		},
+		{
+			name:        "Wrong attribute type",
+			auth:        authOk,
+			linkID:      link.ID,
+			contentType: "text/csv",
+			body:        "code,documentType\nABC123,two\n",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid link allowlist: ABC123: attribute documentType must be an integer",
+			},
+		},
+		{
+			name:        "Happy path with csv",
+			auth:        authOk,
+			linkID:      link.ID,
+			contentType: "text/csv",
+			body:        "did,documentType\n" + holderDID + ",2\n",
+			expected: expected{
+				httpCode: http.StatusCreated,
+				entries:  1,
+			},
+		},
+		{
+			name:        "Happy path with json",
+			auth:        authOk,
+			linkID:      link.ID,
+			contentType: "application/json",
+			body:        `[{"keyType": "code", "key": "ABC123", "credentialSubject": {"documentType": 3}}, {"keyType": "emailHash", "key": "5F4DCC3B5AA765D61D8327DEB882CF99", "credentialSubject": {}}]`,
+			expected: expected{
+				httpCode: http.StatusCreated,
+				entries:  2,
+			},
+		},
+		{
+			name:        "Duplicated key",
+			auth:        authOk,
+			linkID:      link.ID,
+			contentType: "application/json",
+			body:        `[{"keyType": "code", "key": "ABC123", "credentialSubject": {"documentType": 4}}]`,
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "the key or the holder is already on the link allowlist: ABC123",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			apiURL := fmt.Sprintf("/v2/identities/%s/credentials/links/%s/allowlist", did, tc.linkID)
+
+			req, err := http.NewRequest(http.MethodPost, apiURL, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tc.contentType)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusCreated:
+				var response UploadLinkAllowlist201JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Len(t, response, tc.expected.entries)
+			case http.StatusBadRequest:
+				var response UploadLinkAllowlist400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			case http.StatusNotFound:
+				var response UploadLinkAllowlist404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	t.Run("Get allowlist", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/links/%s/allowlist", did, link.ID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response GetLinkAllowlist200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response, 3)
+		byKey := make(map[string]LinkAllowlistEntry, len(response))
+		for _, entry := range response {
+			byKey[entry.Key] = entry
+		}
+		require.Contains(t, byKey, holderDID)
+		assert.Equal(t, common.ToPointer(holderDID), byKey[holderDID].HolderDID)
+		assert.EqualValues(t, 2, byKey[holderDID].CredentialSubject["documentType"])
+		require.Contains(t, byKey, "5f4dcc3b5aa765d61d8327deb882cf99")
+		assert.Nil(t, byKey["5f4dcc3b5aa765d61d8327deb882cf99"].HolderDID)
+		assert.Nil(t, byKey["5f4dcc3b5aa765d61d8327deb882cf99"].ClaimedAt)
+
+		allowlistLink, err := server.Services.links.GetByID(ctx, *did, link.ID, cfg.ServerUrl)
+		require.NoError(t, err)
+		assert.True(t, allowlistLink.Allowlist)
+	})
+
+	t.Run("Offer by allowlist key", func(t *testing.T) {
+		offer := func(key string) int {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials/links/%s/offer?allowlistKey=%s", did, link.ID, url.QueryEscape(key)), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			return rr.Code
+		}
+		assert.Equal(t, http.StatusOK, offer("5f4dcc3b5aa765d61d8327deb882cf99"))
+		assert.Equal(t, http.StatusOK, offer("5F4DCC3B5AA765D61D8327DEB882CF99"))
+		assert.Equal(t, http.StatusOK, offer(" ABC123 "))
+		assert.Equal(t, http.StatusNotFound, offer("abc123"))
+	})
+}
+
+func TestServer_GetLinkUsage(t *testing.T) {
+	const (
+		method      = "opid"
+		blockchain  = "optimism"
+		network     = "sepolia"
+		BJJ         = "BJJ"
+		uri         = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType  = "KYCCountryOfResidenceCredential"
+		holderDID   = "did:iden3:polygon:amoy:x7Z95VkUuyo6mqraJw2VGwCfqTzdqhM1RVjRHzcpK"
+		otherHolder = "did:iden3:polygon:amoy:xCRp75DgAdS63W65fmXHz6p9DwdonuRU9e46DifhX"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(uri, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription")))
+	require.NoError(t, err)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(1), nil, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
+	require.NoError(t, err)
+
+	holder, err := w3c.ParseDID(holderDID)
+	require.NoError(t, err)
+	other, err := w3c.ParseDID(otherHolder)
+	require.NoError(t, err)
+	_, err = server.Services.links.IssueOrFetchClaim(ctx, *did, *holder, link.ID, nil, "host_url")
+	require.NoError(t, err)
+	_, err = server.Services.links.IssueOrFetchClaim(ctx, *did, *other, link.ID, nil, "host_url")
+	require.ErrorIs(t, err, services.ErrLinkMaxExceeded)
+
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		message  string
+		holders  []string
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		linkID   uuid.UUID
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:   "No auth header",
+			auth:   authWrong,
+			linkID: link.ID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "Wrong link id",
+			auth:   authOk,
+			linkID: uuid.New(),
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "link not found",
+			},
+		},
+		{
+			name:   "Happy path",
+			auth:   authOk,
+			linkID: link.ID,
+			expected: expected{
+				httpCode: http.StatusOK,
+				holders:  []string{holderDID},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/links/%s/usage", did, tc.linkID), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response GetLinkUsage200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				require.Len(t, response, len(tc.expected.holders))
+				for i, usage := range response {
+					assert.Equal(t, tc.expected.holders[i], usage.HolderDID)
+					assert.NotEqual(t, uuid.Nil, usage.CredentialID)
+				}
+			case http.StatusNotFound:
+				var response GetLinkUsage404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+}
+
+func TestServer_GetLinkStats(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		uri        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCCountryOfResidenceCredential"
+		holderDID  = "did:iden3:polygon:amoy:x7Z95VkUuyo6mqraJw2VGwCfqTzdqhM1RVjRHzcpK"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(uri, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription")))
+	require.NoError(t, err)
+	link, err := server.Services.links.Save(ctx, *did, nil, nil, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
+	require.NoError(t, err)
+
+	holder, err := w3c.ParseDID(holderDID)
+	require.NoError(t, err)
+	_, err = server.Services.links.CreateQRCode(ctx, *did, link.ID, "host_url")
+	require.NoError(t, err)
+	_, err = server.Services.links.CreateQRCode(ctx, *did, link.ID, "host_url")
+	require.NoError(t, err)
+	_, err = server.Services.links.IssueOrFetchClaim(ctx, *did, *holder, link.ID, nil, "host_url")
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		message  string
+		buckets  int
+		totals   LinkEventCounts
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		url      string
+		expected expected
+	}
+
+	linkURL := fmt.Sprintf("/v2/identities/%s/credentials/links/%s/stats", did, link.ID)
+	schemaURL := fmt.Sprintf("/v2/identities/%s/schemas/%s/stats", did, importedSchema.ID)
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			url:  linkURL,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Wrong link id",
+			auth: authOk,
+			url:  fmt.Sprintf("/v2/identities/%s/credentials/links/%s/stats", did, uuid.New()),
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "link not found",
+			},
+		},
+		{
+			name: "Wrong schema id",
+			auth: authOk,
+			url:  fmt.Sprintf("/v2/identities/%s/schemas/%s/stats", did, uuid.New()),
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "schema not found",
+			},
+		},
+		{
+			name: "Wrong range",
+			auth: authOk,
+			url:  linkURL + "?from=2024-11-06T00:00:00Z&to=2024-11-04T00:00:00Z",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid link stats: from must be before to",
+			},
+		},
+		{
+			name: "Too many buckets",
+			auth: authOk,
+			url:  linkURL + "?from=2020-01-01T00:00:00Z&interval=hour",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid link stats: the range has more than 1000 buckets of one hour",
+			},
+		},
+		{
+			name: "Happy path, last 30 days of the link",
+			auth: authOk,
+			url:  linkURL,
+			expected: expected{
+				httpCode: http.StatusOK,
+				buckets:  31,
+				totals:   LinkEventCounts{QrDisplayed: 2, CredentialIssued: 1, OfferCreated: 1},
+			},
+		},
+		{
+			name: "Happy path, schema by month",
+			auth: authOk,
+			url:  schemaURL + "?interval=month&from=" + url.QueryEscape(domain.LinkStatsMonth.Truncate(time.Now()).Format(time.RFC3339)),
+			expected: expected{
+				httpCode: http.StatusOK,
+				buckets:  1,
+				totals:   LinkEventCounts{QrDisplayed: 2, CredentialIssued: 1, OfferCreated: 1},
+			},
+		},
+		{
+			name: "Happy path, range without events",
+			auth: authOk,
+			url:  linkURL + "?from=2024-11-04T00:00:00Z&to=2024-11-06T00:00:00Z&interval=hour",
+			expected: expected{
+				httpCode: http.StatusOK,
+				buckets:  48,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response GetLinkStats200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Len(t, response.Buckets, tc.expected.buckets)
+				assert.Equal(t, tc.expected.totals, response.Totals)
+				var totals LinkEventCounts
+				for _, bucket := range response.Buckets {
+					totals.QrDisplayed += bucket.Counts.QrDisplayed
+					totals.CredentialIssued += bucket.Counts.CredentialIssued
+					totals.OfferCreated += bucket.Counts.OfferCreated
+				}
+				assert.Equal(t, tc.expected.totals, totals)
+			case http.StatusBadRequest:
+				var response GetLinkStats400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			case http.StatusNotFound:
+				var response GetLinkStats404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+}
+
+func TestServer_LinkInvites(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		uri        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCCountryOfResidenceCredential"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(uri, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription")))
+	require.NoError(t, err)
+	link, err := server.Services.links.Save(ctx, *did, nil, nil, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		message  string
+		invites  int
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		linkID   uuid.UUID
+		body     CreateLinkInvitesRequest
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:   "No auth header",
+			auth:   authWrong,
+			linkID: link.ID,
+			body:   CreateLinkInvitesRequest{Count: 2},
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "Wrong link id",
+			auth:   authOk,
+			linkID: uuid.New(),
+			body:   CreateLinkInvitesRequest{Count: 2},
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "link not found",
+			},
+		},
+		{
+			name:   "Too many invites",
+			auth:   authOk,
+			linkID: link.ID,
+			body:   CreateLinkInvitesRequest{Count: 1001},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid link invites: count must be between 1 and 1000",
+			},
+		},
+		{
+			name:   "Invalid max uses",
+			auth:   authOk,
+			linkID: link.ID,
+			body:   CreateLinkInvitesRequest{Count: 2, MaxUses: common.ToPointer(0)},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid link invites: maxUses must be higher than 0",
+			},
+		},
+		{
+			name:   "Happy path",
+			auth:   authOk,
+			linkID: link.ID,
+			body:   CreateLinkInvitesRequest{Count: 2},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				invites:  2,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			apiURL := fmt.Sprintf("/v2/identities/%s/credentials/links/%s/invites", did, tc.linkID)
+
+			req, err := http.NewRequest(http.MethodPost, apiURL, tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusCreated:
+				var response CreateLinkInvites201JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				require.Len(t, response, tc.expected.invites)
+				for _, invite := range response {
+					assert.Equal(t, 1, invite.MaxUses)
+					assert.Equal(t, 0, invite.Uses)
+					assert.False(t, invite.Revoked)
+					assert.Contains(t, invite.UniversalLink, url.QueryEscape("&invite="+invite.Code))
+				}
+			case http.StatusBadRequest:
+				var response CreateLinkInvites400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			case http.StatusNotFound:
+				var response CreateLinkInvites404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/links/%s/invites", did, link.ID), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var invites GetLinkInvites200JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &invites))
+	require.Len(t, invites, 2)
+
+	t.Run("Invite only", func(t *testing.T) {
+		got, err := server.Services.links.GetByID(ctx, *did, link.ID, cfg.ServerUrl)
+		require.NoError(t, err)
+		assert.False(t, got.InviteOnly)
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/v2/identities/%s/credentials/links/%s", did, link.ID), tests.JSONBody(t, UpdateLinkRequest{InviteOnly: common.ToPointer(true)}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		got, err = server.Services.links.GetByID(ctx, *did, link.ID, cfg.ServerUrl)
+		require.NoError(t, err)
+		assert.True(t, got.InviteOnly)
+	})
+
+	qrStore := func(code string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/qr-store?id=%s&issuer=%s&invite=%s", link.ID, did, code), nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Invite qr", func(t *testing.T) {
+		rr := qrStore(invites[0].Code)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response protocol.AuthorizationRequestMessage
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.True(t, strings.HasSuffix(response.Body.CallbackURL, "&inviteCode="+invites[0].Code))
+
+		assert.Equal(t, http.StatusGone, qrStore("WRONGCODE").Code)
+	})
+
+	t.Run("Revoke invite", func(t *testing.T) {
+		revoke := func(inviteID uuid.UUID) int {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/v2/identities/%s/credentials/links/%s/invites/%s", did, link.ID, inviteID), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			return rr.Code
+		}
+		assert.Equal(t, http.StatusOK, revoke(invites[0].Id))
+		assert.Equal(t, http.StatusNotFound, revoke(invites[0].Id))
+		assert.Equal(t, http.StatusNotFound, revoke(uuid.New()))
+
+		assert.Equal(t, http.StatusGone, qrStore(invites[0].Code).Code)
+		assert.Equal(t, http.StatusOK, qrStore(invites[1].Code).Code)
+	})
+}
+
+func TestServer_CloneLink(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		uri        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCCountryOfResidenceCredential"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(uri, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription")))
+	require.NoError(t, err)
+	tomorrow := time.Now().Add(24 * time.Hour)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil,
+		&verifiable.DisplayMethod{ID: "https://display.xyz", Type: verifiable.Iden3BasicDisplayMethodV1}, nil, ports.LinkAccess{})
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	clone := func(auth func() (string, string), linkID uuid.UUID) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials/links/%s/clone", did, linkID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(auth())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("No auth header", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, clone(authWrong, link.ID).Code)
+	})
+
+	t.Run("Wrong link id", func(t *testing.T) {
+		rr := clone(authOk, uuid.New())
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		var response CloneLink404JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "link not found", response.Message)
+	})
+
+	t.Run("Expired link", func(t *testing.T) {
+		yesterday := time.Now().Add(-24 * time.Hour)
+		expired, err := server.Services.links.Save(ctx, *did, nil, &yesterday, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, ports.LinkAccess{})
+		require.NoError(t, err)
+
+		rr := clone(authOk, expired.ID)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var response CloneLink400JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "cannot clone an expired link", response.Message)
+	})
+
+	t.Run("Happy path", func(t *testing.T) {
+		rr := clone(authOk, link.ID)
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CloneLink201JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		clonedID, err := uuid.Parse(response.Id)
+		require.NoError(t, err)
+		assert.NotEqual(t, link.ID, clonedID)
+
+		cloned, err := server.Services.links.GetByID(ctx, *did, clonedID, cfg.ServerUrl)
+		require.NoError(t, err)
+		assert.Equal(t, link.SchemaID, cloned.SchemaID)
+		assert.Equal(t, link.MaxIssuance, cloned.MaxIssuance)
+		assert.Equal(t, link.CredentialSignatureProof, cloned.CredentialSignatureProof)
+		assert.Equal(t, link.DisplayMethod, cloned.DisplayMethod)
+		assert.EqualValues(t, json.Number("12"), cloned.CredentialSubject["documentType"])
+		assert.Equal(t, 0, cloned.IssuedClaims)
+		assert.True(t, cloned.Active)
+	})
+}
@@ -2,15 +2,18 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/hashicorp/vault/api"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
 	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/iden3/iden3comm/v2"
-	"github.com/iden3/iden3comm/v2/packers"
 	"github.com/iden3/iden3comm/v2/protocol"
 	"github.com/piprate/json-gold/ld"
 	"github.com/stretchr/testify/require"
@@ -18,15 +21,19 @@ import (
 	cache2 "github.com/wakeup-labs/issuer-node/internal/cache"
 	"github.com/wakeup-labs/issuer-node/internal/common"
 	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
 	"github.com/wakeup-labs/issuer-node/internal/core/services"
 	"github.com/wakeup-labs/issuer-node/internal/db"
 	"github.com/wakeup-labs/issuer-node/internal/db/tests"
 	"github.com/wakeup-labs/issuer-node/internal/errors"
+	"github.com/wakeup-labs/issuer-node/internal/gateways"
 	"github.com/wakeup-labs/issuer-node/internal/kms"
 	"github.com/wakeup-labs/issuer-node/internal/loader"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 	"github.com/wakeup-labs/issuer-node/internal/network"
+	"github.com/wakeup-labs/issuer-node/internal/notifications"
+	"github.com/wakeup-labs/issuer-node/internal/payments"
 	"github.com/wakeup-labs/issuer-node/internal/providers"
 	"github.com/wakeup-labs/issuer-node/internal/pubsub"
 	"github.com/wakeup-labs/issuer-node/internal/repositories"
@@ -152,9 +159,11 @@ func getHandler(ctx context.Context, server StrictServerInterface) http.Handler
 
 func middlewares(ctx context.Context) []StrictMiddlewareFunc {
 	usr, pass := authOk()
+	approverUsr, approverPass := authApprover()
 	return []StrictMiddlewareFunc{
 		LogMiddleware(ctx),
 		BasicAuthMiddleware(ctx, usr, pass),
+		ApproverAuthMiddleware(ctx, approverUsr, approverPass),
 	}
 }
 
@@ -162,6 +171,10 @@ func authOk() (string, string) {
 	return "user", "password"
 }
 
+func authApprover() (string, string) {
+	return "approver", "approver-password"
+}
+
 func authWrong() (string, string) {
 	return "", ""
 }
@@ -214,6 +227,37 @@ func NewPublisherMock() ports.Publisher {
 	return nil
 }
 
+// notificationGatewayMock accepts the messages for every device of the push service of the holder
+type notificationGatewayMock struct{}
+
+func (n *notificationGatewayMock) Notify(_ context.Context, _ json.RawMessage, userDIDDocument verifiable.DIDDocument) (*domain.UserNotificationResult, error) {
+	pushService, err := notifications.FindNotificationService(userDIDDocument)
+	if err != nil {
+		return nil, err
+	}
+	result := &domain.UserNotificationResult{}
+	for _, device := range pushService.Metadata.Devices {
+		result.Devices = append(result.Devices, domain.DeviceNotificationResult{Device: device, Status: domain.DeviceNotificationStatusSuccess})
+	}
+	return result, nil
+}
+
+// proofVerifierMock accepts the responses with a proof of every proof request, without verifying the proofs
+type proofVerifierMock struct{}
+
+func (p *proofVerifierMock) VerifyAuthResponse(_ context.Context, response protocol.AuthorizationResponseMessage, request protocol.AuthorizationRequestMessage, _ ...pubsignals.VerifyOpt) error {
+	for _, proofRequest := range request.Body.Scope {
+		found := false
+		for _, proof := range response.Body.Scope {
+			found = found || (proof.ID == proofRequest.ID && proof.CircuitID == proofRequest.CircuitID)
+		}
+		if !found {
+			return fmt.Errorf("proof for zk request id %v not found", proofRequest.ID)
+		}
+	}
+	return nil
+}
+
 func NewIdentityMock() ports.IdentityService { return nil }
 
 func NewClaimsMock() ports.ClaimService {
@@ -233,12 +277,17 @@ func NewLinkMock() ports.LinkService {
 }
 
 type repos struct {
+	agentMessages  ports.AgentMessageRepository
+	approvals      ports.ApprovalRepository
 	claims         ports.ClaimRepository
 	connection     ports.ConnectionRepository
+	connMessages   ports.ConnectionMessageRepository
 	identity       ports.IndentityRepository
 	idenMerkleTree ports.IdentityMerkleTreeRepository
 	identityState  ports.IdentityStateRepository
 	links          ports.LinkRepository
+	payments       ports.PaymentRepository
+	proposalRules  ports.ProposalRuleRepository
 	schemas        ports.SchemaRepository
 	sessions       ports.SessionRepository
 	revocation     ports.RevocationRepository
@@ -270,12 +319,17 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 		st = storage
 	}
 	repos := repos{
+		agentMessages:  repositories.NewAgentMessage(),
+		approvals:      repositories.NewApproval(),
 		claims:         repositories.NewClaim(),
 		connection:     repositories.NewConnection(),
+		connMessages:   repositories.NewConnectionMessage(),
 		identity:       repositories.NewIdentity(),
 		idenMerkleTree: repositories.NewIdentityMerkleTreeRepository(),
 		identityState:  repositories.NewIdentityState(),
 		links:          repositories.NewLink(*st),
+		payments:       repositories.NewPayment(),
+		proposalRules:  repositories.NewProposalRule(),
 		sessions:       repositories.NewSessionCached(cachex),
 		schemas:        repositories.NewSchema(*st),
 		revocation:     repositories.NewRevocation(),
@@ -286,6 +340,7 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 	networkResolver, err := network.NewResolver(context.Background(), cfg, keyStore, common.CreateFile(t))
 	require.NoError(t, err)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
+	onchainIssuer := gateways.NewOnchainIssuer(*networkResolver, cfg.PublishingKeyPath)
 
 	mtService := services.NewIdentityMerkleTrees(repos.idenMerkleTree)
 	qrService := services.NewQrStoreService(cachex)
@@ -294,18 +349,23 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 	connectionService := services.NewConnection(repos.connection, repos.claims, st)
 	schemaService := services.NewSchema(repos.schemas, schemaLoader)
 
-	mediaTypeManager := services.NewMediaTypeManager(
-		map[iden3comm.ProtocolMessage][]string{
-			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			protocol.RevocationStatusRequestMessageType: {"*"},
-		},
-		true,
-	)
+	mediaTypeManager := services.NewMediaTypeManagerWithPolicy(domain.DefaultMediaTypePolicy(), true)
 
-	claimsService := services.NewClaim(repos.claims, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	signingPolicy := services.NewSigningPolicy(cfg.SigningPolicy, repos.claims, repos.approvals, st)
+	claimsService := services.NewClaim(repos.claims, repos.links, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, onchainIssuer, mediaTypeManager, signingPolicy, cfg.UniversalLinks)
 	accountService := services.NewAccountService(*networkResolver)
-	linkService := services.NewLinkService(storage, claimsService, qrService, repos.claims, repos.links, repos.schemas, schemaLoader, repos.sessions, pubSub, identityService, *networkResolver, cfg.UniversalLinks)
-	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, NewPublisherMock(), NewPackageManagerMock(), *networkResolver, nil, schemaService, linkService)
+	linkService := services.NewLinkService(storage, claimsService, qrService, repos.claims, repos.links, repos.schemas, repos.payments, schemaLoader, repos.sessions, pubSub, identityService, *networkResolver, cfg.UniversalLinks)
+	publisher := NewPublisherMock()
+	approvalService := services.NewApproval(repos.approvals, signingPolicy, claimsService, publisher, st, cfg.HTTPBasicAuth, cfg.SigningPolicy)
+	agentPacker := services.NewAgentPacker(keyStore, identityService, claimsService, func(did string) (*verifiable.DIDDocument, error) {
+		return nil, fmt.Errorf("cannot resolve %s in tests", did)
+	}, cfg.Agent.PackedResponses)
+	proposalService := services.NewProposal(repos.proposalRules, repos.schemas, linkService, identityService, mediaTypeManager, st, cfg.ServerUrl)
+	paymentService := services.NewPayment(repos.payments, repos.schemas, linkService, identityService, mediaTypeManager, payments.NewVerifier(*networkResolver), *networkResolver, st, cfg.ServerUrl)
+	agentMessageService := services.NewAgentMessage(repos.agentMessages, st, cfg.Agent)
+	issuanceRequestService := services.NewIssuanceRequest(claimsService, identityService, connectionService, services.NewIssuanceApprover(cfg.IssuanceRequests), mediaTypeManager, cfg.ServerUrl)
+	connectionMessageService := services.NewConnectionMessage(repos.connMessages, connectionService, claimsService, agentMessageService, &notificationGatewayMock{}, identityService, mediaTypeManager, &proofVerifierMock{}, st, cfg.ServerUrl)
+	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, publisher, NewPackageManagerMock(), *networkResolver, nil, schemaService, linkService, signingPolicy, approvalService, agentPacker, proposalService, issuanceRequestService, paymentService, agentMessageService, connectionMessageService, mediaTypeManager)
 
 	return &testServer{
 		Server: server,
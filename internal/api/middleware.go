@@ -19,7 +19,7 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 			if reqID := middleware.GetReqID(ctxReq); reqID != "" {
 				log.With("req-id", reqID)
 			}
-			return f(ctx, w, r, args)
+			return f(ctxReq, w, r, args)
 		}
 	}
 }
@@ -40,7 +40,30 @@ func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewar
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 				}
 			}
-			return f(ctx, w, r, args)
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// ApproverAuthMiddleware returns a middleware that performs an http basic authorization with the approver credentials
+// for the endpoints configured with approver auth in the api spec.
+// Unlike BasicAuthMiddleware, the access is always denied when the approver credentials are not configured.
+func ApproverAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if ctxReq.Value(ApproverAuthScopes) != nil {
+				if user == "" || pass == "" {
+					return nil, apiErrors.AuthError{Err: errors.New("approvals are not enabled")}
+				}
+				userReq, passReq, ok := r.BasicAuth()
+				if !ok {
+					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+				}
+				if subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) != 1 {
+					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+				}
+			}
+			return f(ctxReq, w, r, args)
 		}
 	}
 }
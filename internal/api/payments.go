@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/payments"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+// GetCredentialPrices returns the credential prices of the identity
+func (s *Server) GetCredentialPrices(ctx context.Context, request GetCredentialPricesRequestObject) (GetCredentialPricesResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetCredentialPrices400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	prices, err := s.paymentService.GetPrices(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "getting credential prices", "err", err, "did", did.String())
+		return GetCredentialPrices500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetCredentialPrices200JSONResponse(toCredentialPricesResponse(prices)), nil
+}
+
+// CreateCredentialPrice creates a credential price
+func (s *Server) CreateCredentialPrice(ctx context.Context, request CreateCredentialPriceRequestObject) (CreateCredentialPriceResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateCredentialPrice400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	amount, err := payments.Amount(request.Body.Amount)
+	if err != nil {
+		return CreateCredentialPrice400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+
+	price := domain.NewCredentialPrice(*did, request.Body.SchemaID, request.Body.LinkID, amount, request.Body.Blockchain, request.Body.Network, request.Body.Recipient, request.Body.Description)
+	if err := s.paymentService.CreatePrice(ctx, price); err != nil {
+		errs := []error{
+			services.ErrInvalidCredentialPrice,
+			services.ErrSchemaNotFound,
+			services.ErrLinkNotFound,
+			repositories.ErrCredentialPriceDuplicated,
+		}
+		for _, e := range errs {
+			if errors.Is(err, e) {
+				return CreateCredentialPrice400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+		}
+		log.Error(ctx, "creating credential price", "err", err, "did", did.String())
+		return CreateCredentialPrice500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateCredentialPrice201JSONResponse{Id: price.ID.String()}, nil
+}
+
+// DeleteCredentialPrice deletes a credential price
+func (s *Server) DeleteCredentialPrice(ctx context.Context, request DeleteCredentialPriceRequestObject) (DeleteCredentialPriceResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return DeleteCredentialPrice400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	if err := s.paymentService.DeletePrice(ctx, *did, request.Id); err != nil {
+		if errors.Is(err, repositories.ErrCredentialPriceNotFound) {
+			return DeleteCredentialPrice404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting credential price", "err", err, "id", request.Id)
+		return DeleteCredentialPrice500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeleteCredentialPrice200JSONResponse{Message: "credential price deleted"}, nil
+}
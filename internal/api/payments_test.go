@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db/tests"
+)
+
+func TestServer_CredentialPrices(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		recipient  = "0x8aF6A3F6c9B4bB8B9f1Fe3E0a6c3B4d4A4fD4c21"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription")))
+	require.NoError(t, err)
+
+	pricesURL := fmt.Sprintf("/v2/identities/%s/credentials/prices", did)
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		body     CreateCredentialPriceRequest
+		httpCode int
+	}
+	for _, tc := range []testConfig{
+		{
+			name:     "No auth header",
+			auth:     authWrong,
+			httpCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Schema price",
+			auth: authOk,
+			body: CreateCredentialPriceRequest{
+				SchemaID:    importedSchema.ID,
+				Amount:      "1000000000000000",
+				Blockchain:  blockchain,
+				Network:     network,
+				Recipient:   recipient,
+				Description: common.ToPointer("KYC verification fee"),
+			},
+			httpCode: http.StatusCreated,
+		},
+		{
+			name: "Schema with price",
+			auth: authOk,
+			body: CreateCredentialPriceRequest{
+				SchemaID:   importedSchema.ID,
+				Amount:     "2000000000000000",
+				Blockchain: blockchain,
+				Network:    network,
+				Recipient:  recipient,
+			},
+			httpCode: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid amount",
+			auth: authOk,
+			body: CreateCredentialPriceRequest{
+				SchemaID:   importedSchema.ID,
+				Amount:     "-1",
+				Blockchain: blockchain,
+				Network:    network,
+				Recipient:  recipient,
+			},
+			httpCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unsupported network",
+			auth: authOk,
+			body: CreateCredentialPriceRequest{
+				SchemaID:   importedSchema.ID,
+				Amount:     "1000",
+				Blockchain: "ethereum",
+				Network:    "mainnet",
+				Recipient:  recipient,
+			},
+			httpCode: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid recipient",
+			auth: authOk,
+			body: CreateCredentialPriceRequest{
+				SchemaID:   importedSchema.ID,
+				Amount:     "1000",
+				Blockchain: blockchain,
+				Network:    network,
+				Recipient:  "recipient",
+			},
+			httpCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, pricesURL, tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+		})
+	}
+
+	t.Run("Get and delete prices", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, pricesURL, nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var prices []CredentialPrice
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &prices))
+		require.Len(t, prices, 1)
+		assert.Equal(t, importedSchema.ID, prices[0].SchemaID)
+		assert.Equal(t, schemaType, prices[0].SchemaType)
+		assert.Equal(t, "1000000000000000", prices[0].Amount)
+		assert.Nil(t, prices[0].LinkID)
+
+		for _, httpCode := range []int{http.StatusOK, http.StatusNotFound} {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", pricesURL, prices[0].Id), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, httpCode, rr.Code)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+// GetCredentialProposalRules returns the credential proposal rules of the identity
+func (s *Server) GetCredentialProposalRules(ctx context.Context, request GetCredentialProposalRulesRequestObject) (GetCredentialProposalRulesResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetCredentialProposalRules400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	rules, err := s.proposalService.GetRules(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "getting credential proposal rules", "err", err, "did", did.String())
+		return GetCredentialProposalRules500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetCredentialProposalRules200JSONResponse(toCredentialProposalRulesResponse(rules)), nil
+}
+
+// CreateCredentialProposalRule creates a credential proposal rule
+func (s *Server) CreateCredentialProposalRule(ctx context.Context, request CreateCredentialProposalRuleRequestObject) (CreateCredentialProposalRuleResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateCredentialProposalRule400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	rule := domain.NewProposalRule(*did, request.Body.SchemaID, domain.ProposalRuleType(request.Body.Type), request.Body.LinkID, request.Body.Url, request.Body.Description)
+	if err := s.proposalService.CreateRule(ctx, rule); err != nil {
+		errs := []error{
+			services.ErrInvalidProposalRule,
+			services.ErrSchemaNotFound,
+			services.ErrLinkNotFound,
+			services.ErrMalformedURL,
+		}
+		for _, e := range errs {
+			if errors.Is(err, e) {
+				return CreateCredentialProposalRule400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+		}
+		log.Error(ctx, "creating credential proposal rule", "err", err, "did", did.String())
+		return CreateCredentialProposalRule500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateCredentialProposalRule201JSONResponse{Id: rule.ID.String()}, nil
+}
+
+// DeleteCredentialProposalRule deletes a credential proposal rule
+func (s *Server) DeleteCredentialProposalRule(ctx context.Context, request DeleteCredentialProposalRuleRequestObject) (DeleteCredentialProposalRuleResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return DeleteCredentialProposalRule400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	if err := s.proposalService.DeleteRule(ctx, *did, request.Id); err != nil {
+		if errors.Is(err, repositories.ErrProposalRuleNotFound) {
+			return DeleteCredentialProposalRule404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting credential proposal rule", "err", err, "id", request.Id)
+		return DeleteCredentialProposalRule500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeleteCredentialProposalRule200JSONResponse{Message: "credential proposal rule deleted"}, nil
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db/tests"
+)
+
+func TestServer_CredentialProposalRules(t *testing.T) {
+	const (
+		method     = "opid"
+		blockchain = "optimism"
+		network    = "sepolia"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription")))
+	require.NoError(t, err)
+
+	rulesURL := fmt.Sprintf("/v2/identities/%s/credentials/proposal-rules", did)
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		body     CreateCredentialProposalRuleRequest
+		httpCode int
+	}
+	for _, tc := range []testConfig{
+		{
+			name:     "No auth header",
+			auth:     authWrong,
+			httpCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Web rule",
+			auth: authOk,
+			body: CreateCredentialProposalRuleRequest{
+				SchemaID:    importedSchema.ID,
+				Type:        CreateCredentialProposalRuleRequestTypeWeb,
+				Url:         common.ToPointer("https://issuer.example.com/onboarding"),
+				Description: common.ToPointer("Complete the KYC process"),
+			},
+			httpCode: http.StatusCreated,
+		},
+		{
+			name: "Web rule without url",
+			auth: authOk,
+			body: CreateCredentialProposalRuleRequest{
+				SchemaID: importedSchema.ID,
+				Type:     CreateCredentialProposalRuleRequestTypeWeb,
+			},
+			httpCode: http.StatusBadRequest,
+		},
+		{
+			name: "Link rule without link",
+			auth: authOk,
+			body: CreateCredentialProposalRuleRequest{
+				SchemaID: importedSchema.ID,
+				Type:     CreateCredentialProposalRuleRequestTypeLink,
+			},
+			httpCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown schema",
+			auth: authOk,
+			body: CreateCredentialProposalRuleRequest{
+				SchemaID: uuid.New(),
+				Type:     CreateCredentialProposalRuleRequestTypeVerification,
+				Url:      common.ToPointer("https://verifier.example.com/request"),
+			},
+			httpCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, rulesURL, tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+		})
+	}
+
+	t.Run("Get and delete rules", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, rulesURL, nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var rules []CredentialProposalRule
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rules))
+		require.Len(t, rules, 1)
+		assert.Equal(t, importedSchema.ID, rules[0].SchemaID)
+		assert.Equal(t, schemaType, rules[0].SchemaType)
+		assert.Equal(t, CredentialProposalRuleTypeWeb, rules[0].Type)
+		assert.Nil(t, rules[0].LinkID)
+
+		for _, httpCode := range []int{http.StatusOK, http.StatusNotFound} {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", rulesURL, rules[0].Id), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, httpCode, rr.Code)
+		}
+	})
+}
@@ -2,9 +2,11 @@ package api
 
 import (
 	"context"
+	"errors"
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/wakeup-labs/issuer-node/internal/core/services"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 )
 
@@ -14,6 +16,9 @@ func (s *Server) GetQrFromStore(ctx context.Context, request GetQrFromStoreReque
 		log.Warn(ctx, "qr store. Missing id parameter")
 		return GetQrFromStore400JSONResponse{N400JSONResponse{"id is required"}}, nil
 	}
+	if request.Params.Invite != nil {
+		return s.getInviteQrFromStore(ctx, request)
+	}
 	body, err := s.qrService.Find(ctx, *request.Params.Id)
 	if err != nil {
 		log.Error(ctx, "qr store. Finding qr", "err", err, "id", *request.Params.Id)
@@ -47,3 +52,29 @@ func (s *Server) GetQrFromStore(ctx context.Context, request GetQrFromStoreReque
 	}
 	return NewQrContentResponse(body), nil
 }
+
+// getInviteQrFromStore returns the authorization request of the link of the id for the holder of the invite code
+func (s *Server) getInviteQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error) {
+	if request.Params.Issuer == nil {
+		return GetQrFromStore400JSONResponse{N400JSONResponse{"issuer is required"}}, nil
+	}
+	issuerDID, err := w3c.ParseDID(*request.Params.Issuer)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", *request.Params.Issuer)
+		return GetQrFromStore400JSONResponse{N400JSONResponse{"invalid issuer did"}}, nil
+	}
+	body, err := s.linkService.GetInviteQRCode(ctx, *issuerDID, *request.Params.Id, *request.Params.Invite, s.cfg.ServerUrl)
+	if err != nil {
+		log.Error(ctx, "getting link invite qr", "err", err, "link id", *request.Params.Id)
+		switch {
+		case errors.Is(err, services.ErrLinkNotFound):
+			return GetQrFromStore404JSONResponse{N404JSONResponse{"link not found"}}, nil
+		case errors.Is(err, services.ErrLinkInviteInvalid), errors.Is(err, services.ErrLinkAlreadyExpired),
+			errors.Is(err, services.ErrLinkMaxExceeded), errors.Is(err, services.ErrLinkInactive):
+			return GetQrFromStore410JSONResponse{N410JSONResponse{err.Error()}}, nil
+		default:
+			return GetQrFromStore500JSONResponse{N500JSONResponse{"error looking for qr body"}}, nil
+		}
+	}
+	return NewQrContentResponse(body), nil
+}
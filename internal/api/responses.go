@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/iden3/go-schema-processor/v2/verifiable"
@@ -43,6 +44,49 @@ func getLinkResponses(links []*domain.Link) []Link {
 	return res
 }
 
+func getLinkDerivation(derivation *domain.LinkDerivation) *LinkDerivation {
+	if derivation == nil {
+		return nil
+	}
+	res := &LinkDerivation{
+		ProofRequests: make([]ZeroKnowledgeProofRequest, len(derivation.ProofRequests)),
+		Attributes:    make([]LinkDerivedAttribute, len(derivation.Attributes)),
+	}
+	for i, req := range derivation.ProofRequests {
+		res.ProofRequests[i] = ZeroKnowledgeProofRequest{
+			Id:        common.ToPointer(req.ID),
+			CircuitId: req.CircuitID,
+			Optional:  req.Optional,
+			Query:     req.Query,
+		}
+		if req.Params != nil {
+			res.ProofRequests[i].Params = common.ToPointer(req.Params)
+		}
+	}
+	for i, attr := range derivation.Attributes {
+		res.Attributes[i] = LinkDerivedAttribute{
+			Attribute: attr.Attribute,
+			Rule:      LinkDerivedAttributeRule(attr.Rule),
+		}
+		if attr.Field != "" {
+			res.Attributes[i].Field = common.ToPointer(attr.Field)
+		}
+		if attr.Values != nil {
+			res.Attributes[i].Values = common.ToPointer(attr.Values)
+		}
+		if attr.Default != nil {
+			res.Attributes[i].Default = common.ToPointer(attr.Default)
+		}
+		if attr.MinAge != 0 {
+			res.Attributes[i].MinAge = common.ToPointer(attr.MinAge)
+		}
+		if attr.Value != nil {
+			res.Attributes[i].Value = common.ToPointer(attr.Value)
+		}
+	}
+	return res
+}
+
 func getLinkResponse(link *domain.Link) Link {
 	hash, _ := link.Schema.Hash.MarshalText()
 	var credentialExpiration *timeapi.Time
@@ -88,6 +132,9 @@ func getLinkResponse(link *domain.Link) Link {
 		CredentialExpiration: credentialExpiration,
 		RefreshService:       refreshService,
 		DisplayMethod:        displayMethod,
+		Allowlist:            link.Allowlist,
+		InviteOnly:           link.InviteOnly,
+		Derivation:           getLinkDerivation(link.Derivation),
 		DeepLink:             link.DeepLink,
 		UniversalLink:        link.UniversalLink,
 	}
@@ -167,10 +214,45 @@ func connectionResponse(conn *domain.Connection, credentials []*domain.Claim) (G
 		Id:          conn.ID.String(),
 		UserID:      conn.UserDID.String(),
 		IssuerID:    conn.IssuerDID.String(),
+		Metadata:    conn.Metadata,
+		Tags:        conn.Tags,
 		Credentials: credResp,
 	}, nil
 }
 
+func toConnectionMessageResponse(message *domain.ConnectionMessage) ConnectionMessage {
+	return ConnectionMessage{
+		Id:           message.ID,
+		ConnectionID: message.ConnectionID,
+		ThreadID:     message.ThreadID,
+		Type:         string(message.Type),
+		Status:       ConnectionMessageStatus(message.Status),
+		Reason:       message.Reason,
+		CreatedAt:    TimeUTC(message.CreatedAt),
+	}
+}
+
+func toConnectionEventResponse(event *domain.ConnectionEvent) ConnectionEvent {
+	return ConnectionEvent{
+		Id:           event.ID,
+		ConnectionID: event.ConnectionID,
+		Type:         ConnectionEventType(event.Type),
+		SessionID:    event.SessionID,
+		UserDoc:      toJSONObject(event.UserDoc),
+		PushService:  toJSONObject(event.PushService),
+		CreatedAt:    TimeUTC(event.CreatedAt),
+	}
+}
+
+// toJSONObject returns nil when raw is empty or it is not a json object
+func toJSONObject(raw json.RawMessage) *map[string]interface{} {
+	var object map[string]interface{}
+	if len(raw) == 0 || json.Unmarshal(raw, &object) != nil || object == nil {
+		return nil
+	}
+	return &object
+}
+
 func connectionsResponse(conns []domain.Connection) (GetConnectionsResponse, error) {
 	resp := make([]GetConnectionResponse, 0)
 
@@ -188,6 +270,28 @@ func connectionsResponse(conns []domain.Connection) (GetConnectionsResponse, err
 	return resp, nil
 }
 
+// defaultMaxResults is the number of items of a page when max_results is not set
+const defaultMaxResults uint = 50
+
+func maxResultsOrDefault(maxResults *uint) *uint {
+	if maxResults == nil || *maxResults == 0 {
+		return common.ToPointer(defaultMaxResults)
+	}
+	return maxResults
+}
+
+func newPaginatedMetadata(pagFilter pagination.Filter, total uint) PaginatedMetadata {
+	meta := PaginatedMetadata{
+		MaxResults: pagFilter.MaxResults,
+		Page:       1, // default
+		Total:      total,
+	}
+	if pagFilter.Page != nil {
+		meta.Page = *pagFilter.Page
+	}
+	return meta
+}
+
 func connectionsPaginatedResponse(conns []domain.Connection, pagFilter pagination.Filter, total uint) (ConnectionsPaginated, error) {
 	resp, err := connectionsResponse(conns)
 	if err != nil {
@@ -280,3 +384,131 @@ func getTransactionStatus(status domain.IdentityStatus) StateTransactionStatus {
 		return "failed"
 	}
 }
+
+func toApprovalRequestResponse(request *domain.ApprovalRequest) ApprovalRequest {
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(request.Payload, &payload); err != nil {
+		payload = map[string]interface{}{}
+	}
+
+	var reviewedAt *TimeUTC
+	if request.ReviewedAt != nil {
+		reviewedAt = common.ToPointer(TimeUTC(*request.ReviewedAt))
+	}
+
+	return ApprovalRequest{
+		Id:          request.ID,
+		Operation:   ApprovalRequestOperation(request.Operation),
+		Status:      ApprovalRequestStatus(request.Status),
+		Payload:     payload,
+		RequestedBy: request.RequestedBy,
+		ReviewedBy:  request.ReviewedBy,
+		Reason:      request.Reason,
+		ResultId:    request.ResultID,
+		CreatedAt:   TimeUTC(request.CreatedAt),
+		ReviewedAt:  reviewedAt,
+	}
+}
+
+func toApprovalRequestsResponse(requests []*domain.ApprovalRequest) []ApprovalRequest {
+	res := make([]ApprovalRequest, len(requests))
+	for i, request := range requests {
+		res[i] = toApprovalRequestResponse(request)
+	}
+	return res
+}
+
+func toCredentialProposalRulesResponse(rules []*domain.ProposalRule) []CredentialProposalRule {
+	res := make([]CredentialProposalRule, len(rules))
+	for i, rule := range rules {
+		res[i] = CredentialProposalRule{
+			Id:          rule.ID,
+			SchemaID:    rule.SchemaID,
+			SchemaType:  rule.SchemaType,
+			Type:        CredentialProposalRuleType(rule.Type),
+			LinkID:      rule.LinkID,
+			Url:         rule.URL,
+			Description: rule.Description,
+			CreatedAt:   TimeUTC(rule.CreatedAt),
+		}
+	}
+	return res
+}
+
+func toCredentialPricesResponse(prices []*domain.CredentialPrice) []CredentialPrice {
+	res := make([]CredentialPrice, len(prices))
+	for i, price := range prices {
+		res[i] = CredentialPrice{
+			Id:          price.ID,
+			SchemaID:    price.SchemaID,
+			SchemaType:  price.SchemaType,
+			LinkID:      price.LinkID,
+			Amount:      price.Amount.String(),
+			Blockchain:  price.Blockchain,
+			Network:     price.Network,
+			Recipient:   price.Recipient,
+			Description: price.Description,
+			CreatedAt:   TimeUTC(price.CreatedAt),
+		}
+	}
+	return res
+}
+
+func toAgentMessagesResponse(messages []*domain.AgentMessage) []AgentMessage {
+	res := make([]AgentMessage, len(messages))
+	for i, message := range messages {
+		body := make(map[string]interface{})
+		if len(message.Body) > 0 {
+			_ = json.Unmarshal(message.Body, &body)
+		}
+		var createdTime, expiresTime *TimeUTC
+		if message.CreatedTime != nil {
+			createdTime = common.ToPointer(TimeUTC(*message.CreatedTime))
+		}
+		if message.ExpiresTime != nil {
+			expiresTime = common.ToPointer(TimeUTC(*message.ExpiresTime))
+		}
+		res[i] = AgentMessage{
+			Id:          message.ID,
+			MessageID:   message.MessageID,
+			ThreadID:    message.ThreadID,
+			HolderDID:   message.HolderDID,
+			Direction:   AgentMessageDirection(message.Direction),
+			Type:        string(message.Type),
+			MediaType:   string(message.MediaType),
+			Body:        body,
+			CreatedTime: createdTime,
+			ExpiresTime: expiresTime,
+			CreatedAt:   TimeUTC(message.CreatedAt),
+		}
+	}
+	return res
+}
+
+func toLinkStatsResponse(stats *domain.LinkStats) LinkStats {
+	buckets := make([]LinkStatsBucket, len(stats.Buckets))
+	for i, bucket := range stats.Buckets {
+		buckets[i] = LinkStatsBucket{
+			Time:   TimeUTC(bucket.Time),
+			Counts: toLinkEventCountsResponse(bucket.Counts),
+		}
+	}
+	return LinkStats{
+		From:     TimeUTC(stats.From),
+		To:       TimeUTC(stats.To),
+		Interval: LinkStatsInterval(stats.Interval),
+		Totals:   toLinkEventCountsResponse(stats.Totals),
+		Buckets:  buckets,
+	}
+}
+
+func toLinkEventCountsResponse(counts domain.LinkEventCounts) LinkEventCounts {
+	return LinkEventCounts{
+		QrDisplayed:          counts.QRDisplayed,
+		CallbackReceived:     counts.CallbackReceived,
+		AuthenticationFailed: counts.AuthenticationFailed,
+		CredentialIssued:     counts.CredentialIssued,
+		OfferCreated:         counts.OfferCreated,
+		CredentialFetched:    counts.CredentialFetched,
+	}
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
 	"github.com/wakeup-labs/issuer-node/internal/core/services"
 	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/sqltools"
 )
 
 // ImportSchema is the UI endpoint to import schema metadata
@@ -53,6 +55,29 @@ func (s *Server) GetSchema(ctx context.Context, request GetSchemaRequestObject)
 	return GetSchema200JSONResponse(schemaResponse(schema)), nil
 }
 
+// GetSchemaLinkStats returns the events of the funnel of the links of a schema by buckets of time
+func (s *Server) GetSchemaLinkStats(ctx context.Context, request GetSchemaLinkStatsRequestObject) (GetSchemaLinkStatsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetSchemaLinkStats400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	req := newLinkStatsRequest(request.Params.From, request.Params.To, (*string)(request.Params.Interval))
+	req.SchemaID = &request.Id
+	stats, err := s.linkService.GetStats(ctx, *issuerDID, req)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return GetSchemaLinkStats404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidLinkStats) {
+			return GetSchemaLinkStats400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting schema link stats", "err", err, "id", request.Id)
+		return GetSchemaLinkStats500JSONResponse{N500JSONResponse{Message: "error getting schema link stats"}}, nil
+	}
+	return GetSchemaLinkStats200JSONResponse(toLinkStatsResponse(stats)), nil
+}
+
 // GetSchemas returns the list of schemas that match the request.Params.Query filter. If param query is nil it will return all
 func (s *Server) GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error) {
 	issuerDID, err := w3c.ParseDID(request.Identifier)
@@ -60,12 +85,47 @@ func (s *Server) GetSchemas(ctx context.Context, request GetSchemasRequestObject
 		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
 		return GetSchemas400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
 	}
-	col, err := s.schemaService.GetAll(ctx, *issuerDID, request.Params.Query)
+	filter, err := getSchemasFilter(request)
+	if err != nil {
+		return GetSchemas400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	col, total, err := s.schemaService.GetAll(ctx, *issuerDID, filter)
 	if err != nil {
 		log.Error(ctx, "loading schemas", "err", err)
 		return GetSchemas500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
-	return GetSchemas200JSONResponse(schemaCollectionResponse(col)), nil
+	return GetSchemas200JSONResponse{
+		Items: schemaCollectionResponse(col),
+		Meta:  newPaginatedMetadata(filter.Pagination, total),
+	}, nil
+}
+
+func getSchemasFilter(req GetSchemasRequestObject) (*ports.SchemasFilter, error) {
+	if req.Params.Page != nil && *req.Params.Page <= 0 {
+		return nil, errors.New("page must be greater than 0")
+	}
+	orderBy := sqltools.OrderByFilters{}
+	if req.Params.Sort != nil {
+		for _, sortBy := range *req.Params.Sort {
+			var err error
+			field, desc := strings.CutPrefix(strings.TrimSpace(string(sortBy)), "-")
+			switch GetSchemasParamsSort(field) {
+			case GetSchemasParamsSortCreatedAt:
+				err = orderBy.Add(ports.SchemasCreatedAt, desc)
+			case GetSchemasParamsSortType:
+				err = orderBy.Add(ports.SchemasType, desc)
+			case GetSchemasParamsSortTitle:
+				err = orderBy.AddWithNullsLast(ports.SchemasTitle, desc)
+			default:
+				return nil, errors.New("wrong sort by value")
+			}
+			if err != nil {
+				return nil, errors.New("repeated sort by value field")
+			}
+		}
+	}
+	return ports.NewSchemasFilter(req.Params.Query, req.Params.CreatedFrom, req.Params.CreatedTo, req.Params.Page,
+		maxResultsOrDefault(req.Params.MaxResults), orderBy), nil
 }
 
 func guardImportSchemaReq(req *ImportSchemaJSONRequestBody) error {
@@ -176,11 +176,14 @@ func TestServer_GetSchemas(t *testing.T) {
 	type expected struct {
 		httpCode int
 		count    int
+		total    uint
+		first    string
 	}
 	type testConfig struct {
 		name     string
 		auth     func() (string, string)
 		query    *string
+		params   string
 		expected expected
 	}
 	for _, tc := range []testConfig{
@@ -236,12 +239,57 @@ func TestServer_GetSchemas(t *testing.T) {
 				count:    1,
 			},
 		},
+		{
+			name:   "Wrong page",
+			auth:   authOk,
+			params: "page=0",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:   "Wrong sort",
+			auth:   authOk,
+			params: "sort=url",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:   "Second page sorted by type",
+			auth:   authOk,
+			query:  common.ToPointer("schemaType"),
+			params: "page=2&max_results=10&sort=type",
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    10,
+				total:    20,
+				first:    "schemaType-18",
+			},
+		},
+		{
+			name:   "First page sorted by type descending",
+			auth:   authOk,
+			query:  common.ToPointer("schemaType"),
+			params: "page=1&max_results=5&sort=-type",
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    5,
+				total:    20,
+				first:    "schemaType-9",
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
 			endpoint := fmt.Sprintf("/v2/identities/%s/schemas", issuerDID)
+			params := url.Values{}
 			if tc.query != nil {
-				endpoint = endpoint + "?query=" + url.QueryEscape(*tc.query)
+				params.Set("query", *tc.query)
+			}
+			endpoint = endpoint + "?" + params.Encode()
+			if tc.params != "" {
+				endpoint = endpoint + "&" + tc.params
 			}
 			req, err := http.NewRequest("GET", endpoint, nil)
 			req.SetBasicAuth(tc.auth())
@@ -254,7 +302,15 @@ func TestServer_GetSchemas(t *testing.T) {
 			case http.StatusOK:
 				var response GetSchemas200JSONResponse
 				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
-				assert.Equal(t, tc.expected.count, len(response))
+				assert.Equal(t, tc.expected.count, len(response.Items))
+				if tc.expected.total != 0 {
+					assert.Equal(t, tc.expected.total, response.Meta.Total)
+				} else {
+					assert.Equal(t, uint(tc.expected.count), response.Meta.Total)
+				}
+				if tc.expected.first != "" {
+					assert.Equal(t, tc.expected.first, response.Items[0].Type)
+				}
 			}
 		})
 	}
@@ -17,35 +17,53 @@ import (
 // Server implements StrictServerInterface and holds the implementation of all API controllers
 // This is the glue to the API autogenerated code
 type Server struct {
-	cfg                *config.Configuration
-	accountService     ports.AccountService
-	claimService       ports.ClaimService
-	connectionsService ports.ConnectionService
-	health             *health.Status
-	identityService    ports.IdentityService
-	linkService        ports.LinkService
-	networkResolver    network.Resolver
-	packageManager     *iden3comm.PackageManager
-	publisherGateway   ports.Publisher
-	qrService          ports.QrStoreService
-	schemaService      ports.SchemaService
+	cfg                      *config.Configuration
+	accountService           ports.AccountService
+	agentMessageService      ports.AgentMessageService
+	agentPacker              ports.AgentPacker
+	approvalService          ports.ApprovalService
+	claimService             ports.ClaimService
+	connectionMessageService ports.ConnectionMessageService
+	connectionsService       ports.ConnectionService
+	health                   *health.Status
+	identityService          ports.IdentityService
+	issuanceRequests         ports.IssuanceRequestService
+	linkService              ports.LinkService
+	mediatypeManager         ports.MediatypeManager
+	networkResolver          network.Resolver
+	packageManager           *iden3comm.PackageManager
+	paymentService           ports.PaymentService
+	proposalService          ports.ProposalService
+	publisherGateway         ports.Publisher
+	qrService                ports.QrStoreService
+	schemaService            ports.SchemaService
+	signingPolicy            ports.SigningPolicyService
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService) *Server {
+func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService, signingPolicy ports.SigningPolicyService, approvalService ports.ApprovalService, agentPacker ports.AgentPacker, proposalService ports.ProposalService, issuanceRequests ports.IssuanceRequestService, paymentService ports.PaymentService, agentMessageService ports.AgentMessageService, connectionMessageService ports.ConnectionMessageService, mediatypeManager ports.MediatypeManager) *Server {
 	return &Server{
-		cfg:                cfg,
-		accountService:     accountService,
-		claimService:       claimsService,
-		connectionsService: connectionsService,
-		health:             health,
-		identityService:    identityService,
-		linkService:        linkService,
-		networkResolver:    networkResolver,
-		publisherGateway:   publisherGateway,
-		packageManager:     packageManager,
-		qrService:          qrService,
-		schemaService:      schemaService,
+		cfg:                      cfg,
+		accountService:           accountService,
+		agentMessageService:      agentMessageService,
+		mediatypeManager:         mediatypeManager,
+		agentPacker:              agentPacker,
+		approvalService:          approvalService,
+		claimService:             claimsService,
+		connectionMessageService: connectionMessageService,
+		connectionsService:       connectionsService,
+		health:                   health,
+		identityService:          identityService,
+		issuanceRequests:         issuanceRequests,
+		linkService:              linkService,
+		networkResolver:          networkResolver,
+		publisherGateway:         publisherGateway,
+		packageManager:           packageManager,
+		paymentService:           paymentService,
+		proposalService:          proposalService,
+		qrService:                qrService,
+		schemaService:            schemaService,
+		signingPolicy:            signingPolicy,
 	}
 }
 
@@ -22,8 +22,20 @@ func (s *Server) PublishIdentityState(ctx context.Context, request PublishIdenti
 		return PublishIdentityState400JSONResponse{N400JSONResponse{"invalid did"}}, nil
 	}
 
+	if s.signingPolicy.RequiresPublishApproval() {
+		approval, err := s.approvalService.RequestStatePublish(ctx, *did)
+		if err != nil {
+			log.Error(ctx, "requesting state publish approval", "err", err)
+			return PublishIdentityState500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		return PublishIdentityState201JSONResponse(toApprovalRequestResponse(approval)), nil
+	}
+
 	publishedState, err := s.publisherGateway.PublishState(ctx, did)
 	if err != nil {
+		if errors.Is(err, services.ErrOutsidePublishWindow) {
+			return PublishIdentityState400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, gateways.ErrNoStatesToProcess) || errors.Is(err, gateways.ErrStateIsBeingProcessed) {
 			return PublishIdentityState200JSONResponse{Message: err.Error()}, nil
 		}
@@ -55,7 +67,7 @@ func (s *Server) RetryPublishState(ctx context.Context, request RetryPublishStat
 	publishedState, err := s.publisherGateway.RetryPublishState(ctx, did)
 	if err != nil {
 		log.Error(ctx, "error retrying the publishing the state", "err", err)
-		if errors.Is(err, gateways.ErrStateIsBeingProcessed) || errors.Is(err, gateways.ErrNoFailedStatesToProcess) {
+		if errors.Is(err, gateways.ErrStateIsBeingProcessed) || errors.Is(err, gateways.ErrNoFailedStatesToProcess) || errors.Is(err, services.ErrOutsidePublishWindow) {
 			return RetryPublishState400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
 		return RetryPublishState500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
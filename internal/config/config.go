@@ -31,6 +31,8 @@ const (
 	Vault = "vault"
 	// AWS is the AWS plugin
 	AWS = "aws"
+	// Remote is the remote signer plugin
+	Remote = "remote"
 	// CacheProviderRedis is the redis cache provider
 	CacheProviderRedis = "redis"
 	// CacheProviderValKey is the valkey cache provider
@@ -62,6 +64,9 @@ type Configuration struct {
 	MediaTypeManager            MediaTypeManager
 	UniversalLinks              UniversalLinks
 	UniversalDIDResolver        UniversalDIDResolver
+	SigningPolicy               SigningPolicy
+	Agent                       Agent
+	IssuanceRequests            IssuanceRequests
 }
 
 // Database has the database configuration
@@ -141,8 +146,38 @@ type KeyStore struct {
 	AWSRegion                    string `env:"ISSUER_KMS_ETH_PLUGIN_AWS_REGION"`
 	VaultUserPassAuthEnabled     bool   `env:"ISSUER_VAULT_USERPASS_AUTH_ENABLED"`
 	VaultUserPassAuthPassword    string `env:"ISSUER_VAULT_USERPASS_AUTH_PASSWORD"`
+	VaultAuthMethod              string `env:"ISSUER_VAULT_AUTH_METHOD"`
+	VaultAppRoleID               string `env:"ISSUER_VAULT_APPROLE_ROLE_ID"`
+	VaultAppRoleSecretID         string `env:"ISSUER_VAULT_APPROLE_SECRET_ID"`
+	VaultAppRoleMountPath        string `env:"ISSUER_VAULT_APPROLE_MOUNT_PATH"`
+	VaultKubernetesRole          string `env:"ISSUER_VAULT_KUBERNETES_ROLE"`
+	VaultKubernetesTokenPath     string `env:"ISSUER_VAULT_KUBERNETES_TOKEN_PATH"`
+	VaultKubernetesMountPath     string `env:"ISSUER_VAULT_KUBERNETES_MOUNT_PATH"`
 	TLSEnabled                   bool   `env:"ISSUER_VAULT_TLS_ENABLED"`
 	CertPath                     string `env:"ISSUER_VAULT_TLS_CERT_PATH"`
+	RemoteSigner                 RemoteSigner
+}
+
+// RemoteSigner defines the connection to the remote signer service used when the key providers are `remote`
+type RemoteSigner struct {
+	URL          string `env:"ISSUER_KMS_REMOTE_SIGNER_URL"`
+	TLSCertPath  string `env:"ISSUER_KMS_REMOTE_SIGNER_TLS_CERT_PATH"`
+	TLSKeyPath   string `env:"ISSUER_KMS_REMOTE_SIGNER_TLS_KEY_PATH"`
+	CACertPath   string `env:"ISSUER_KMS_REMOTE_SIGNER_CA_CERT_PATH"`
+	InsecureHTTP bool   `env:"ISSUER_KMS_REMOTE_SIGNER_INSECURE_HTTP" envDefault:"false"`
+}
+
+// Signer holds the configuration of the remote signer service (cmd/signer).
+// The signer uses the same key store configuration as the issuer node.
+type Signer struct {
+	ServerPort       int    `env:"ISSUER_SIGNER_SERVER_PORT" envDefault:"3010"`
+	TLSCertPath      string `env:"ISSUER_SIGNER_TLS_CERT_PATH"`
+	TLSKeyPath       string `env:"ISSUER_SIGNER_TLS_KEY_PATH"`
+	ClientCACertPath string `env:"ISSUER_SIGNER_CLIENT_CA_CERT_PATH"`
+	InsecureHTTP     bool   `env:"ISSUER_SIGNER_INSECURE_HTTP" envDefault:"false"`
+	KeyStore         KeyStore
+	Ethereum         Ethereum
+	Log              Log
 }
 
 // UniversalDIDResolver defines the universal DID resolver
@@ -176,9 +211,78 @@ type HTTPBasicAuth struct {
 	Password string `env:"ISSUER_API_AUTH_PASSWORD" envDefault:""`
 }
 
+// SigningPolicy holds the policies evaluated before the issuer node signs a credential or publishes a state.
+// ApprovalSchemas: credentials of these schema urls are queued until an approver accepts them. Use * for all schemas.
+// PublishApproval: state publications are queued until an approver accepts them.
+// DailyIssuanceLimit: maximum number of credentials an identity can issue per day (UTC). 0 means no limit.
+// PublishWindow: states can only be published within this time window (UTC), e.g. 08:00-18:00.
+// The approver credentials protect the approval endpoints and must be different from the API ones.
+type SigningPolicy struct {
+	ApprovalSchemas    []string `env:"ISSUER_SIGNING_POLICY_APPROVAL_SCHEMAS" envSeparator:","`
+	PublishApproval    bool     `env:"ISSUER_SIGNING_POLICY_PUBLISH_APPROVAL" envDefault:"false"`
+	DailyIssuanceLimit uint     `env:"ISSUER_SIGNING_POLICY_DAILY_ISSUANCE_LIMIT" envDefault:"0"`
+	PublishWindow      string   `env:"ISSUER_SIGNING_POLICY_PUBLISH_WINDOW"`
+	ApproverUser       string   `env:"ISSUER_SIGNING_POLICY_APPROVER_USER"`
+	ApproverPassword   string   `env:"ISSUER_SIGNING_POLICY_APPROVER_PASSWORD"`
+}
+
+// ApprovalEnabled returns true if any operation has to be approved before being executed
+func (s *SigningPolicy) ApprovalEnabled() bool {
+	return len(s.ApprovalSchemas) > 0 || s.PublishApproval
+}
+
+// PublishWindowRange returns the start and the end of the publish window as offsets from midnight UTC.
+// The end can be lower than the start when the window spans midnight.
+func (s *SigningPolicy) PublishWindowRange() (start time.Duration, end time.Duration, err error) {
+	from, to, found := strings.Cut(s.PublishWindow, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid publish window <%s>, expected format HH:MM-HH:MM", s.PublishWindow)
+	}
+	if start, err = parseTimeOfDay(from); err != nil {
+		return 0, 0, err
+	}
+	if end, err = parseTimeOfDay(to); err != nil {
+		return 0, 0, err
+	}
+	if start == end {
+		return 0, 0, fmt.Errorf("invalid publish window <%s>, start and end must be different", s.PublishWindow)
+	}
+	return start, end, nil
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day <%s>: %w", value, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
 // MediaTypeManager enables or disables the media types manager
+// PolicyPath is the yaml file with the media types allowed per message type and per issuer identity.
 type MediaTypeManager struct {
-	Enabled *bool `env:"ISSUER_MEDIA_TYPE_MANAGER_ENABLED"`
+	Enabled    *bool  `env:"ISSUER_MEDIA_TYPE_MANAGER_ENABLED"`
+	PolicyPath string `env:"ISSUER_MEDIA_TYPE_MANAGER_POLICY_PATH"`
+}
+
+// Agent configures the iden3comm agent
+// PackedResponses signs the responses to packed (ZKP or JWS) requests when the holder does not ask for a media type.
+// MessageClockSkew is the tolerance for the created_time and expires_time of the messages received by the agent.
+type Agent struct {
+	PackedResponses  bool          `env:"ISSUER_AGENT_PACKED_RESPONSES" envDefault:"false"`
+	MessageClockSkew time.Duration `env:"ISSUER_AGENT_MESSAGE_CLOCK_SKEW" envDefault:"5m"`
+}
+
+// IssuanceRequests configures how the credential issuance requests sent by the holders to the agent are approved.
+// Requests for the AutoApproveSchemas urls (* for all) are approved right away, the others are sent to the WebhookURL.
+// Without webhook, the requests that are not auto approved are rejected.
+// The webhook requests are signed with an HMAC-SHA256 of the body keyed by WebhookSecret, so the webhook can check
+// they come from the issuer node.
+type IssuanceRequests struct {
+	AutoApproveSchemas []string      `env:"ISSUER_ISSUANCE_REQUESTS_AUTO_APPROVE_SCHEMAS" envSeparator:","`
+	WebhookURL         string        `env:"ISSUER_ISSUANCE_REQUESTS_WEBHOOK_URL"`
+	WebhookSecret      string        `env:"ISSUER_ISSUANCE_REQUESTS_WEBHOOK_SECRET"`
+	WebhookTimeout     time.Duration `env:"ISSUER_ISSUANCE_REQUESTS_WEBHOOK_TIMEOUT" envDefault:"10s"`
 }
 
 // UniversalLinks configuration
@@ -211,14 +315,43 @@ func (c *Configuration) sanitize(ctx context.Context) error {
 		return fmt.Errorf("serverUrl is not a valid URL <%s>: %w", c.ServerUrl, err)
 	}
 	c.ServerUrl = sUrl
-	if c.KeyStore.Token == "" && !c.KeyStore.VaultUserPassAuthEnabled {
-		log.Error(ctx, "a vault token must be provided or vault userpass auth must be enabled", "vaultUserPassAuthEnabled", c.KeyStore.VaultUserPassAuthEnabled)
-		return fmt.Errorf("a vault token must be provided or vault userpass auth must be enabled")
+	if c.KeyStore.usesVault() {
+		if err := c.KeyStore.VaultConfig().Validate(); err != nil {
+			log.Error(ctx, "invalid vault authentication configuration", "err", err, "method", c.KeyStore.VaultConfig().Method())
+			return err
+		}
 	}
 
 	return nil
 }
 
+// VaultConfig returns the configuration of the vault client
+func (k *KeyStore) VaultConfig() providers.Config {
+	return providers.Config{
+		Address:             k.Address,
+		AuthMethod:          k.VaultAuthMethod,
+		UserPassAuthEnabled: k.VaultUserPassAuthEnabled,
+		Token:               k.Token,
+		Pass:                k.VaultUserPassAuthPassword,
+		AppRoleID:           k.VaultAppRoleID,
+		AppRoleSecretID:     k.VaultAppRoleSecretID,
+		AppRoleMountPath:    k.VaultAppRoleMountPath,
+		KubernetesRole:      k.VaultKubernetesRole,
+		KubernetesTokenPath: k.VaultKubernetesTokenPath,
+		KubernetesMountPath: k.VaultKubernetesMountPath,
+		TLSEnabled:          k.TLSEnabled,
+		CertPath:            k.CertPath,
+	}
+}
+
+func (k *KeyStore) usesVault() bool {
+	return k.BJJProvider == Vault || k.ETHProvider == Vault
+}
+
+func (k *KeyStore) usesRemoteSigner() bool {
+	return k.BJJProvider == Remote || k.ETHProvider == Remote
+}
+
 func (c *Configuration) validateServerUrl() (string, error) {
 	sUrl, err := url.ParseRequestURI(c.ServerUrl)
 	if err != nil {
@@ -359,6 +492,26 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 		}
 	}
 
+	if cfg.KeyStore.usesRemoteSigner() && cfg.KeyStore.RemoteSigner.URL == "" {
+		log.Error(ctx, "ISSUER_KMS_REMOTE_SIGNER_URL value is missing")
+		return errors.New("ISSUER_KMS_REMOTE_SIGNER_URL value is missing")
+	}
+
+	if err := checkSigningPolicy(ctx, cfg); err != nil {
+		return err
+	}
+
+	if cfg.IssuanceRequests.WebhookURL != "" {
+		if _, err := url.ParseRequestURI(cfg.IssuanceRequests.WebhookURL); err != nil {
+			log.Error(ctx, "ISSUER_ISSUANCE_REQUESTS_WEBHOOK_URL value is invalid", "err", err)
+			return fmt.Errorf("ISSUER_ISSUANCE_REQUESTS_WEBHOOK_URL value is invalid: %w", err)
+		}
+		if cfg.IssuanceRequests.WebhookSecret == "" {
+			log.Error(ctx, "ISSUER_ISSUANCE_REQUESTS_WEBHOOK_SECRET value is missing")
+			return errors.New("ISSUER_ISSUANCE_REQUESTS_WEBHOOK_SECRET value is missing")
+		}
+	}
+
 	if cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage {
 		log.Info(ctx, `
 			=====================================================================================================================================================
@@ -370,6 +523,29 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 	return nil
 }
 
+func checkSigningPolicy(ctx context.Context, cfg *Configuration) error {
+	policy := cfg.SigningPolicy
+	if policy.PublishWindow != "" {
+		if _, _, err := policy.PublishWindowRange(); err != nil {
+			log.Error(ctx, "ISSUER_SIGNING_POLICY_PUBLISH_WINDOW value is invalid", "err", err)
+			return fmt.Errorf("ISSUER_SIGNING_POLICY_PUBLISH_WINDOW value is invalid: %w", err)
+		}
+	}
+
+	if !policy.ApprovalEnabled() {
+		return nil
+	}
+	if policy.ApproverUser == "" || policy.ApproverPassword == "" {
+		log.Error(ctx, "ISSUER_SIGNING_POLICY_APPROVER_USER and ISSUER_SIGNING_POLICY_APPROVER_PASSWORD are required when approvals are enabled")
+		return errors.New("ISSUER_SIGNING_POLICY_APPROVER_USER and ISSUER_SIGNING_POLICY_APPROVER_PASSWORD are required when approvals are enabled")
+	}
+	if policy.ApproverUser == cfg.HTTPBasicAuth.User {
+		log.Error(ctx, "ISSUER_SIGNING_POLICY_APPROVER_USER must be different from ISSUER_API_AUTH_USER")
+		return errors.New("ISSUER_SIGNING_POLICY_APPROVER_USER must be different from ISSUER_API_AUTH_USER")
+	}
+	return nil
+}
+
 // KeyStoreConfig initializes the key store
 func KeyStoreConfig(ctx context.Context, cfg *Configuration, vaultCfg providers.Config) (*kms.KMS, error) {
 	var (
@@ -384,9 +560,7 @@ func KeyStoreConfig(ctx context.Context, cfg *Configuration, vaultCfg providers.
 			return nil, vaultErr
 		}
 
-		if vaultCfg.UserPassAuthEnabled {
-			go providers.RenewToken(ctx, vaultCli, vaultCfg)
-		}
+		go providers.RenewToken(ctx, vaultCli, vaultCfg)
 	}
 
 	kmsConfig := kms.Config{
@@ -399,6 +573,13 @@ func KeyStoreConfig(ctx context.Context, cfg *Configuration, vaultCfg providers.
 		Vault:                    vaultCli,
 		PluginIden3MountPath:     cfg.KeyStore.PluginIden3MountPath,
 		IssuerETHTransferKeyPath: cfg.Ethereum.TransferAccountKeyPath,
+		RemoteSigner: kms.RemoteKeyProviderConfig{
+			URL:          cfg.KeyStore.RemoteSigner.URL,
+			TLSCertPath:  cfg.KeyStore.RemoteSigner.TLSCertPath,
+			TLSKeyPath:   cfg.KeyStore.RemoteSigner.TLSKeyPath,
+			CACertPath:   cfg.KeyStore.RemoteSigner.CACertPath,
+			InsecureHTTP: cfg.KeyStore.RemoteSigner.InsecureHTTP,
+		},
 	}
 
 	keyStore, err := kms.OpenWithConfig(ctx, kmsConfig)
@@ -409,6 +590,41 @@ func KeyStoreConfig(ctx context.Context, cfg *Configuration, vaultCfg providers.
 	return keyStore, nil
 }
 
+// LoadSigner loads the remote signer service configuration from the environment
+func LoadSigner() (*Signer, error) {
+	ctx := context.Background()
+	cfg := Signer{}
+	if err := env.Parse(&cfg); err != nil {
+		return nil, err
+	}
+	if cfg.KeyStore.usesRemoteSigner() {
+		return nil, errors.New("the signer key providers cannot be remote")
+	}
+	if cfg.KeyStore.BJJProvider == "" {
+		log.Info(ctx, "ISSUER_KMS_BJJ_PLUGIN value is missing, using default value: localstorage")
+		cfg.KeyStore.BJJProvider = LocalStorage
+	}
+	if cfg.KeyStore.ETHProvider == "" {
+		log.Info(ctx, "ISSUER_KMS_ETH_PLUGIN value is missing, using default value: localstorage")
+		cfg.KeyStore.ETHProvider = LocalStorage
+	}
+	if (cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage) && cfg.KeyStore.ProviderLocalStorageFilePath == "" {
+		cfg.KeyStore.ProviderLocalStorageFilePath = "./localstoragekeys"
+	}
+	if cfg.KeyStore.usesVault() {
+		if err := cfg.KeyStore.VaultConfig().Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.TLSCertPath == "" || cfg.TLSKeyPath == "" || cfg.ClientCACertPath == "" {
+		if !cfg.InsecureHTTP {
+			return nil, errors.New("ISSUER_SIGNER_TLS_CERT_PATH, ISSUER_SIGNER_TLS_KEY_PATH and ISSUER_SIGNER_CLIENT_CA_CERT_PATH are required")
+		}
+		log.Warn(ctx, "ISSUER_SIGNER_INSECURE_HTTP is enabled. THE SIGNER WILL ACCEPT PLAIN HTTP REQUESTS FROM ANY CLIENT")
+	}
+	return &cfg, nil
+}
+
 func getWorkingDirectory() string {
 	_, b, _, _ := runtime.Caller(0)
 	return filepath.Join(filepath.Dir(b), "../..") + "/"
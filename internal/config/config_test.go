@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type envVarsT map[string]string
@@ -225,6 +226,77 @@ func TestLoadCacheProvider(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestLoadSigningPolicy(t *testing.T) {
+	loadEnvironmentVariables(t, initVariables(t))
+	t.Setenv("ISSUER_SIGNING_POLICY_APPROVAL_SCHEMAS", "https://schema.org/KYCAgeCredential.json,https://schema.org/KYCCountry.json")
+	t.Setenv("ISSUER_SIGNING_POLICY_DAILY_ISSUANCE_LIMIT", "100")
+	t.Setenv("ISSUER_SIGNING_POLICY_PUBLISH_WINDOW", "22:00-06:30")
+	t.Setenv("ISSUER_SIGNING_POLICY_APPROVER_USER", "approver")
+	t.Setenv("ISSUER_SIGNING_POLICY_APPROVER_PASSWORD", "password-approver")
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://schema.org/KYCAgeCredential.json", "https://schema.org/KYCCountry.json"}, cfg.SigningPolicy.ApprovalSchemas)
+	assert.Equal(t, uint(100), cfg.SigningPolicy.DailyIssuanceLimit)
+	assert.True(t, cfg.SigningPolicy.ApprovalEnabled())
+	start, end, err := cfg.SigningPolicy.PublishWindowRange()
+	assert.NoError(t, err)
+	assert.Equal(t, 22*time.Hour, start)
+	assert.Equal(t, 6*time.Hour+30*time.Minute, end)
+
+	t.Setenv("ISSUER_SIGNING_POLICY_PUBLISH_WINDOW", "22:00")
+	_, err = Load()
+	assert.Error(t, err)
+
+	t.Setenv("ISSUER_SIGNING_POLICY_PUBLISH_WINDOW", "")
+	t.Setenv("ISSUER_SIGNING_POLICY_APPROVER_USER", "user-issuer")
+	_, err = Load()
+	assert.Error(t, err)
+
+	t.Setenv("ISSUER_SIGNING_POLICY_APPROVER_USER", "")
+	_, err = Load()
+	assert.Error(t, err)
+
+	t.Setenv("ISSUER_SIGNING_POLICY_APPROVAL_SCHEMAS", "")
+	_, err = Load()
+	assert.NoError(t, err)
+}
+
+func TestLoadVaultAuthMethod(t *testing.T) {
+	loadEnvironmentVariables(t, initVariables(t))
+	t.Setenv("ISSUER_KMS_BJJ_PROVIDER", "vault")
+	t.Setenv("ISSUER_VAULT_AUTH_METHOD", "approle")
+	t.Setenv("ISSUER_VAULT_APPROLE_ROLE_ID", "role-id")
+	_, err := Load()
+	assert.Error(t, err)
+
+	t.Setenv("ISSUER_VAULT_APPROLE_SECRET_ID", "secret-id")
+	cfg, err := Load()
+	require.NoError(t, err)
+	vaultCfg := cfg.KeyStore.VaultConfig()
+	assert.Equal(t, "approle", vaultCfg.Method())
+	assert.Equal(t, "role-id", vaultCfg.AppRoleID)
+	assert.Equal(t, "secret-id", vaultCfg.AppRoleSecretID)
+
+	t.Setenv("ISSUER_VAULT_AUTH_METHOD", "kubernetes")
+	_, err = Load()
+	assert.Error(t, err)
+
+	t.Setenv("ISSUER_VAULT_KUBERNETES_ROLE", "issuer-node")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, "kubernetes", cfg.KeyStore.VaultConfig().Method())
+	assert.Equal(t, "issuer-node", cfg.KeyStore.VaultConfig().KubernetesRole)
+
+	t.Setenv("ISSUER_VAULT_AUTH_METHOD", "ldap")
+	_, err = Load()
+	assert.Error(t, err)
+
+	t.Setenv("ISSUER_VAULT_AUTH_METHOD", "")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, "userpass", cfg.KeyStore.VaultConfig().Method())
+}
+
 func initVariables(t *testing.T) envVarsT {
 	t.Helper()
 	envVars := map[string]string{
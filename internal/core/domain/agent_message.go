@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+)
+
+const (
+	// AgentMessageInbound is a message received by the agent
+	AgentMessageInbound AgentMessageDirection = "inbound"
+	// AgentMessageOutbound is a message answered by the agent
+	AgentMessageOutbound AgentMessageDirection = "outbound"
+)
+
+// AgentMessageDirection tells if the message was received or answered by the agent
+type AgentMessageDirection string
+
+// AgentMessage is an iden3comm message of a thread between an issuer and a holder.
+// MessageID is the id of the message, unique per issuer and direction. CreatedTime and ExpiresTime are the
+// created_time and expires_time of the message, if any.
+type AgentMessage struct {
+	ID          uuid.UUID
+	MessageID   string
+	ThreadID    string
+	IssuerDID   w3c.DID
+	HolderDID   string
+	Direction   AgentMessageDirection
+	Type        iden3comm.ProtocolMessage
+	MediaType   iden3comm.MediaType
+	Body        json.RawMessage
+	CreatedTime *time.Time
+	ExpiresTime *time.Time
+	CreatedAt   time.Time
+}
+
+// NewAgentMessage creates an agent message. Messages without thread start a thread with their own id.
+func NewAgentMessage(direction AgentMessageDirection, messageID string, threadID string, issuerDID w3c.DID, holderDID string, messageType iden3comm.ProtocolMessage, mediaType iden3comm.MediaType, body json.RawMessage) *AgentMessage {
+	if threadID == "" {
+		threadID = messageID
+	}
+	return &AgentMessage{
+		ID:        uuid.New(),
+		MessageID: messageID,
+		ThreadID:  threadID,
+		IssuerDID: issuerDID,
+		HolderDID: holderDID,
+		Direction: direction,
+		Type:      messageType,
+		MediaType: mediaType,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}
+}
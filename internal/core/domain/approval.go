@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const (
+	// ApprovalOperationCredentialIssuance is the issuance of a credential
+	ApprovalOperationCredentialIssuance ApprovalOperation = "credentialIssuance"
+	// ApprovalOperationStatePublish is the publication of the identity state
+	ApprovalOperationStatePublish ApprovalOperation = "statePublish"
+
+	// ApprovalStatusPending the request is waiting for an approver
+	ApprovalStatusPending ApprovalStatus = "pending"
+	// ApprovalStatusApproved the request was approved and the operation executed
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	// ApprovalStatusRejected the request was rejected by an approver
+	ApprovalStatusRejected ApprovalStatus = "rejected"
+	// ApprovalStatusFailed the request was approved but the operation failed
+	ApprovalStatusFailed ApprovalStatus = "failed"
+)
+
+// ApprovalOperation is the operation an approval request is holding
+type ApprovalOperation string
+
+// ApprovalStatus is the status of an approval request
+type ApprovalStatus string
+
+// ApprovalRequest is an operation that requires a signature of the issuer and is waiting for a second person to approve it
+type ApprovalRequest struct {
+	ID          uuid.UUID
+	IssuerDID   w3c.DID
+	Operation   ApprovalOperation
+	Payload     json.RawMessage
+	Status      ApprovalStatus
+	RequestedBy string
+	ReviewedBy  *string
+	Reason      *string
+	ResultID    *string
+	CreatedAt   time.Time
+	ReviewedAt  *time.Time
+}
+
+// NewApprovalRequest creates a pending approval request
+func NewApprovalRequest(issuerDID w3c.DID, operation ApprovalOperation, payload json.RawMessage, requestedBy string) *ApprovalRequest {
+	return &ApprovalRequest{
+		ID:          uuid.New(),
+		IssuerDID:   issuerDID,
+		Operation:   operation,
+		Payload:     payload,
+		Status:      ApprovalStatusPending,
+		RequestedBy: requestedBy,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// IsPending returns true if the request is waiting for an approver
+func (a *ApprovalRequest) IsPending() bool {
+	return a.Status == ApprovalStatusPending
+}
@@ -48,6 +48,12 @@ type Claim struct {
 	MtProof   bool       `json:"mt_poof"`
 	LinkID    *uuid.UUID `json:"-"`
 	CreatedAt time.Time  `json:"-"`
+
+	DeliveryStatus    *CredentialDeliveryStatus `json:"-"`
+	DeliveryUpdatedAt *time.Time                `json:"-"`
+
+	IssuanceMode CredentialIssuanceMode `json:"-"`
+	OnchainTxID  *string                `json:"-"`
 }
 
 // Credentials is the type of array of credential
@@ -2,12 +2,29 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 )
 
+const (
+	// ConnectionMaxMetadataKeys is the maximum number of metadata entries of a connection
+	ConnectionMaxMetadataKeys = 50
+	// ConnectionMaxTags is the maximum number of tags of a connection
+	ConnectionMaxTags = 50
+
+	connectionMaxKeyLength   = 64
+	connectionMaxValueLength = 512
+)
+
+// ErrInvalidConnectionMetadata means the metadata or the tags of a connection are not valid
+var ErrInvalidConnectionMetadata = errors.New("invalid connection metadata")
+
 // Connection struct
 type Connection struct {
 	ID          uuid.UUID
@@ -15,7 +32,50 @@ type Connection struct {
 	UserDID     w3c.DID
 	IssuerDoc   json.RawMessage
 	UserDoc     json.RawMessage
+	Metadata    map[string]string
+	Tags        []string
 	CreatedAt   time.Time
 	ModifiedAt  time.Time
 	Credentials *Credentials
 }
+
+// SetMetadata replaces the metadata of the connection. The metadata are free-form key values set by the issuer,
+// like the id of the holder in an external system.
+func (c *Connection) SetMetadata(metadata map[string]string) error {
+	if len(metadata) > ConnectionMaxMetadataKeys {
+		return fmt.Errorf("%w: a connection can not have more than %d metadata entries", ErrInvalidConnectionMetadata, ConnectionMaxMetadataKeys)
+	}
+	m := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		key = strings.TrimSpace(key)
+		if key == "" || len(key) > connectionMaxKeyLength {
+			return fmt.Errorf("%w: metadata keys must have between 1 and %d characters", ErrInvalidConnectionMetadata, connectionMaxKeyLength)
+		}
+		if len(value) > connectionMaxValueLength {
+			return fmt.Errorf("%w: the value of the metadata key %q is longer than %d characters", ErrInvalidConnectionMetadata, key, connectionMaxValueLength)
+		}
+		m[key] = value
+	}
+	c.Metadata = m
+	return nil
+}
+
+// SetTags replaces the tags of the connection. The tags are trimmed, deduplicated and sorted.
+func (c *Connection) SetTags(tags []string) error {
+	t := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || len(tag) > connectionMaxKeyLength {
+			return fmt.Errorf("%w: tags must have between 1 and %d characters", ErrInvalidConnectionMetadata, connectionMaxKeyLength)
+		}
+		if !slices.Contains(t, tag) {
+			t = append(t, tag)
+		}
+	}
+	if len(t) > ConnectionMaxTags {
+		return fmt.Errorf("%w: a connection can not have more than %d tags", ErrInvalidConnectionMetadata, ConnectionMaxTags)
+	}
+	slices.Sort(t)
+	c.Tags = t
+	return nil
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+// ConnectionEventType is an entry of the history of a connection
+type ConnectionEventType string
+
+const (
+	// ConnectionEventCreated means the connection was created, either by the first authentication of the holder or by the issuer
+	ConnectionEventCreated ConnectionEventType = "created"
+	// ConnectionEventAuthenticated means the holder authenticated in a session
+	ConnectionEventAuthenticated ConnectionEventType = "authenticated"
+	// ConnectionEventDIDDocumentChanged means the holder sent a DID document different from the previous one
+	ConnectionEventDIDDocumentChanged ConnectionEventType = "didDocumentChanged"
+	// ConnectionEventPushServiceChanged means the push service of the DID document changed, e.g. the wallet was installed in a new device
+	ConnectionEventPushServiceChanged ConnectionEventType = "pushServiceChanged"
+)
+
+// ConnectionEvent is an entry of the history of a connection.
+// UserDoc is the DID document of the holder after a created or didDocumentChanged event,
+// PushService is the push service after a created or pushServiceChanged event and it is empty when the holder has none.
+type ConnectionEvent struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	Type         ConnectionEventType
+	SessionID    *uuid.UUID
+	UserDoc      json.RawMessage
+	PushService  json.RawMessage
+	CreatedAt    time.Time
+}
+
+// NewConnectionEvents returns the events of saving current over previous, which is nil for new connections.
+// An authenticated event is added when sessionID is not nil.
+func NewConnectionEvents(previous *Connection, current *Connection, connID uuid.UUID, sessionID *uuid.UUID) []*ConnectionEvent {
+	now := time.Now()
+	newEvent := func(eventType ConnectionEventType) *ConnectionEvent {
+		return &ConnectionEvent{ID: uuid.New(), ConnectionID: connID, Type: eventType, SessionID: sessionID, CreatedAt: now}
+	}
+
+	var events []*ConnectionEvent
+	pushService := findPushService(current.UserDoc)
+	if previous == nil {
+		event := newEvent(ConnectionEventCreated)
+		event.UserDoc, event.PushService = current.UserDoc, pushService
+		events = append(events, event)
+	} else {
+		if !sameJSON(previous.UserDoc, current.UserDoc) {
+			event := newEvent(ConnectionEventDIDDocumentChanged)
+			event.UserDoc = current.UserDoc
+			events = append(events, event)
+		}
+		if !sameJSON(findPushService(previous.UserDoc), pushService) {
+			event := newEvent(ConnectionEventPushServiceChanged)
+			event.PushService = pushService
+			events = append(events, event)
+		}
+	}
+	if sessionID != nil {
+		events = append(events, newEvent(ConnectionEventAuthenticated))
+	}
+	return events
+}
+
+// findPushService returns the push service of the DID document or nil if it has none or the document is not valid
+func findPushService(userDoc json.RawMessage) json.RawMessage {
+	var doc struct {
+		Service []json.RawMessage `json:"service"`
+	}
+	if err := json.Unmarshal(userDoc, &doc); err != nil {
+		return nil
+	}
+	for _, service := range doc.Service {
+		var s verifiable.Service
+		if err := json.Unmarshal(service, &s); err == nil && s.Type == verifiable.PushNotificationServiceType {
+			return service
+		}
+	}
+	return nil
+}
+
+// sameJSON returns true if both documents have the same content, regardless of the formatting and the order of the keys
+func sameJSON(a json.RawMessage, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return string(a) == string(b)
+	}
+	return reflect.DeepEqual(va, vb)
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnectionEvents(t *testing.T) {
+	const (
+		push      = `{"id": "did:example:123#push", "type": "push-notification", "serviceEndpoint": "https://push.example.com", "metadata": {"devices": [{"alg": "RSA-OAEP-512", "ciphertext": "device-1"}]}}`
+		newDevice = `{"id": "did:example:123#push", "type": "push-notification", "serviceEndpoint": "https://push.example.com", "metadata": {"devices": [{"alg": "RSA-OAEP-512", "ciphertext": "device-2"}]}}`
+		other     = `{"id": "did:example:123#other", "type": "other", "serviceEndpoint": "https://example.com"}`
+	)
+	doc := func(services ...string) json.RawMessage {
+		raw := `{"id": "did:example:123", "service": [`
+		for i, s := range services {
+			if i > 0 {
+				raw += ","
+			}
+			raw += s
+		}
+		return json.RawMessage(raw + `]}`)
+	}
+	types := func(events []*ConnectionEvent) []ConnectionEventType {
+		var t []ConnectionEventType
+		for _, e := range events {
+			t = append(t, e.Type)
+		}
+		return t
+	}
+
+	connID := uuid.New()
+	sessionID := uuid.New()
+
+	t.Run("new connection", func(t *testing.T) {
+		events := NewConnectionEvents(nil, &Connection{UserDoc: doc(other, push)}, connID, &sessionID)
+		require.Equal(t, []ConnectionEventType{ConnectionEventCreated, ConnectionEventAuthenticated}, types(events))
+		assert.Equal(t, connID, events[0].ConnectionID)
+		assert.Equal(t, &sessionID, events[0].SessionID)
+		assert.JSONEq(t, push, string(events[0].PushService))
+		assert.Nil(t, events[1].UserDoc)
+	})
+
+	t.Run("new connection created by the issuer", func(t *testing.T) {
+		events := NewConnectionEvents(nil, &Connection{UserDoc: doc()}, connID, nil)
+		require.Equal(t, []ConnectionEventType{ConnectionEventCreated}, types(events))
+		assert.Nil(t, events[0].SessionID)
+		assert.Nil(t, events[0].PushService)
+	})
+
+	t.Run("same document with a different format", func(t *testing.T) {
+		previous := &Connection{UserDoc: doc(push, other)}
+		current := &Connection{UserDoc: json.RawMessage(`{"service":[` + push + `,` + other + `],"id":"did:example:123"}`)}
+		events := NewConnectionEvents(previous, current, connID, &sessionID)
+		assert.Equal(t, []ConnectionEventType{ConnectionEventAuthenticated}, types(events))
+	})
+
+	t.Run("document changed but not the push service", func(t *testing.T) {
+		events := NewConnectionEvents(&Connection{UserDoc: doc(push)}, &Connection{UserDoc: doc(push, other)}, connID, &sessionID)
+		require.Equal(t, []ConnectionEventType{ConnectionEventDIDDocumentChanged, ConnectionEventAuthenticated}, types(events))
+		assert.JSONEq(t, string(doc(push, other)), string(events[0].UserDoc))
+	})
+
+	t.Run("new device", func(t *testing.T) {
+		events := NewConnectionEvents(&Connection{UserDoc: doc(push)}, &Connection{UserDoc: doc(newDevice)}, connID, &sessionID)
+		require.Equal(t, []ConnectionEventType{ConnectionEventDIDDocumentChanged, ConnectionEventPushServiceChanged, ConnectionEventAuthenticated}, types(events))
+		assert.JSONEq(t, newDevice, string(events[1].PushService))
+	})
+
+	t.Run("push service removed", func(t *testing.T) {
+		events := NewConnectionEvents(&Connection{UserDoc: doc(push)}, &Connection{UserDoc: doc()}, connID, nil)
+		require.Equal(t, []ConnectionEventType{ConnectionEventDIDDocumentChanged, ConnectionEventPushServiceChanged}, types(events))
+		assert.Nil(t, events[1].PushService)
+	})
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+)
+
+// BasicMessageType is the type of the free text messages the issuer sends to its connections
+const BasicMessageType iden3comm.ProtocolMessage = iden3comm.DidCommProtocol + "basicmessage/2.0/message"
+
+// BasicMessageBody is the body of the basic message
+type BasicMessageBody struct {
+	Content string `json:"content"`
+}
+
+// ConnectionMessageStatus is the result of the delivery of a message to the devices of the holder
+type ConnectionMessageStatus string
+
+const (
+	// ConnectionMessageSent means the push service accepted the message for at least one device of the holder
+	ConnectionMessageSent ConnectionMessageStatus = "sent"
+	// ConnectionMessageRejected means the push service rejected the message for every device of the holder
+	ConnectionMessageRejected ConnectionMessageStatus = "rejected"
+	// ConnectionMessageFailed means the message could not be sent, e.g. the holder has no push service
+	ConnectionMessageFailed ConnectionMessageStatus = "failed"
+	// ConnectionMessageVerified means the proofs of the holder that answer a proof request are valid
+	ConnectionMessageVerified ConnectionMessageStatus = "verified"
+	// ConnectionMessageInvalid means the proofs of the holder that answer a proof request are not valid
+	ConnectionMessageInvalid ConnectionMessageStatus = "invalid"
+)
+
+// ConnectionMessage is an iden3comm message sent by the issuer to one of its connections, or the answer of the holder
+// to a proof request. ID is also the id of the message, so it can be found in the thread of the agent.
+type ConnectionMessage struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	IssuerDID    w3c.DID
+	ThreadID     string
+	Type         iden3comm.ProtocolMessage
+	Status       ConnectionMessageStatus
+	Reason       *string
+	CreatedAt    time.Time
+}
+
+// NewConnectionMessage creates the record of a message sent to the connection
+func NewConnectionMessage(id uuid.UUID, conn *Connection, threadID string, messageType iden3comm.ProtocolMessage) *ConnectionMessage {
+	return &ConnectionMessage{
+		ID:           id,
+		ConnectionID: conn.ID,
+		IssuerDID:    conn.IssuerDID,
+		ThreadID:     threadID,
+		Type:         messageType,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// Delivered records the result of the push service. The message is sent if any device received it.
+func (m *ConnectionMessage) Delivered(result *UserNotificationResult) {
+	for _, device := range result.Devices {
+		if device.Status == DeviceNotificationStatusSuccess {
+			m.Status = ConnectionMessageSent
+			m.Reason = nil
+			return
+		}
+	}
+	m.Status = ConnectionMessageRejected
+	for _, device := range result.Devices {
+		if device.Reason != "" {
+			reason := device.Reason
+			m.Reason = &reason
+			return
+		}
+	}
+}
+
+// Failed records that the message could not be sent
+func (m *ConnectionMessage) Failed(err error) {
+	reason := err.Error()
+	m.Status = ConnectionMessageFailed
+	m.Reason = &reason
+}
+
+// ProofVerified records the result of the verification of the proofs of the holder
+func (m *ConnectionMessage) ProofVerified(err error) {
+	if err != nil {
+		reason := err.Error()
+		m.Status = ConnectionMessageInvalid
+		m.Reason = &reason
+		return
+	}
+	m.Status = ConnectionMessageVerified
+	m.Reason = nil
+}
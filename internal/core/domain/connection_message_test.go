@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectionMessage_Delivered(t *testing.T) {
+	conn := &Connection{ID: uuid.New()}
+	id := uuid.New()
+	message := NewConnectionMessage(id, conn, id.String(), BasicMessageType)
+	assert.Equal(t, conn.ID, message.ConnectionID)
+	assert.Equal(t, id.String(), message.ThreadID)
+
+	message.Delivered(&UserNotificationResult{Devices: []DeviceNotificationResult{
+		{Status: "rejected", Reason: "invalid token"},
+		{Status: DeviceNotificationStatusSuccess},
+	}})
+	assert.Equal(t, ConnectionMessageSent, message.Status)
+	assert.Nil(t, message.Reason)
+
+	message.Delivered(&UserNotificationResult{Devices: []DeviceNotificationResult{
+		{Status: "failed"},
+		{Status: "rejected", Reason: "invalid token"},
+	}})
+	assert.Equal(t, ConnectionMessageRejected, message.Status)
+	assert.Equal(t, "invalid token", *message.Reason)
+
+	message.Failed(errors.New("no push service in did document"))
+	assert.Equal(t, ConnectionMessageFailed, message.Status)
+	assert.Equal(t, "no push service in did document", *message.Reason)
+}
+
+func TestConnectionMessage_ProofVerified(t *testing.T) {
+	message := NewConnectionMessage(uuid.New(), &Connection{ID: uuid.New()}, uuid.NewString(), "https://iden3-communication.io/proofs/1.0/response")
+
+	message.ProofVerified(errors.New("proof is not valid"))
+	assert.Equal(t, ConnectionMessageInvalid, message.Status)
+	assert.Equal(t, "proof is not valid", *message.Reason)
+
+	message.ProofVerified(nil)
+	assert.Equal(t, ConnectionMessageVerified, message.Status)
+	assert.Nil(t, message.Reason)
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnection_SetMetadata(t *testing.T) {
+	conn := &Connection{}
+	require.NoError(t, conn.SetMetadata(map[string]string{" customerId ": "1234", "department": ""}))
+	assert.Equal(t, map[string]string{"customerId": "1234", "department": ""}, conn.Metadata)
+
+	require.NoError(t, conn.SetMetadata(nil))
+	assert.Equal(t, map[string]string{}, conn.Metadata)
+
+	assert.ErrorIs(t, conn.SetMetadata(map[string]string{" ": "value"}), ErrInvalidConnectionMetadata)
+	assert.ErrorIs(t, conn.SetMetadata(map[string]string{strings.Repeat("k", 65): "value"}), ErrInvalidConnectionMetadata)
+	assert.ErrorIs(t, conn.SetMetadata(map[string]string{"key": strings.Repeat("v", 513)}), ErrInvalidConnectionMetadata)
+
+	tooMany := make(map[string]string, ConnectionMaxMetadataKeys+1)
+	for i := 0; i <= ConnectionMaxMetadataKeys; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "value"
+	}
+	assert.ErrorIs(t, conn.SetMetadata(tooMany), ErrInvalidConnectionMetadata)
+	assert.Equal(t, map[string]string{}, conn.Metadata)
+}
+
+func TestConnection_SetTags(t *testing.T) {
+	conn := &Connection{}
+	require.NoError(t, conn.SetTags([]string{"vip", " sales ", "vip"}))
+	assert.Equal(t, []string{"sales", "vip"}, conn.Tags)
+
+	require.NoError(t, conn.SetTags(nil))
+	assert.Equal(t, []string{}, conn.Tags)
+
+	assert.ErrorIs(t, conn.SetTags([]string{""}), ErrInvalidConnectionMetadata)
+	assert.ErrorIs(t, conn.SetTags([]string{strings.Repeat("t", 65)}), ErrInvalidConnectionMetadata)
+
+	tooMany := make([]string, 0, ConnectionMaxTags+1)
+	for i := 0; i <= ConnectionMaxTags; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("tag%d", i))
+	}
+	assert.ErrorIs(t, conn.SetTags(tooMany), ErrInvalidConnectionMetadata)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "github.com/iden3/iden3comm/v2"
+
+// AckMessageType is the type of the message a holder sends to acknowledge the messages of a thread
+const AckMessageType iden3comm.ProtocolMessage = iden3comm.DidCommProtocol + "notification/1.0/ack"
+
+// AckStatusOK means the acknowledged messages were processed by the holder
+const AckStatusOK = "OK"
+
+// AckMessageBody is the body of the ack message
+type AckMessageBody struct {
+	Status string `json:"status,omitempty"`
+}
+
+// CredentialDeliveryStatus tells how far the delivery of a credential to its holder went.
+// Credentials that were never offered have no delivery status.
+type CredentialDeliveryStatus string
+
+const (
+	// CredentialDeliveryOffered means the credential offer was generated
+	CredentialDeliveryOffered CredentialDeliveryStatus = "offered"
+	// CredentialDeliveryFetched means the holder fetched the credential from the agent
+	CredentialDeliveryFetched CredentialDeliveryStatus = "fetched"
+	// CredentialDeliveryAcked means the holder acknowledged the reception of the credential
+	CredentialDeliveryAcked CredentialDeliveryStatus = "acked"
+)
+
+// credentialDeliveryStatuses are the delivery statuses in the order they are reached
+var credentialDeliveryStatuses = []CredentialDeliveryStatus{
+	CredentialDeliveryOffered,
+	CredentialDeliveryFetched,
+	CredentialDeliveryAcked,
+}
+
+// IsValid returns true if the status is a known delivery status
+func (s CredentialDeliveryStatus) IsValid() bool {
+	for _, status := range credentialDeliveryStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Previous returns the statuses that come before s. The delivery status never goes back,
+// so a credential can only move to s from one of them.
+func (s CredentialDeliveryStatus) Previous() []CredentialDeliveryStatus {
+	for i, status := range credentialDeliveryStatuses {
+		if s == status {
+			return credentialDeliveryStatuses[:i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialDeliveryStatus_Previous(t *testing.T) {
+	assert.Empty(t, CredentialDeliveryOffered.Previous())
+	assert.Equal(t, []CredentialDeliveryStatus{CredentialDeliveryOffered}, CredentialDeliveryFetched.Previous())
+	assert.Equal(t, []CredentialDeliveryStatus{CredentialDeliveryOffered, CredentialDeliveryFetched}, CredentialDeliveryAcked.Previous())
+	assert.Nil(t, CredentialDeliveryStatus("delivered").Previous())
+	assert.False(t, CredentialDeliveryStatus("delivered").IsValid())
+	assert.True(t, CredentialDeliveryAcked.IsValid())
+}
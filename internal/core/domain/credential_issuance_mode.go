@@ -0,0 +1,32 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrOnchainIssuanceNotConfigured means the identity has no on-chain identity contract to issue credentials
+	ErrOnchainIssuanceNotConfigured = errors.New("the identity is not configured to issue credentials on chain")
+	// ErrOnchainClaimNotPublished means the claim is not in the claims tree of the on-chain identity contract yet
+	ErrOnchainClaimNotPublished = errors.New("the claim is not published on chain yet")
+)
+
+// CredentialIssuanceMode tells where the claims of the credentials are published
+type CredentialIssuanceMode string
+
+const (
+	// CredentialIssuanceOffchain means the claim is added to the claims tree of the identity kept by the node
+	// and its proof is available once the identity state is published
+	CredentialIssuanceOffchain CredentialIssuanceMode = "offchain"
+	// CredentialIssuanceOnchain means the claim is added to the claims tree of the on-chain identity contract
+	// of the issuer and its proof is read from the contract
+	CredentialIssuanceOnchain CredentialIssuanceMode = "onchain"
+)
+
+// IsValid returns true if the mode is a known issuance mode
+func (m CredentialIssuanceMode) IsValid() bool {
+	return m == CredentialIssuanceOffchain || m == CredentialIssuanceOnchain
+}
+
+// IsOnchain returns true if the claim is published to the on-chain identity contract of the issuer
+func (m CredentialIssuanceMode) IsOnchain() bool {
+	return m == CredentialIssuanceOnchain
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "github.com/iden3/iden3comm/v2"
+
+const (
+	// DiscoverFeatureQueriesMessageType is the type of the discover-features queries message
+	DiscoverFeatureQueriesMessageType iden3comm.ProtocolMessage = iden3comm.DidCommProtocol + "discover-features/2.0/queries"
+	// DiscoverFeatureDiscloseMessageType is the type of the discover-features disclose message
+	DiscoverFeatureDiscloseMessageType iden3comm.ProtocolMessage = iden3comm.DidCommProtocol + "discover-features/2.0/disclose"
+)
+
+// DiscoverFeatureType is the kind of feature asked in a discover-features query
+type DiscoverFeatureType string
+
+// Feature types disclosed by the agent
+const (
+	DiscoverFeatureTypeProtocol             DiscoverFeatureType = "protocol"
+	DiscoverFeatureTypeAccept               DiscoverFeatureType = "accept"
+	DiscoverFeatureTypeProofType            DiscoverFeatureType = "proof-type"
+	DiscoverFeatureTypeCredentialStatusType DiscoverFeatureType = "credential-status-type"
+)
+
+// DiscoverFeatureQueriesMessageBody is the body of the discover-features queries message
+type DiscoverFeatureQueriesMessageBody struct {
+	Queries []DiscoverFeatureQuery `json:"queries"`
+}
+
+// DiscoverFeatureQuery asks for the features of a type. Match is the feature id and can end with * to match a prefix.
+type DiscoverFeatureQuery struct {
+	FeatureType DiscoverFeatureType `json:"feature-type"`
+	Match       string              `json:"match,omitempty"`
+}
+
+// DiscoverFeatureDiscloseMessageBody is the body of the discover-features disclose message
+type DiscoverFeatureDiscloseMessageBody struct {
+	Disclosures []DiscoverFeatureDisclosure `json:"disclosures"`
+}
+
+// DiscoverFeatureDisclosure is a feature supported by the agent
+type DiscoverFeatureDisclosure struct {
+	FeatureType DiscoverFeatureType `json:"feature-type"`
+	ID          string              `json:"id"`
+}
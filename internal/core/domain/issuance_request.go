@@ -0,0 +1,28 @@
+package domain
+
+// IssuanceRequest is a credential requested by a holder to the agent. It is the payload sent to the approval webhook.
+type IssuanceRequest struct {
+	ThreadID   string         `json:"threadID"`
+	IssuerDID  string         `json:"issuerDID"`
+	HolderDID  string         `json:"holderDID"`
+	SchemaURL  string         `json:"schemaURL"`
+	SchemaType string         `json:"schemaType"`
+	Data       map[string]any `json:"data"`
+	Expiration *int64         `json:"expiration,omitempty"`
+	// Connection is the connection of the holder with the issuer, if the holder is already connected
+	Connection *IssuanceRequestConnection `json:"connection,omitempty"`
+}
+
+// IssuanceRequestConnection is the connection of the holder sent to the approval webhook,
+// so the holder can be mapped to the records of the issuer
+type IssuanceRequestConnection struct {
+	ID       string            `json:"id"`
+	Metadata map[string]string `json:"metadata"`
+	Tags     []string          `json:"tags"`
+}
+
+// IssuanceDecision is the answer of the approval hook to an issuance request
+type IssuanceDecision struct {
+	Approved bool   `json:"approved"`
+	Reason   string `json:"reason,omitempty"`
+}
@@ -75,9 +75,12 @@ type Link struct {
 	CredentialSubject           CredentialSubject
 	Active                      bool
 	Schema                      *Schema
-	IssuedClaims                int // TODO: Give a value when link redemption is implemented
+	IssuedClaims                int // reserved atomically by the link repository for each credential issued
 	RefreshService              *verifiable.RefreshService
 	DisplayMethod               *verifiable.DisplayMethod
+	Allowlist                   bool // the link only issues credentials to the holders of its allowlist
+	InviteOnly                  bool // the link only issues credentials to the holders with one of its invite codes
+	Derivation                  *LinkDerivation
 	AuthorizationRequestMessage *pgtype.JSONB `json:"authorization_request_message"`
 	DeepLink                    string
 	UniversalLink               string
@@ -95,6 +98,7 @@ func NewLink(
 	credentialSubject CredentialSubject,
 	refreshService *verifiable.RefreshService,
 	displayMethod *verifiable.DisplayMethod,
+	derivation *LinkDerivation,
 ) *Link {
 	return &Link{
 		ID:                       uuid.New(),
@@ -110,6 +114,27 @@ func NewLink(
 		IssuedClaims:             0,
 		RefreshService:           refreshService,
 		DisplayMethod:            displayMethod,
+		Derivation:               derivation,
+	}
+}
+
+// LinkUsage is a credential issued by a link to a holder
+type LinkUsage struct {
+	ID        uuid.UUID
+	LinkID    uuid.UUID
+	HolderDID string
+	ClaimID   uuid.UUID
+	CreatedAt time.Time
+}
+
+// NewLinkUsage - Constructor
+func NewLinkUsage(linkID uuid.UUID, holderDID w3c.DID, claimID uuid.UUID) *LinkUsage {
+	return &LinkUsage{
+		ID:        uuid.New(),
+		LinkID:    linkID,
+		HolderDID: holderDID.String(),
+		ClaimID:   claimID,
+		CreatedAt: time.Now(),
 	}
 }
 
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const (
+	// LinkAllowlistKeyDID is the key of the entries of a holder DID
+	LinkAllowlistKeyDID LinkAllowlistKeyType = "did"
+	// LinkAllowlistKeyEmailHash is the key of the entries of the hash of a holder email
+	LinkAllowlistKeyEmailHash LinkAllowlistKeyType = "emailHash"
+	// LinkAllowlistKeyCode is the key of the entries of a one-time code
+	LinkAllowlistKeyCode LinkAllowlistKeyType = "code"
+)
+
+// ErrInvalidLinkAllowlist means the uploaded allowlist entries are malformed
+var ErrInvalidLinkAllowlist = errors.New("invalid link allowlist")
+
+// LinkAllowlistKeyType is the type of the key that identifies the holder of a link allowlist entry
+type LinkAllowlistKeyType string
+
+// IsValid returns true if the key type is supported
+func (t LinkAllowlistKeyType) IsValid() bool {
+	switch t {
+	case LinkAllowlistKeyDID, LinkAllowlistKeyEmailHash, LinkAllowlistKeyCode:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizeKey returns the key as it is stored in the entries of the key type, so the keys presented by the holders
+// match the uploaded ones regardless of the whitespace and, for email hashes, the case of the hex digits.
+func (t LinkAllowlistKeyType) NormalizeKey(key string) string {
+	key = strings.TrimSpace(key)
+	if t == LinkAllowlistKeyEmailHash {
+		return strings.ToLower(key)
+	}
+	return key
+}
+
+// LinkAllowlistEntry is a holder allowed to get a credential from a link with the attributes of the credential.
+// Entries keyed by DID are bound to the holder on creation, the rest of them when the holder presents the key.
+// ClaimID and ClaimedAt are set once the credential of the entry is issued.
+type LinkAllowlistEntry struct {
+	ID                uuid.UUID
+	LinkID            uuid.UUID
+	KeyType           LinkAllowlistKeyType
+	Key               string
+	CredentialSubject CredentialSubject
+	HolderDID         *string
+	ClaimID           *uuid.UUID
+	ClaimedAt         *time.Time
+	CreatedAt         time.Time
+}
+
+// NewLinkAllowlistEntry validates the key and creates an allowlist entry for the link
+func NewLinkAllowlistEntry(linkID uuid.UUID, keyType LinkAllowlistKeyType, key string, credentialSubject CredentialSubject) (*LinkAllowlistEntry, error) {
+	if !keyType.IsValid() {
+		return nil, fmt.Errorf("%w: unsupported key type %q", ErrInvalidLinkAllowlist, keyType)
+	}
+	key = keyType.NormalizeKey(key)
+	if key == "" {
+		return nil, fmt.Errorf("%w: empty %s key", ErrInvalidLinkAllowlist, keyType)
+	}
+	if credentialSubject == nil {
+		credentialSubject = CredentialSubject{}
+	}
+
+	entry := &LinkAllowlistEntry{
+		ID:                uuid.New(),
+		LinkID:            linkID,
+		KeyType:           keyType,
+		Key:               key,
+		CredentialSubject: credentialSubject,
+		CreatedAt:         time.Now(),
+	}
+	switch keyType {
+	case LinkAllowlistKeyDID:
+		did, err := w3c.ParseDID(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid did %q", ErrInvalidLinkAllowlist, key)
+		}
+		entry.Key = did.String()
+		entry.HolderDID = &entry.Key
+	}
+	return entry, nil
+}
+
+// IsClaimed returns true if the credential of the entry has been issued
+func (e *LinkAllowlistEntry) IsClaimed() bool {
+	return e.ClaimedAt != nil
+}
+
+// Subject returns the credential subject of the link with the attributes of the entry
+func (e *LinkAllowlistEntry) Subject(linkSubject CredentialSubject) CredentialSubject {
+	subject := make(CredentialSubject, len(linkSubject)+len(e.CredentialSubject))
+	for key, val := range linkSubject {
+		subject[key] = val
+	}
+	for key, val := range e.CredentialSubject {
+		subject[key] = val
+	}
+	return subject
+}
+
+// ParseLinkAllowlistCSV reads the allowlist entries of the link from a CSV.
+// The first column of the header is the key type and the rest of them the attributes of the credentials.
+// The values of the attributes are strings, empty values are skipped so the attribute of the link is used.
+func ParseLinkAllowlistCSV(r io.Reader, linkID uuid.UUID) ([]*LinkAllowlistEntry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: empty csv", ErrInvalidLinkAllowlist)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLinkAllowlist, err)
+	}
+	keyType := LinkAllowlistKeyType(strings.TrimSpace(header[0]))
+	if !keyType.IsValid() {
+		return nil, fmt.Errorf("%w: the first column must be one of did, emailHash or code", ErrInvalidLinkAllowlist)
+	}
+
+	entries := make([]*LinkAllowlistEntry, 0)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLinkAllowlist, err)
+		}
+		subject := make(CredentialSubject, len(record)-1)
+		for i, val := range record[1:] {
+			if val != "" {
+				subject[strings.TrimSpace(header[i+1])] = val
+			}
+		}
+		entry, err := NewLinkAllowlistEntry(linkID, keyType, record[0], subject)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
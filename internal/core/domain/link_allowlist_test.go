@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+)
+
+func TestParseLinkAllowlistCSV(t *testing.T) {
+	const holderDID = "did:iden3:polygon:amoy:x7Z95VkUuyo6mqraJw2VGwCfqTzdqhM1RVjRHzcpK"
+	linkID := uuid.New()
+
+	type testConfig struct {
+		name     string
+		csv      string
+		expected []LinkAllowlistEntry
+		err      string
+	}
+	for _, tc := range []testConfig{
+		{
+			name: "did keys",
+			csv:  "did,birthday,documentType\n" + holderDID + ",19960424,2\n",
+			expected: []LinkAllowlistEntry{
+				{KeyType: LinkAllowlistKeyDID, Key: holderDID, HolderDID: common.ToPointer(holderDID), CredentialSubject: CredentialSubject{"birthday": "19960424", "documentType": "2"}},
+			},
+		},
+		{
+			name: "email hashes with empty attributes",
+			csv:  "emailHash, documentType\n5F4DCC3B5AA765D61D8327DEB882CF99,\n",
+			expected: []LinkAllowlistEntry{
+				{KeyType: LinkAllowlistKeyEmailHash, Key: "5f4dcc3b5aa765d61d8327deb882cf99", CredentialSubject: CredentialSubject{}},
+			},
+		},
+		{
+			name:     "header only",
+			csv:      "code,documentType\n",
+			expected: []LinkAllowlistEntry{},
+		},
+		{
+			name: "empty",
+			csv:  "",
+			err:  "invalid link allowlist: empty csv",
+		},
+		{
+			name: "unknown key type",
+			csv:  "email,documentType\nme@example.com,2\n",
+			err:  "invalid link allowlist: the first column must be one of did, emailHash or code",
+		},
+		{
+			name: "invalid did",
+			csv:  "did,documentType\n" + holderDID + ",2\nnot-a-did,3\n",
+			err:  `line 3: invalid link allowlist: invalid did "not-a-did"`,
+		},
+		{
+			name: "empty code",
+			csv:  "code,documentType\n,2\n",
+			err:  "line 2: invalid link allowlist: empty code key",
+		},
+		{
+			name: "wrong number of fields",
+			csv:  "code,documentType\nABC123\n",
+			err:  "invalid link allowlist: record on line 2: wrong number of fields",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := ParseLinkAllowlistCSV(strings.NewReader(tc.csv), linkID)
+			if tc.err != "" {
+				require.ErrorIs(t, err, ErrInvalidLinkAllowlist)
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, entries, len(tc.expected))
+			for i, entry := range entries {
+				assert.Equal(t, linkID, entry.LinkID)
+				assert.Equal(t, tc.expected[i].KeyType, entry.KeyType)
+				assert.Equal(t, tc.expected[i].Key, entry.Key)
+				assert.Equal(t, tc.expected[i].HolderDID, entry.HolderDID)
+				assert.Equal(t, tc.expected[i].CredentialSubject, entry.CredentialSubject)
+				assert.False(t, entry.IsClaimed())
+			}
+		})
+	}
+}
+
+func TestLinkAllowlistEntry_Subject(t *testing.T) {
+	entry, err := NewLinkAllowlistEntry(uuid.New(), LinkAllowlistKeyCode, "ABC123", CredentialSubject{"documentType": 2})
+	require.NoError(t, err)
+	linkSubject := CredentialSubject{"birthday": 19960424, "documentType": 1}
+
+	assert.Equal(t, CredentialSubject{"birthday": 19960424, "documentType": 2}, entry.Subject(linkSubject))
+	assert.Equal(t, CredentialSubject{"birthday": 19960424, "documentType": 1}, linkSubject)
+}
+
+func TestLinkAllowlistKeyType_NormalizeKey(t *testing.T) {
+	assert.Equal(t, "5f4dcc3b5aa765d61d8327deb882cf99", LinkAllowlistKeyEmailHash.NormalizeKey(" 5F4DCC3B5AA765D61D8327DEB882CF99 "))
+	assert.Equal(t, "ABC123", LinkAllowlistKeyCode.NormalizeKey(" ABC123\n"))
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-circuits/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+)
+
+const (
+	// LinkDerivationCopy copies the disclosed value to the attribute
+	LinkDerivationCopy LinkDerivationRule = "copy"
+	// LinkDerivationMap sets the attribute to the value mapped to the disclosed value, or to the default value
+	LinkDerivationMap LinkDerivationRule = "map"
+	// LinkDerivationMinAge sets the attribute to true if the disclosed birth date is at least MinAge years ago
+	LinkDerivationMinAge LinkDerivationRule = "minAge"
+	// LinkDerivationConstant sets the attribute to a fixed value once the proofs are verified
+	LinkDerivationConstant LinkDerivationRule = "constant"
+)
+
+var (
+	ErrInvalidLinkDerivation = errors.New("invalid link derivation")                            // ErrInvalidLinkDerivation means the proof requests or derived attributes of a link are malformed
+	ErrLinkDerivation        = errors.New("cannot derive the attributes from the holder proof") // ErrLinkDerivation means the disclosed values of the holder cannot be derived into the attributes
+)
+
+// LinkDerivationRule is how a derived attribute is computed from the values disclosed by the holder
+type LinkDerivationRule string
+
+// LinkDerivation is the proof requested to the holders of a link and the attributes of the credential derived from the
+// values they disclose, e.g. a birth date disclosed from a credential of another issuer into an isAdult attribute.
+type LinkDerivation struct {
+	ProofRequests []protocol.ZeroKnowledgeProofRequest `json:"proofRequests"`
+	Attributes    []LinkDerivedAttribute               `json:"attributes"`
+}
+
+// LinkDerivedAttribute is an attribute of the credential derived from the disclosed value of Field
+type LinkDerivedAttribute struct {
+	Attribute string                 `json:"attribute"`
+	Rule      LinkDerivationRule     `json:"rule"`
+	Field     string                 `json:"field,omitempty"`
+	Values    map[string]interface{} `json:"values,omitempty"`
+	Default   interface{}            `json:"default,omitempty"`
+	MinAge    int                    `json:"minAge,omitempty"`
+	Value     interface{}            `json:"value,omitempty"`
+}
+
+// Validate checks the proof requests and the derived attributes and sets the ids of the proof requests without id.
+// Only the circuits whose verification keys are shipped with the issuer are supported and the fields of the
+// derived attributes must be selectively disclosed by a proof request.
+func (d *LinkDerivation) Validate() error {
+	if len(d.ProofRequests) == 0 {
+		return fmt.Errorf("%w: at least one proof request is required", ErrInvalidLinkDerivation)
+	}
+	ids := make(map[uint32]bool, len(d.ProofRequests))
+	disclosed := make(map[string]bool)
+	for i := range d.ProofRequests {
+		req := &d.ProofRequests[i]
+		switch circuits.CircuitID(req.CircuitID) {
+		case circuits.AtomicQueryMTPV2CircuitID, circuits.AtomicQuerySigV2CircuitID:
+		default:
+			return fmt.Errorf("%w: unsupported circuit %q", ErrInvalidLinkDerivation, req.CircuitID)
+		}
+		if len(req.Query) == 0 {
+			return fmt.Errorf("%w: proof request without query", ErrInvalidLinkDerivation)
+		}
+		if req.ID == 0 {
+			req.ID = uint32(i + 1)
+		}
+		if ids[req.ID] {
+			return fmt.Errorf("%w: duplicated proof request id %d", ErrInvalidLinkDerivation, req.ID)
+		}
+		ids[req.ID] = true
+
+		subject, _ := req.Query["credentialSubject"].(map[string]interface{})
+		for field, op := range subject {
+			if ops, ok := op.(map[string]interface{}); ok && len(ops) == 0 {
+				disclosed[field] = true
+			}
+		}
+	}
+
+	attributes := make(map[string]bool, len(d.Attributes))
+	for _, attr := range d.Attributes {
+		if attr.Attribute == "" {
+			return fmt.Errorf("%w: derived attribute without name", ErrInvalidLinkDerivation)
+		}
+		if attributes[attr.Attribute] {
+			return fmt.Errorf("%w: duplicated derived attribute %s", ErrInvalidLinkDerivation, attr.Attribute)
+		}
+		attributes[attr.Attribute] = true
+
+		if attr.Rule == LinkDerivationConstant {
+			if attr.Value == nil {
+				return fmt.Errorf("%w: %s: constant without value", ErrInvalidLinkDerivation, attr.Attribute)
+			}
+			continue
+		}
+		if !disclosed[attr.Field] {
+			return fmt.Errorf("%w: %s: field %q is not selectively disclosed by any proof request", ErrInvalidLinkDerivation, attr.Attribute, attr.Field)
+		}
+		switch attr.Rule {
+		case LinkDerivationCopy:
+		case LinkDerivationMap:
+			if len(attr.Values) == 0 {
+				return fmt.Errorf("%w: %s: map without values", ErrInvalidLinkDerivation, attr.Attribute)
+			}
+		case LinkDerivationMinAge:
+			if attr.MinAge <= 0 {
+				return fmt.Errorf("%w: %s: minAge must be higher than 0", ErrInvalidLinkDerivation, attr.Attribute)
+			}
+		default:
+			return fmt.Errorf("%w: %s: unsupported rule %q", ErrInvalidLinkDerivation, attr.Attribute, attr.Rule)
+		}
+	}
+	return nil
+}
+
+// Derive computes the derived attributes from the values disclosed by the holder
+func (d *LinkDerivation) Derive(disclosed map[string]interface{}, now time.Time) (CredentialSubject, error) {
+	subject := make(CredentialSubject, len(d.Attributes))
+	for _, attr := range d.Attributes {
+		if attr.Rule == LinkDerivationConstant {
+			subject[attr.Attribute] = attr.Value
+			continue
+		}
+		val, ok := disclosed[attr.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s was not disclosed", ErrLinkDerivation, attr.Field)
+		}
+		switch attr.Rule {
+		case LinkDerivationCopy:
+			subject[attr.Attribute] = val
+		case LinkDerivationMap:
+			mapped, ok := attr.Values[fmt.Sprint(val)]
+			if !ok {
+				mapped = attr.Default
+			}
+			if mapped == nil {
+				return nil, fmt.Errorf("%w: no value of %s for %s %v", ErrLinkDerivation, attr.Attribute, attr.Field, val)
+			}
+			subject[attr.Attribute] = mapped
+		case LinkDerivationMinAge:
+			birthDate, err := parseBirthDate(val)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %s", ErrLinkDerivation, attr.Field, err)
+			}
+			subject[attr.Attribute] = !birthDate.AddDate(attr.MinAge, 0, 0).After(now)
+		}
+	}
+	return subject, nil
+}
+
+// DisclosedValues returns the values of the credential subjects of the verifiable presentations of the proofs
+func DisclosedValues(scope []protocol.ZeroKnowledgeProofResponse) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	for _, proof := range scope {
+		if len(proof.VerifiablePresentation) == 0 {
+			continue
+		}
+		var vp struct {
+			VerifiableCredential struct {
+				CredentialSubject map[string]interface{} `json:"credentialSubject"`
+			} `json:"verifiableCredential"`
+		}
+		d := json.NewDecoder(bytes.NewReader(proof.VerifiablePresentation))
+		d.UseNumber()
+		if err := d.Decode(&vp); err != nil {
+			return nil, fmt.Errorf("%w: invalid verifiable presentation: %s", ErrLinkDerivation, err)
+		}
+		for field, val := range vp.VerifiableCredential.CredentialSubject {
+			if !strings.HasPrefix(field, "@") && field != "type" && field != "id" {
+				values[field] = val
+			}
+		}
+	}
+	return values, nil
+}
+
+// parseBirthDate parses the dates of the iden3 schemas, integers like 19960424, and date strings
+func parseBirthDate(val interface{}) (time.Time, error) {
+	str := fmt.Sprint(val)
+	if _, err := strconv.Atoi(str); err == nil {
+		return time.Parse("20060102", str)
+	}
+	if date, err := time.Parse(time.DateOnly, str); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, str)
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func kycAgeProofRequest(circuitID string, credentialSubject map[string]interface{}) protocol.ZeroKnowledgeProofRequest {
+	return protocol.ZeroKnowledgeProofRequest{
+		CircuitID: circuitID,
+		Query: map[string]interface{}{
+			"allowedIssuers":    []interface{}{"*"},
+			"context":           "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+			"type":              "KYCAgeCredential",
+			"credentialSubject": credentialSubject,
+		},
+	}
+}
+
+func TestLinkDerivation_Validate(t *testing.T) {
+	disclosure := map[string]interface{}{"birthday": map[string]interface{}{}}
+	type testConfig struct {
+		name       string
+		derivation LinkDerivation
+		err        string
+	}
+	for _, tc := range []testConfig{
+		{
+			name: "valid",
+			derivation: LinkDerivation{
+				ProofRequests: []protocol.ZeroKnowledgeProofRequest{kycAgeProofRequest("credentialAtomicQuerySigV2", disclosure)},
+				Attributes:    []LinkDerivedAttribute{{Attribute: "isAdult", Rule: LinkDerivationMinAge, Field: "birthday", MinAge: 18}},
+			},
+		},
+		{
+			name: "constant from a query without disclosure",
+			derivation: LinkDerivation{
+				ProofRequests: []protocol.ZeroKnowledgeProofRequest{kycAgeProofRequest("credentialAtomicQueryMTPV2", map[string]interface{}{
+					"birthday": map[string]interface{}{"$lt": 20060101},
+				})},
+				Attributes: []LinkDerivedAttribute{{Attribute: "isAdult", Rule: LinkDerivationConstant, Value: true}},
+			},
+		},
+		{
+			name: "no proof requests",
+			err:  "invalid link derivation: at least one proof request is required",
+		},
+		{
+			name: "unsupported circuit",
+			derivation: LinkDerivation{
+				ProofRequests: []protocol.ZeroKnowledgeProofRequest{kycAgeProofRequest("credentialAtomicQueryV3-beta.1", disclosure)},
+			},
+			err: `invalid link derivation: unsupported circuit "credentialAtomicQueryV3-beta.1"`,
+		},
+		{
+			name: "field not disclosed",
+			derivation: LinkDerivation{
+				ProofRequests: []protocol.ZeroKnowledgeProofRequest{kycAgeProofRequest("credentialAtomicQuerySigV2", disclosure)},
+				Attributes:    []LinkDerivedAttribute{{Attribute: "region", Rule: LinkDerivationMap, Field: "country", Values: map[string]interface{}{"AR": "LATAM"}}},
+			},
+			err: `invalid link derivation: region: field "country" is not selectively disclosed by any proof request`,
+		},
+		{
+			name: "map without values",
+			derivation: LinkDerivation{
+				ProofRequests: []protocol.ZeroKnowledgeProofRequest{kycAgeProofRequest("credentialAtomicQuerySigV2", disclosure)},
+				Attributes:    []LinkDerivedAttribute{{Attribute: "generation", Rule: LinkDerivationMap, Field: "birthday"}},
+			},
+			err: "invalid link derivation: generation: map without values",
+		},
+		{
+			name: "unsupported rule",
+			derivation: LinkDerivation{
+				ProofRequests: []protocol.ZeroKnowledgeProofRequest{kycAgeProofRequest("credentialAtomicQuerySigV2", disclosure)},
+				Attributes:    []LinkDerivedAttribute{{Attribute: "isAdult", Rule: "age", Field: "birthday"}},
+			},
+			err: `invalid link derivation: isAdult: unsupported rule "age"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.derivation.Validate()
+			if tc.err != "" {
+				require.ErrorIs(t, err, ErrInvalidLinkDerivation)
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			for i, req := range tc.derivation.ProofRequests {
+				assert.Equal(t, uint32(i+1), req.ID)
+			}
+		})
+	}
+}
+
+func TestLinkDerivation_Derive(t *testing.T) {
+	vp := json.RawMessage(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"@type": "VerifiablePresentation",
+		"verifiableCredential": {
+			"@context": ["https://www.w3.org/2018/credentials/v1", "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld"],
+			"@type": ["VerifiableCredential", "KYCAgeCredential"],
+			"credentialSubject": {"@type": "KYCAgeCredential", "birthday": 19960424, "country": "AR"}
+		}
+	}`)
+	disclosed, err := DisclosedValues([]protocol.ZeroKnowledgeProofResponse{{ID: 1, VerifiablePresentation: vp}, {ID: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"birthday": json.Number("19960424"), "country": "AR"}, disclosed)
+
+	derivation := LinkDerivation{
+		Attributes: []LinkDerivedAttribute{
+			{Attribute: "isAdult", Rule: LinkDerivationMinAge, Field: "birthday", MinAge: 18},
+			{Attribute: "isSenior", Rule: LinkDerivationMinAge, Field: "birthday", MinAge: 65},
+			{Attribute: "region", Rule: LinkDerivationMap, Field: "country", Values: map[string]interface{}{"AR": "LATAM", "ES": "EU"}},
+			{Attribute: "birthday", Rule: LinkDerivationCopy, Field: "birthday"},
+			{Attribute: "verified", Rule: LinkDerivationConstant, Value: true},
+		},
+	}
+	now := time.Date(2024, 4, 24, 0, 0, 0, 0, time.UTC)
+	subject, err := derivation.Derive(disclosed, now)
+	require.NoError(t, err)
+	assert.Equal(t, CredentialSubject{
+		"isAdult":  true,
+		"isSenior": false,
+		"region":   "LATAM",
+		"birthday": json.Number("19960424"),
+		"verified": true,
+	}, subject)
+
+	t.Run("turns of age on the birthday", func(t *testing.T) {
+		minAge := LinkDerivation{Attributes: []LinkDerivedAttribute{{Attribute: "isAdult", Rule: LinkDerivationMinAge, Field: "birthday", MinAge: 28}}}
+		subject, err := minAge.Derive(map[string]interface{}{"birthday": "1996-04-24"}, now)
+		require.NoError(t, err)
+		assert.Equal(t, true, subject["isAdult"])
+		subject, err = minAge.Derive(map[string]interface{}{"birthday": "1996-04-25"}, now)
+		require.NoError(t, err)
+		assert.Equal(t, false, subject["isAdult"])
+	})
+
+	t.Run("unmapped value uses the default", func(t *testing.T) {
+		withDefault := LinkDerivation{Attributes: []LinkDerivedAttribute{{Attribute: "region", Rule: LinkDerivationMap, Field: "country", Values: map[string]interface{}{"ES": "EU"}, Default: "OTHER"}}}
+		subject, err := withDefault.Derive(disclosed, now)
+		require.NoError(t, err)
+		assert.Equal(t, "OTHER", subject["region"])
+	})
+
+	t.Run("unmapped value without default", func(t *testing.T) {
+		withoutDefault := LinkDerivation{Attributes: []LinkDerivedAttribute{{Attribute: "region", Rule: LinkDerivationMap, Field: "country", Values: map[string]interface{}{"ES": "EU"}}}}
+		_, err := withoutDefault.Derive(disclosed, now)
+		assert.ErrorIs(t, err, ErrLinkDerivation)
+	})
+
+	t.Run("not disclosed", func(t *testing.T) {
+		_, err := derivation.Derive(map[string]interface{}{"country": "AR"}, now)
+		assert.ErrorIs(t, err, ErrLinkDerivation)
+	})
+
+	t.Run("invalid birth date", func(t *testing.T) {
+		_, err := derivation.Derive(map[string]interface{}{"birthday": "yesterday", "country": "AR"}, now)
+		assert.ErrorIs(t, err, ErrLinkDerivation)
+	})
+}
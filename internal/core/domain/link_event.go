@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// LinkEventType is a step of the funnel of a link, from the qr code to the credential in the wallet
+type LinkEventType string
+
+const (
+	// LinkEventQRDisplayed means the qr code of the link was created
+	LinkEventQRDisplayed LinkEventType = "qrDisplayed"
+	// LinkEventCallbackReceived means a holder sent the authorization response to the callback of the link
+	LinkEventCallbackReceived LinkEventType = "callbackReceived"
+	// LinkEventAuthenticationFailed means the authorization response of a holder was not valid
+	LinkEventAuthenticationFailed LinkEventType = "authenticationFailed"
+	// LinkEventCredentialIssued means a credential of the link was issued to a holder
+	LinkEventCredentialIssued LinkEventType = "credentialIssued"
+	// LinkEventOfferCreated means the credential offer was generated for a holder
+	LinkEventOfferCreated LinkEventType = "offerCreated"
+	// LinkEventCredentialFetched means a holder fetched the credential from the agent
+	LinkEventCredentialFetched LinkEventType = "credentialFetched"
+)
+
+// LinkStatsInterval is the size of the buckets of the link stats
+type LinkStatsInterval string
+
+const (
+	// LinkStatsHour groups the events by hour
+	LinkStatsHour LinkStatsInterval = "hour"
+	// LinkStatsDay groups the events by day
+	LinkStatsDay LinkStatsInterval = "day"
+	// LinkStatsWeek groups the events by week, starting on monday
+	LinkStatsWeek LinkStatsInterval = "week"
+	// LinkStatsMonth groups the events by month
+	LinkStatsMonth LinkStatsInterval = "month"
+
+	// LinkStatsMaxBuckets is the maximum number of buckets returned at once
+	LinkStatsMaxBuckets = 1000
+)
+
+// ErrInvalidLinkStats means the range or the interval of the link stats is not valid
+var ErrInvalidLinkStats = errors.New("invalid link stats")
+
+// LinkEvent is an event of the funnel of a link. HolderDID is only known once the holder is authenticated.
+type LinkEvent struct {
+	ID        uuid.UUID
+	LinkID    uuid.UUID
+	Type      LinkEventType
+	HolderDID *string
+	CreatedAt time.Time
+}
+
+// NewLinkEvent creates an event of the link
+func NewLinkEvent(linkID uuid.UUID, eventType LinkEventType, holderDID *w3c.DID) *LinkEvent {
+	event := &LinkEvent{
+		ID:        uuid.New(),
+		LinkID:    linkID,
+		Type:      eventType,
+		CreatedAt: time.Now(),
+	}
+	if holderDID != nil {
+		holder := holderDID.String()
+		event.HolderDID = &holder
+	}
+	return event
+}
+
+// LinkEventCount is the number of events of a type in the bucket that starts at Time
+type LinkEventCount struct {
+	Time  time.Time
+	Type  LinkEventType
+	Count int
+}
+
+// LinkEventCounts are the number of events of every type
+type LinkEventCounts struct {
+	QRDisplayed          int
+	CallbackReceived     int
+	AuthenticationFailed int
+	CredentialIssued     int
+	OfferCreated         int
+	CredentialFetched    int
+}
+
+// Add adds count events of the given type. Unknown types are ignored.
+func (c *LinkEventCounts) Add(eventType LinkEventType, count int) {
+	switch eventType {
+	case LinkEventQRDisplayed:
+		c.QRDisplayed += count
+	case LinkEventCallbackReceived:
+		c.CallbackReceived += count
+	case LinkEventAuthenticationFailed:
+		c.AuthenticationFailed += count
+	case LinkEventCredentialIssued:
+		c.CredentialIssued += count
+	case LinkEventOfferCreated:
+		c.OfferCreated += count
+	case LinkEventCredentialFetched:
+		c.CredentialFetched += count
+	}
+}
+
+// LinkStatsBucket are the events that happened between Time and the start of the next bucket
+type LinkStatsBucket struct {
+	Time   time.Time
+	Counts LinkEventCounts
+}
+
+// LinkStats are the events of the links between From and To, grouped in buckets of Interval.
+// Every bucket of the range is present, so they can be charted as they are.
+type LinkStats struct {
+	From     time.Time
+	To       time.Time
+	Interval LinkStatsInterval
+	Totals   LinkEventCounts
+	Buckets  []*LinkStatsBucket
+}
+
+// NewLinkStats groups the event counts in the buckets between from and to. The times are in UTC.
+func NewLinkStats(from time.Time, to time.Time, interval LinkStatsInterval, counts []*LinkEventCount) (*LinkStats, error) {
+	if err := interval.Validate(); err != nil {
+		return nil, err
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidLinkStats)
+	}
+
+	stats := &LinkStats{From: from.UTC(), To: to.UTC(), Interval: interval}
+	index := make(map[time.Time]*LinkStatsBucket)
+	for t := interval.Truncate(stats.From); t.Before(stats.To); t = interval.Next(t) {
+		if len(stats.Buckets) == LinkStatsMaxBuckets {
+			return nil, fmt.Errorf("%w: the range has more than %d buckets of one %s", ErrInvalidLinkStats, LinkStatsMaxBuckets, interval)
+		}
+		bucket := &LinkStatsBucket{Time: t}
+		stats.Buckets = append(stats.Buckets, bucket)
+		index[t] = bucket
+	}
+
+	for _, count := range counts {
+		bucket, ok := index[interval.Truncate(count.Time)]
+		if !ok {
+			continue
+		}
+		bucket.Counts.Add(count.Type, count.Count)
+		stats.Totals.Add(count.Type, count.Count)
+	}
+	return stats, nil
+}
+
+// Validate returns an error if the interval is not supported
+func (i LinkStatsInterval) Validate() error {
+	switch i {
+	case LinkStatsHour, LinkStatsDay, LinkStatsWeek, LinkStatsMonth:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown interval %q", ErrInvalidLinkStats, i)
+}
+
+// Truncate returns the start of the bucket of t in UTC, the same way as date_trunc does in postgres
+func (i LinkStatsInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case LinkStatsHour:
+		return t.Truncate(time.Hour)
+	case LinkStatsWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case LinkStatsMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Next returns the start of the bucket after the one that starts at t
+func (i LinkStatsInterval) Next(t time.Time) time.Time {
+	switch i {
+	case LinkStatsHour:
+		return t.Add(time.Hour)
+	case LinkStatsWeek:
+		return t.AddDate(0, 0, 7)
+	case LinkStatsMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkStatsInterval_Truncate(t *testing.T) {
+	// wednesday
+	at := time.Date(2024, 11, 6, 15, 42, 10, 0, time.FixedZone("CET", 3600))
+	for _, tc := range []struct {
+		interval LinkStatsInterval
+		expected time.Time
+		next     time.Time
+	}{
+		{LinkStatsHour, time.Date(2024, 11, 6, 14, 0, 0, 0, time.UTC), time.Date(2024, 11, 6, 15, 0, 0, 0, time.UTC)},
+		{LinkStatsDay, time.Date(2024, 11, 6, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 7, 0, 0, 0, 0, time.UTC)},
+		{LinkStatsWeek, time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 11, 0, 0, 0, 0, time.UTC)},
+		{LinkStatsMonth, time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(string(tc.interval), func(t *testing.T) {
+			bucket := tc.interval.Truncate(at)
+			assert.Equal(t, tc.expected, bucket)
+			assert.Equal(t, tc.next, tc.interval.Next(bucket))
+		})
+	}
+
+	sunday := time.Date(2024, 11, 10, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC), LinkStatsWeek.Truncate(sunday))
+}
+
+func TestNewLinkStats(t *testing.T) {
+	from := time.Date(2024, 11, 4, 10, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 11, 7, 0, 0, 0, 0, time.UTC)
+	stats, err := NewLinkStats(from, to, LinkStatsDay, []*LinkEventCount{
+		{Time: time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC), Type: LinkEventQRDisplayed, Count: 10},
+		{Time: time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC), Type: LinkEventCredentialIssued, Count: 4},
+		{Time: time.Date(2024, 11, 6, 0, 0, 0, 0, time.UTC), Type: LinkEventQRDisplayed, Count: 3},
+		{Time: time.Date(2024, 11, 6, 0, 0, 0, 0, time.UTC), Type: LinkEventCredentialFetched, Count: 2},
+		{Time: time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC), Type: LinkEventQRDisplayed, Count: 100},
+	})
+	require.NoError(t, err)
+	require.Len(t, stats.Buckets, 3)
+	assert.Equal(t, time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC), stats.Buckets[0].Time)
+	assert.Equal(t, LinkEventCounts{QRDisplayed: 10, CredentialIssued: 4}, stats.Buckets[0].Counts)
+	assert.Equal(t, LinkEventCounts{}, stats.Buckets[1].Counts)
+	assert.Equal(t, LinkEventCounts{QRDisplayed: 3, CredentialFetched: 2}, stats.Buckets[2].Counts)
+	assert.Equal(t, LinkEventCounts{QRDisplayed: 13, CredentialIssued: 4, CredentialFetched: 2}, stats.Totals)
+
+	_, err = NewLinkStats(to, from, LinkStatsDay, nil)
+	assert.ErrorIs(t, err, ErrInvalidLinkStats)
+	_, err = NewLinkStats(from, to, "minute", nil)
+	assert.ErrorIs(t, err, ErrInvalidLinkStats)
+	_, err = NewLinkStats(from, from.AddDate(1, 0, 0), LinkStatsHour, nil)
+	assert.ErrorIs(t, err, ErrInvalidLinkStats)
+	_, err = NewLinkStats(from, from.AddDate(1, 0, 0), LinkStatsWeek, nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// LinkInvitesMaxBatch is the maximum number of invites generated at once for a link
+	LinkInvitesMaxBatch = 1000
+	// LinkInviteReservation is how long a holder keeps a use of an invite without getting the credential of the link,
+	// e.g. while paying for it. After that, the use can be taken by another holder.
+	LinkInviteReservation = 24 * time.Hour
+
+	linkInviteCodeBytes = 10
+)
+
+// ErrInvalidLinkInvites means the number of invites or their uses are out of range
+var ErrInvalidLinkInvites = errors.New("invalid link invites")
+
+// LinkInvite is a code that allows MaxUses holders to get a credential from an invite-only link.
+// Every holder takes one use of the code, no matter how many times they scan it.
+type LinkInvite struct {
+	ID            uuid.UUID
+	LinkID        uuid.UUID
+	Code          string
+	MaxUses       int
+	Uses          int
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+	DeepLink      string
+	UniversalLink string
+}
+
+// NewLinkInvites generates count invites for the link that can be used maxUses times each
+func NewLinkInvites(linkID uuid.UUID, count int, maxUses int) ([]*LinkInvite, error) {
+	if count <= 0 || count > LinkInvitesMaxBatch {
+		return nil, fmt.Errorf("%w: count must be between 1 and %d", ErrInvalidLinkInvites, LinkInvitesMaxBatch)
+	}
+	if maxUses <= 0 {
+		return nil, fmt.Errorf("%w: maxUses must be higher than 0", ErrInvalidLinkInvites)
+	}
+
+	invites := make([]*LinkInvite, count)
+	for i := range invites {
+		code, err := newLinkInviteCode()
+		if err != nil {
+			return nil, err
+		}
+		invites[i] = &LinkInvite{
+			ID:        uuid.New(),
+			LinkID:    linkID,
+			Code:      code,
+			MaxUses:   maxUses,
+			CreatedAt: time.Now(),
+		}
+	}
+	return invites, nil
+}
+
+// IsRevoked returns true if the invite has been revoked
+func (i *LinkInvite) IsRevoked() bool {
+	return i.RevokedAt != nil
+}
+
+// IsUsable returns true if the invite is not revoked and has uses left
+func (i *LinkInvite) IsUsable() bool {
+	return !i.IsRevoked() && i.Uses < i.MaxUses
+}
+
+// newLinkInviteCode returns a random code that is safe to use in urls and to type
+func newLinkInviteCode() (string, error) {
+	b := make([]byte, linkInviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
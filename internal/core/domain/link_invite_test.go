@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLinkInvites(t *testing.T) {
+	linkID := uuid.New()
+	invites, err := NewLinkInvites(linkID, 50, 2)
+	require.NoError(t, err)
+	require.Len(t, invites, 50)
+
+	codes := make(map[string]bool, len(invites))
+	for _, invite := range invites {
+		assert.Equal(t, linkID, invite.LinkID)
+		assert.Equal(t, 2, invite.MaxUses)
+		assert.Len(t, invite.Code, 16)
+		assert.Regexp(t, "^[A-Z2-7]+$", invite.Code)
+		assert.True(t, invite.IsUsable())
+		codes[invite.Code] = true
+	}
+	assert.Len(t, codes, 50)
+
+	_, err = NewLinkInvites(linkID, 0, 1)
+	assert.ErrorIs(t, err, ErrInvalidLinkInvites)
+	_, err = NewLinkInvites(linkID, LinkInvitesMaxBatch+1, 1)
+	assert.ErrorIs(t, err, ErrInvalidLinkInvites)
+	_, err = NewLinkInvites(linkID, 1, 0)
+	assert.ErrorIs(t, err, ErrInvalidLinkInvites)
+}
+
+func TestLinkInvite_IsUsable(t *testing.T) {
+	invites, err := NewLinkInvites(uuid.New(), 1, 1)
+	require.NoError(t, err)
+	invite := invites[0]
+	invite.Uses = 1
+	assert.False(t, invite.IsUsable())
+
+	invite.Uses = 0
+	invite.RevokedAt = &invite.CreatedAt
+	assert.True(t, invite.IsRevoked())
+	assert.False(t, invite.IsUsable())
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidMediaTypePolicy means the media type policy file cannot be used by the agent
+var ErrInvalidMediaTypePolicy = errors.New("invalid media type policy")
+
+// AgentProtocols are the message types answered by the agent
+var AgentProtocols = []iden3comm.ProtocolMessage{
+	protocol.CredentialFetchRequestMessageType,
+	protocol.RevocationStatusRequestMessageType,
+	DiscoverFeatureQueriesMessageType,
+	protocol.CredentialProposalRequestMessageType,
+	protocol.CredentialIssuanceRequestMessageType,
+	protocol.CredentialPaymentMessageType,
+	protocol.ProofGenerationResponseMessageType,
+	AckMessageType,
+}
+
+// AgentMediaTypes are the media types the agent can unpack
+var AgentMediaTypes = []iden3comm.MediaType{
+	packers.MediaTypePlainMessage,
+	packers.MediaTypeSignedMessage,
+	packers.MediaTypeZKPMessage,
+}
+
+// MediaTypeAllowList are the media types accepted by the agent for each protocol message type. * accepts any media type.
+type MediaTypeAllowList map[iden3comm.ProtocolMessage][]string
+
+// MediaTypePolicy is the media type allow list of the agent. Identities override the Default allow list
+// of the message types they list, the other message types follow the Default allow list.
+type MediaTypePolicy struct {
+	Default    MediaTypeAllowList            `yaml:"default"`
+	Identities map[string]MediaTypeAllowList `yaml:"identities"`
+}
+
+// AllowList returns the allow list of the issuer
+func (p *MediaTypePolicy) AllowList(issuerDID *w3c.DID) MediaTypeAllowList {
+	allowList := make(MediaTypeAllowList, len(p.Default))
+	for message, mediaTypes := range p.Default {
+		allowList[message] = mediaTypes
+	}
+	if issuerDID == nil {
+		return allowList
+	}
+	for message, mediaTypes := range p.Identities[issuerDID.String()] {
+		allowList[message] = mediaTypes
+	}
+	return allowList
+}
+
+// IsOverridden returns true if the issuer has its own allow list for the message type
+func (p *MediaTypePolicy) IsOverridden(issuerDID *w3c.DID, message iden3comm.ProtocolMessage) bool {
+	if issuerDID == nil {
+		return false
+	}
+	_, ok := p.Identities[issuerDID.String()][message]
+	return ok
+}
+
+// DefaultMediaTypePolicy returns the media type policy of the agent when there is no policy file.
+// Credentials, payments and the answers to the proof requests must be sent with ZKP packed messages.
+func DefaultMediaTypePolicy() *MediaTypePolicy {
+	return &MediaTypePolicy{
+		Default: MediaTypeAllowList{
+			protocol.CredentialFetchRequestMessageType:    {string(packers.MediaTypeZKPMessage)},
+			protocol.RevocationStatusRequestMessageType:   {"*"},
+			DiscoverFeatureQueriesMessageType:             {"*"},
+			AckMessageType:                                {"*"},
+			protocol.CredentialProposalRequestMessageType: {"*"},
+			protocol.CredentialIssuanceRequestMessageType: {string(packers.MediaTypeZKPMessage)},
+			protocol.CredentialPaymentMessageType:         {string(packers.MediaTypeZKPMessage)},
+			protocol.ProofGenerationResponseMessageType:   {string(packers.MediaTypeZKPMessage)},
+		},
+		Identities: map[string]MediaTypeAllowList{},
+	}
+}
+
+// ParseMediaTypePolicy parses and validates a yaml media type policy.
+// The message types missing in the default allow list of the file keep the allow list of the default policy.
+func ParseMediaTypePolicy(reader io.Reader) (*MediaTypePolicy, error) {
+	file := &MediaTypePolicy{}
+	if err := yaml.NewDecoder(reader).Decode(file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMediaTypePolicy, err)
+	}
+
+	policy := DefaultMediaTypePolicy()
+	for message, mediaTypes := range file.Default {
+		policy.Default[message] = mediaTypes
+	}
+	for identity, allowList := range file.Identities {
+		policy.Identities[identity] = allowList
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (p *MediaTypePolicy) validate() error {
+	if err := p.Default.validate(); err != nil {
+		return fmt.Errorf("%w: default: %s", ErrInvalidMediaTypePolicy, err)
+	}
+	for identity, allowList := range p.Identities {
+		if _, err := w3c.ParseDID(identity); err != nil {
+			return fmt.Errorf("%w: invalid identity %s: %s", ErrInvalidMediaTypePolicy, identity, err)
+		}
+		if err := allowList.validate(); err != nil {
+			return fmt.Errorf("%w: identity %s: %s", ErrInvalidMediaTypePolicy, identity, err)
+		}
+	}
+	return nil
+}
+
+func (l MediaTypeAllowList) validate() error {
+	for message, mediaTypes := range l {
+		if !isAgentProtocol(message) {
+			return fmt.Errorf("message type %s is not answered by the agent", message)
+		}
+		if len(mediaTypes) == 0 {
+			return fmt.Errorf("message type %s has no media types", message)
+		}
+		for _, mediaType := range mediaTypes {
+			if mediaType != "*" && !isAgentMediaType(iden3comm.MediaType(mediaType)) {
+				return fmt.Errorf("unsupported media type %s for message type %s", mediaType, message)
+			}
+		}
+	}
+	return nil
+}
+
+func isAgentProtocol(message iden3comm.ProtocolMessage) bool {
+	for _, m := range AgentProtocols {
+		if m == message {
+			return true
+		}
+	}
+	return false
+}
+
+func isAgentMediaType(mediaType iden3comm.MediaType) bool {
+	for _, m := range AgentMediaTypes {
+		if m == mediaType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMediaTypePolicy(t *testing.T) {
+	const (
+		jwsIssuer = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+		zkpIssuer = "did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq"
+	)
+	type testConfig struct {
+		name  string
+		yaml  string
+		error string
+	}
+	for _, tc := range []testConfig{
+		{name: "empty file"},
+		{
+			name: "identity allow list",
+			yaml: `
+identities:
+  ` + jwsIssuer + `:
+    https://iden3-communication.io/credentials/1.0/fetch-request: [application/iden3comm-signed-json, application/iden3-zkp-json]
+`,
+		},
+		{
+			name: "proof response allow list",
+			yaml: `
+identities:
+  ` + zkpIssuer + `:
+    https://iden3-communication.io/proofs/1.0/response: [application/iden3-zkp-json]
+`,
+		},
+		{
+			name: "unknown message type",
+			yaml: `
+default:
+  https://iden3-communication.io/credentials/1.0/unknown: ["*"]
+`,
+			error: "message type https://iden3-communication.io/credentials/1.0/unknown is not answered by the agent",
+		},
+		{
+			name: "unknown media type",
+			yaml: `
+default:
+  https://iden3-communication.io/credentials/1.0/fetch-request: [application/json]
+`,
+			error: "unsupported media type application/json",
+		},
+		{
+			name: "no media types",
+			yaml: `
+identities:
+  ` + zkpIssuer + `:
+    https://iden3-communication.io/credentials/1.0/fetch-request: []
+`,
+			error: "has no media types",
+		},
+		{
+			name: "invalid identity",
+			yaml: `
+identities:
+  issuer:
+    https://iden3-communication.io/credentials/1.0/fetch-request: ["*"]
+`,
+			error: "invalid identity issuer",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := ParseMediaTypePolicy(strings.NewReader(tc.yaml))
+			if tc.error != "" {
+				require.ErrorIs(t, err, ErrInvalidMediaTypePolicy)
+				assert.Contains(t, err.Error(), tc.error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, DefaultMediaTypePolicy().Default, policy.Default)
+		})
+	}
+
+	t.Run("sample policy file", func(t *testing.T) {
+		f, err := os.Open("../../../mediatype_policy_sample.yaml")
+		require.NoError(t, err)
+		defer func() { _ = f.Close() }()
+		_, err = ParseMediaTypePolicy(f)
+		require.NoError(t, err)
+	})
+
+	t.Run("identity overrides the default allow list", func(t *testing.T) {
+		policy, err := ParseMediaTypePolicy(strings.NewReader(`
+identities:
+  ` + jwsIssuer + `:
+    https://iden3-communication.io/credentials/1.0/fetch-request: [application/iden3comm-signed-json]
+`))
+		require.NoError(t, err)
+		jwsDID, err := w3c.ParseDID(jwsIssuer)
+		require.NoError(t, err)
+		zkpDID, err := w3c.ParseDID(zkpIssuer)
+		require.NoError(t, err)
+
+		jwsAllowList := policy.AllowList(jwsDID)
+		zkpAllowList := policy.AllowList(zkpDID)
+		assert.Equal(t, []string{string(packers.MediaTypeSignedMessage)}, jwsAllowList[protocol.CredentialFetchRequestMessageType])
+		assert.Equal(t, []string{string(packers.MediaTypeZKPMessage)}, zkpAllowList[protocol.CredentialFetchRequestMessageType])
+		assert.Equal(t, []string{"*"}, jwsAllowList[protocol.RevocationStatusRequestMessageType])
+		assert.True(t, policy.IsOverridden(jwsDID, protocol.CredentialFetchRequestMessageType))
+		assert.False(t, policy.IsOverridden(zkpDID, protocol.CredentialFetchRequestMessageType))
+	})
+}
+
+func TestDefaultMediaTypePolicy(t *testing.T) {
+	// every message type of the default policy is answered by the agent, so the policy files can be parsed
+	policy := DefaultMediaTypePolicy()
+	require.NoError(t, policy.validate())
+	for message := range policy.Default {
+		assert.Contains(t, AgentProtocols, message)
+	}
+}
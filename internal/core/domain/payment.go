@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const (
+	// PaymentRequestStatusPending is a payment request that has not been paid yet
+	PaymentRequestStatusPending PaymentRequestStatus = "pending"
+	// PaymentRequestStatusPaid is a payment request whose transaction has been verified on chain
+	PaymentRequestStatusPaid PaymentRequestStatus = "paid"
+
+	// PaymentTypeCrypto is the type of the payments made with a native coin transfer
+	PaymentTypeCrypto = "Iden3PaymentRequestCryptoV1"
+	// PaymentCurrencyETH is the currency of the native coin transfers
+	PaymentCurrencyETH = "ETH"
+)
+
+// PaymentRequestStatus is the status of a payment request
+type PaymentRequestStatus string
+
+// CredentialPrice is the amount, in wei, that holders pay for the credentials of a schema.
+// Prices with LinkID only apply to that link and take precedence over the price of its schema.
+type CredentialPrice struct {
+	ID          uuid.UUID
+	IssuerDID   w3c.DID
+	SchemaID    uuid.UUID
+	SchemaType  string
+	LinkID      *uuid.UUID
+	Amount      *big.Int
+	Blockchain  string
+	Network     string
+	Recipient   string
+	Description *string
+	CreatedAt   time.Time
+}
+
+// NewCredentialPrice creates a credential price
+func NewCredentialPrice(issuerDID w3c.DID, schemaID uuid.UUID, linkID *uuid.UUID, amount *big.Int, blockchain, network, recipient string, description *string) *CredentialPrice {
+	return &CredentialPrice{
+		ID:          uuid.New(),
+		IssuerDID:   issuerDID,
+		SchemaID:    schemaID,
+		LinkID:      linkID,
+		Amount:      amount,
+		Blockchain:  blockchain,
+		Network:     network,
+		Recipient:   recipient,
+		Description: description,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// PaymentRequest is the payment asked to a holder before issuing the credential of a link.
+// The transaction that pays it must transfer Amount to Recipient after the request is created and cannot pay other requests.
+// CredentialSubject are the attributes derived from the proof of the holder, issued once the request is paid.
+type PaymentRequest struct {
+	ID                uuid.UUID
+	IssuerDID         w3c.DID
+	UserDID           w3c.DID
+	LinkID            uuid.UUID
+	Amount            *big.Int
+	Blockchain        string
+	Network           string
+	ChainID           string
+	Recipient         string
+	Description       *string
+	Status            PaymentRequestStatus
+	TxID              *string
+	CredentialSubject CredentialSubject
+	CreatedAt         time.Time
+	PaidAt            *time.Time
+}
+
+// NewPaymentRequest creates a pending payment request with the price of the credential
+func NewPaymentRequest(price *CredentialPrice, userDID w3c.DID, linkID uuid.UUID, chainID string, credentialSubject CredentialSubject) *PaymentRequest {
+	return &PaymentRequest{
+		ID:                uuid.New(),
+		IssuerDID:         price.IssuerDID,
+		UserDID:           userDID,
+		LinkID:            linkID,
+		Amount:            price.Amount,
+		Blockchain:        price.Blockchain,
+		Network:           price.Network,
+		ChainID:           chainID,
+		Recipient:         price.Recipient,
+		Description:       price.Description,
+		Status:            PaymentRequestStatusPending,
+		CredentialSubject: credentialSubject,
+		CreatedAt:         time.Now(),
+	}
+}
+
+// HasPrice returns true if the request asks for the price
+func (p *PaymentRequest) HasPrice(price *CredentialPrice) bool {
+	return p.Amount.Cmp(price.Amount) == 0 && p.Recipient == price.Recipient &&
+		p.Blockchain == price.Blockchain && p.Network == price.Network
+}
+
+// ResolverPrefix returns the network resolver key of the network of the payment
+func (p *PaymentRequest) ResolverPrefix() string {
+	return p.Blockchain + ":" + p.Network
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const (
+	// ProposalRuleTypeLink proposes the universal link of an issuer link
+	ProposalRuleTypeLink ProposalRuleType = "link"
+	// ProposalRuleTypeWeb proposes a web onboarding url
+	ProposalRuleTypeWeb ProposalRuleType = "web"
+	// ProposalRuleTypeVerification proposes a url with a verification request
+	ProposalRuleTypeVerification ProposalRuleType = "verification"
+
+	// CredentialProposalTypeLinkOffer is the type of the proposals of link rules
+	CredentialProposalTypeLinkOffer = "LinkOfferV1.0"
+	// CredentialProposalTypeVerification is the type of the proposals of verification rules
+	CredentialProposalTypeVerification = "VerificationRequestV1.0"
+)
+
+// ProposalRuleType is the way a holder is told to obtain a credential
+type ProposalRuleType string
+
+// ProposalRule tells the holders that ask for a credential of the schema how to obtain it.
+// LinkID is set for link rules and URL for web and verification rules.
+type ProposalRule struct {
+	ID          uuid.UUID
+	IssuerDID   w3c.DID
+	SchemaID    uuid.UUID
+	SchemaType  string
+	Type        ProposalRuleType
+	LinkID      *uuid.UUID
+	URL         *string
+	Description *string
+	CreatedAt   time.Time
+}
+
+// NewProposalRule creates a proposal rule
+func NewProposalRule(issuerDID w3c.DID, schemaID uuid.UUID, ruleType ProposalRuleType, linkID *uuid.UUID, url *string, description *string) *ProposalRule {
+	return &ProposalRule{
+		ID:          uuid.New(),
+		IssuerDID:   issuerDID,
+		SchemaID:    schemaID,
+		Type:        ruleType,
+		LinkID:      linkID,
+		URL:         url,
+		Description: description,
+		CreatedAt:   time.Now(),
+	}
+}
@@ -10,6 +10,7 @@ const (
 	CreateCredentialEvent = "createCredentialEvent" // CreateCredentialEvent create credential event
 	CreateConnectionEvent = "createConnectionEvent" // CreateConnectionEvent create connection MyEvent
 	CreateStateEvent      = "createStateEvent"      // CreateStateEvent create state event
+	LowBalanceEvent       = "lowBalanceEvent"       // LowBalanceEvent publishing account balance under the alert threshold
 )
 
 // CreateState defines the createState data
@@ -58,3 +59,22 @@ func (ev *CreateConnection) Marshal() (msg pubsub.Message, err error) {
 func (ev *CreateConnection) Unmarshal(msg pubsub.Message) error {
 	return json.Unmarshal(msg, &ev)
 }
+
+// LowBalance defines the lowBalance data
+type LowBalance struct {
+	Network   string `json:"network"`
+	KeyID     string `json:"keyID"`
+	Address   string `json:"address"`
+	Balance   string `json:"balance"`
+	Threshold string `json:"threshold"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *LowBalance) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *LowBalance) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
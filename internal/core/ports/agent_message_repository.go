@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// AgentMessageRepository is the interface that defines the available methods for the messages of the agent threads
+type AgentMessageRepository interface {
+	Save(ctx context.Context, conn db.Querier, message *domain.AgentMessage) error
+	GetThread(ctx context.Context, conn db.Querier, issuerDID w3c.DID, threadID string) ([]*domain.AgentMessage, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, direction domain.AgentMessageDirection, messageID string) error
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+// AgentMessageService persists the messages received and answered by the agent per thread
+type AgentMessageService interface {
+	Receive(ctx context.Context, envelope []byte, message *iden3comm.BasicMessage, mediatype iden3comm.MediaType) error
+	Discard(ctx context.Context, message *iden3comm.BasicMessage) error
+	Send(ctx context.Context, message *domain.AgentMessage) error
+	GetThread(ctx context.Context, issuerDID w3c.DID, threadID string) ([]*domain.AgentMessage, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+// AgentPacker packs the agent responses with the media type negotiated with the holder
+type AgentPacker interface {
+	Pack(ctx context.Context, response *domain.Agent, requestMediaType iden3comm.MediaType, accept []iden3comm.MediaType) ([]byte, iden3comm.MediaType, error)
+}
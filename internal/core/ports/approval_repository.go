@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// ApprovalRepository is the interface that defines the available methods for the approval requests
+type ApprovalRepository interface {
+	Save(ctx context.Context, conn db.Querier, request *domain.ApprovalRequest) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ApprovalRequest, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, status *domain.ApprovalStatus) ([]*domain.ApprovalRequest, error)
+	Review(ctx context.Context, conn db.Querier, request *domain.ApprovalRequest) error
+	UpdateResult(ctx context.Context, conn db.Querier, request *domain.ApprovalRequest) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+// ApprovalService manages the queue of operations waiting for an approver
+type ApprovalService interface {
+	RequestCredentialIssuance(ctx context.Context, req *CreateClaimRequest) (*domain.ApprovalRequest, error)
+	RequestStatePublish(ctx context.Context, issuerDID w3c.DID) (*domain.ApprovalRequest, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, status *domain.ApprovalStatus) ([]*domain.ApprovalRequest, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.ApprovalRequest, error)
+	Approve(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.ApprovalRequest, error)
+	Reject(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, reason *string) (*domain.ApprovalRequest, error)
+}
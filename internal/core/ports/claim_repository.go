@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -31,4 +32,8 @@ type ClaimRepository interface {
 	GetClaimsIssuedForUser(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID, linkID uuid.UUID) ([]*domain.Claim, error)
 	GetClaimsOfAConnection(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID) ([]*domain.Claim, error)
 	GetByStateIDWithMTPProof(ctx context.Context, conn db.Querier, did *w3c.DID, state string) (claims []*domain.Claim, err error)
+	CountIssuedSince(ctx context.Context, conn db.Querier, identifier w3c.DID, since time.Time) (int, error)
+	UpdateDeliveryStatus(ctx context.Context, conn db.Querier, identifier w3c.DID, claimID uuid.UUID, status domain.CredentialDeliveryStatus, threadID *string) (int64, error)
+	UpdateOnchainTxID(ctx context.Context, conn db.Querier, identifier w3c.DID, claimID uuid.UUID, txID string) (int64, error)
+	UpdateDeliveryStatusByThread(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID, threadID string, status domain.CredentialDeliveryStatus) (int64, error)
 }
@@ -43,6 +43,8 @@ type CreateClaimRequest struct {
 	RefreshService        *verifiable.RefreshService
 	RevNonce              *uint64
 	DisplayMethod         *verifiable.DisplayMethod
+	ApprovalID            *uuid.UUID
+	IssuanceMode          domain.CredentialIssuanceMode
 }
 
 // AgentRequest struct
@@ -67,12 +69,20 @@ const (
 	CredentialRevoked           sqltools.SQLFieldName = "claims.revoked"
 	StateTransitionsPublishDate sqltools.SQLFieldName = "created_at"
 	StateTransitionsStatus      sqltools.SQLFieldName = "status"
+	LinksCreatedAt              sqltools.SQLFieldName = "links.created_at"
+	LinksValidUntil             sqltools.SQLFieldName = "links.valid_until"
+	LinksIssuedClaims           sqltools.SQLFieldName = "links.issued_claims"
+	LinksSchemaType             sqltools.SQLFieldName = "schemas.type"
+	SchemasCreatedAt            sqltools.SQLFieldName = "schemas.created_at"
+	SchemasType                 sqltools.SQLFieldName = "schemas.type"
+	SchemasTitle                sqltools.SQLFieldName = "schemas.title"
 )
 
 // ClaimsFilter struct
 type ClaimsFilter struct {
 	Self            *bool
 	Revoked         *bool
+	DeliveryStatus  *domain.CredentialDeliveryStatus
 	ExpiredOn       *time.Time
 	SchemaHash      string
 	SchemaType      string
@@ -182,7 +192,11 @@ func NewAgentRequest(basicMessage *comm.BasicMessage) (*AgentRequest, error) {
 		return nil, err
 	}
 
-	if basicMessage.Type != protocol.CredentialFetchRequestMessageType && basicMessage.Type != protocol.RevocationStatusRequestMessageType {
+	switch basicMessage.Type {
+	case protocol.CredentialFetchRequestMessageType, protocol.RevocationStatusRequestMessageType, domain.DiscoverFeatureQueriesMessageType, domain.AckMessageType,
+		protocol.CredentialProposalRequestMessageType, protocol.CredentialIssuanceRequestMessageType, protocol.CredentialPaymentMessageType,
+		protocol.ProofGenerationResponseMessageType:
+	default:
 		return nil, fmt.Errorf("invalid type")
 	}
 
@@ -221,6 +235,7 @@ type ClaimService interface {
 	GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error)
 	GetByID(ctx context.Context, issID *w3c.DID, id uuid.UUID) (*domain.Claim, error)
 	GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid.UUID, hostURL string) (*GetCredentialQrCodeResponse, error)
+	MarkOffered(ctx context.Context, issuerDID w3c.DID, offer *protocol.CredentialsOfferMessage) error
 	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error)
 	GetAuthClaim(ctx context.Context, did *w3c.DID) (*domain.Claim, error)
 	GetAuthClaimForPublishing(ctx context.Context, did *w3c.DID, state string) (*domain.Claim, error)
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// ConnectionMessageRepository is the interface that defines the available methods for the messages sent to the connections
+type ConnectionMessageRepository interface {
+	Save(ctx context.Context, conn db.Querier, message *domain.ConnectionMessage) error
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connID uuid.UUID) ([]*domain.ConnectionMessage, error)
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ConnectionMessage, error)
+}
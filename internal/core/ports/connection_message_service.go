@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+// ConnectionMessageType is the kind of message the issuer can send to a connection
+type ConnectionMessageType string
+
+const (
+	ConnectionMessageBasic           ConnectionMessageType = "basicMessage"    // ConnectionMessageBasic is a free text message
+	ConnectionMessageCredentialOffer ConnectionMessageType = "credentialOffer" // ConnectionMessageCredentialOffer is the offer of an existing credential of the holder
+	ConnectionMessageProofRequest    ConnectionMessageType = "proofRequest"    // ConnectionMessageProofRequest asks the holder for zero knowledge proofs
+)
+
+// SendConnectionMessageRequest is the message to send to a connection. Only the fields of the type are used.
+// Messages with ThreadID continue that thread, the others start a new one.
+type SendConnectionMessageRequest struct {
+	Type          ConnectionMessageType
+	ThreadID      *string
+	Content       string
+	CredentialID  uuid.UUID
+	ProofRequests []protocol.ZeroKnowledgeProofRequest
+}
+
+// ProofVerifier verifies the zero knowledge proofs of a holder against the proof requests of the issuer
+type ProofVerifier interface {
+	VerifyAuthResponse(ctx context.Context, response protocol.AuthorizationResponseMessage, request protocol.AuthorizationRequestMessage, opts ...pubsignals.VerifyOpt) error
+}
+
+// ConnectionMessageService sends iden3comm messages to the connections of an issuer through their push service
+// and verifies the answers of the holders to the proof requests
+type ConnectionMessageService interface {
+	Send(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID, req *SendConnectionMessageRequest) (*domain.ConnectionMessage, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]*domain.ConnectionMessage, error)
+	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error)
+}
@@ -20,5 +20,8 @@ type ConnectionRepository interface {
 	GetByUserID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID) (*domain.Connection, error)
 	GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
 	GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error)
+	UpdateMetadata(ctx context.Context, conn db.Querier, connection *domain.Connection) error
 	SaveUserAuthentication(ctx context.Context, conn db.Querier, connID uuid.UUID, sessID uuid.UUID, mTime time.Time) error
+	SaveEvents(ctx context.Context, conn db.Querier, events []*domain.ConnectionEvent) error
+	GetEvents(ctx context.Context, conn db.Querier, connID uuid.UUID, request *GetConnectionHistoryRequest) ([]*domain.ConnectionEvent, uint, error)
 }
@@ -15,12 +15,15 @@ import (
 type NewGetAllConnectionsRequest struct {
 	WithCredentials bool
 	Query           string
+	Tags            []string
+	Metadata        map[string]string
 	Pagination      pagination.Filter
 	OrderBy         sqltools.OrderByFilters
 }
 
-// NewGetAllRequest returns the request object for obtaining all connections
-func NewGetAllRequest(withCredentials *bool, query *string, page *uint, maxResults *uint, orderBy sqltools.OrderByFilters) *NewGetAllConnectionsRequest {
+// NewGetAllRequest returns the request object for obtaining all connections.
+// Only the connections with all the given tags and metadata entries are returned.
+func NewGetAllRequest(withCredentials *bool, query *string, tags []string, metadata map[string]string, page *uint, maxResults *uint, orderBy sqltools.OrderByFilters) *NewGetAllConnectionsRequest {
 	var connQuery string
 
 	if query != nil {
@@ -32,6 +35,8 @@ func NewGetAllRequest(withCredentials *bool, query *string, page *uint, maxResul
 	return &NewGetAllConnectionsRequest{
 		WithCredentials: withCredentials != nil && *withCredentials,
 		Query:           connQuery,
+		Tags:            tags,
+		Metadata:        metadata,
 		Pagination:      *pagFilter,
 		OrderBy:         orderBy,
 	}
@@ -70,6 +75,26 @@ func NewDeleteRequest(connID uuid.UUID, deleteCredentials *bool, revokeCredentia
 	}
 }
 
+// UpdateConnectionRequest are the fields of a connection that can be changed by the issuer. Nil fields are not changed.
+type UpdateConnectionRequest struct {
+	Metadata *map[string]string
+	Tags     *[]string
+}
+
+// GetConnectionHistoryRequest filters the history of a connection. All the events are returned when Types is empty.
+type GetConnectionHistoryRequest struct {
+	Types      []domain.ConnectionEventType
+	Pagination pagination.Filter
+}
+
+// NewGetConnectionHistoryRequest returns the request object for obtaining the history of a connection
+func NewGetConnectionHistoryRequest(types []domain.ConnectionEventType, page *uint, maxResults *uint) *GetConnectionHistoryRequest {
+	return &GetConnectionHistoryRequest{
+		Types:      types,
+		Pagination: *pagination.NewFilter(maxResults, page),
+	}
+}
+
 // ConnectionService  is the interface implemented by the Connections service
 type ConnectionService interface {
 	Create(ctx context.Context, conn *domain.Connection) error
@@ -79,4 +104,6 @@ type ConnectionService interface {
 	GetByUserID(ctx context.Context, issuerDID w3c.DID, userID w3c.DID) (*domain.Connection, error)
 	GetAllByIssuerID(ctx context.Context, issuerDID w3c.DID, request *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
 	GetByUserSessionID(ctx context.Context, sessionID uuid.UUID) (*domain.Connection, error)
+	Update(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *UpdateConnectionRequest) (*domain.Connection, error)
+	GetHistory(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *GetConnectionHistoryRequest) ([]*domain.ConnectionEvent, uint, error)
 }
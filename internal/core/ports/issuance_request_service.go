@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+// IssuanceApprover decides if the credential requested by a holder is issued
+type IssuanceApprover interface {
+	Decide(ctx context.Context, req *domain.IssuanceRequest) (*domain.IssuanceDecision, error)
+}
+
+// IssuanceRequestService handles the credential issuance requests sent by the holders to the agent
+type IssuanceRequestService interface {
+	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error)
+}
@@ -15,7 +15,24 @@ import (
 type LinkRepository interface {
 	Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uuid.UUID, error)
 	GetByID(ctx context.Context, issuerID w3c.DID, id uuid.UUID) (*domain.Link, error)
-	GetAll(ctx context.Context, issuerDID w3c.DID, status LinkStatus, query *string) ([]*domain.Link, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter *LinksFilter) ([]*domain.Link, uint, error)
 	Delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) error
 	AddAuthorizationRequest(ctx context.Context, linkID uuid.UUID, issuerDID w3c.DID, authorizationRequest *protocol.AuthorizationRequestMessage) error
+	ReserveIssuance(ctx context.Context, conn db.Querier, issuerDID w3c.DID, linkID uuid.UUID) error
+	SaveUsage(ctx context.Context, conn db.Querier, usage *domain.LinkUsage) error
+	GetUsage(ctx context.Context, linkID uuid.UUID) ([]*domain.LinkUsage, error)
+	SaveEvent(ctx context.Context, event *domain.LinkEvent) error
+	CountEvents(ctx context.Context, issuerDID w3c.DID, req LinkStatsRequest) ([]*domain.LinkEventCount, error)
+	SaveInvites(ctx context.Context, conn db.Querier, invites []*domain.LinkInvite) error
+	GetInvites(ctx context.Context, linkID uuid.UUID) ([]*domain.LinkInvite, error)
+	GetInviteByCode(ctx context.Context, linkID uuid.UUID, code string) (*domain.LinkInvite, error)
+	UseInvite(ctx context.Context, conn db.Querier, linkID uuid.UUID, code string, holderDID w3c.DID) (bool, error)
+	ReleaseInvite(ctx context.Context, conn db.Querier, linkID uuid.UUID, code string, holderDID w3c.DID) error
+	RevokeInvite(ctx context.Context, linkID uuid.UUID, id uuid.UUID) error
+	SaveAllowlistEntries(ctx context.Context, conn db.Querier, entries []*domain.LinkAllowlistEntry) error
+	GetAllowlist(ctx context.Context, conn db.Querier, linkID uuid.UUID) ([]*domain.LinkAllowlistEntry, error)
+	GetAllowlistEntryByKey(ctx context.Context, conn db.Querier, linkID uuid.UUID, key string) (*domain.LinkAllowlistEntry, error)
+	GetAllowlistEntryByHolder(ctx context.Context, conn db.Querier, linkID uuid.UUID, holderDID w3c.DID) (*domain.LinkAllowlistEntry, error)
+	BindAllowlistEntry(ctx context.Context, conn db.Querier, linkID uuid.UUID, key string, holderDID w3c.DID) error
+	ClaimAllowlistEntry(ctx context.Context, conn db.Querier, id uuid.UUID, claimID uuid.UUID) error
 }
@@ -13,6 +13,8 @@ import (
 	"github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/pagination"
+	"github.com/wakeup-labs/issuer-node/internal/sqltools"
 )
 
 // CreateQRCodeResponse - is the result of creating a link QRcode.
@@ -45,6 +47,37 @@ func LinkTypeReqFromString(s string) (LinkStatus, error) {
 	return LinkStatus(s), nil
 }
 
+// LinksFilter - the filters, the page and the order of the links returned by GetAll.
+// All the links are returned when the page is not set.
+type LinksFilter struct {
+	Status      LinkStatus
+	Query       string
+	SchemaID    *uuid.UUID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Pagination  pagination.Filter
+	OrderBy     sqltools.OrderByFilters
+}
+
+// NewLinksFilter returns the filter of the links of the given status, all of them by default
+func NewLinksFilter(status *LinkStatus, query *string, schemaID *uuid.UUID, createdFrom *time.Time, createdTo *time.Time, page *uint, maxResults *uint, orderBy sqltools.OrderByFilters) *LinksFilter {
+	filter := &LinksFilter{
+		Status:      LinkAll,
+		SchemaID:    schemaID,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		Pagination:  *pagination.NewFilter(maxResults, page),
+		OrderBy:     orderBy,
+	}
+	if status != nil {
+		filter.Status = *status
+	}
+	if query != nil {
+		filter.Query = *query
+	}
+	return filter
+}
+
 // State - Link state.
 type State struct {
 	Status  string  `json:"status,omitempty"`
@@ -58,15 +91,74 @@ type GetQRCodeResponse struct {
 	State *State
 }
 
+// LinkField is a field of a link that can be unset by a LinkUpdate
+type LinkField string
+
+const (
+	LinkFieldValidUntil           LinkField = "expiration"           // LinkFieldValidUntil : the expiration of the link
+	LinkFieldMaxIssuance          LinkField = "limitedClaims"        // LinkFieldMaxIssuance : the maximum number of credentials issued by the link
+	LinkFieldCredentialExpiration LinkField = "credentialExpiration" // LinkFieldCredentialExpiration : the expiration of the credentials
+	LinkFieldRefreshService       LinkField = "refreshService"       // LinkFieldRefreshService : the refresh service of the credentials
+	LinkFieldDisplayMethod        LinkField = "displayMethod"        // LinkFieldDisplayMethod : the display method of the credentials
+)
+
+// LinkAccess - the holders that can get the credentials of a link. A link without restrictions issues credentials
+// to every holder that scans it.
+type LinkAccess struct {
+	Allowlist  bool // only the holders of the allowlist of the link
+	InviteOnly bool // only the holders with one of the invite codes of the link
+}
+
+// LinkUpdate - the mutable fields of a link. Nil fields are not changed and the fields in Unset are removed.
+type LinkUpdate struct {
+	Active               *bool
+	ValidUntil           *time.Time
+	MaxIssuance          *int
+	CredentialExpiration *time.Time
+	CredentialSubject    domain.CredentialSubject
+	RefreshService       *verifiable.RefreshService
+	DisplayMethod        *verifiable.DisplayMethod
+	Allowlist            *bool
+	InviteOnly           *bool
+	Unset                []LinkField
+}
+
+// IsActivation returns true if the update only activates or deactivates the link
+func (u *LinkUpdate) IsActivation() bool {
+	return u.Active != nil && u.ValidUntil == nil && u.MaxIssuance == nil && u.CredentialExpiration == nil &&
+		u.CredentialSubject == nil && u.RefreshService == nil && u.DisplayMethod == nil && u.Allowlist == nil && u.InviteOnly == nil && len(u.Unset) == 0
+}
+
+// LinkStatsRequest - the range and the interval of the link stats, filtered by a link or by a schema of the issuer.
+// The stats of all the links of the issuer are returned when no filter is set.
+type LinkStatsRequest struct {
+	LinkID   *uuid.UUID
+	SchemaID *uuid.UUID
+	From     time.Time
+	To       time.Time
+	Interval domain.LinkStatsInterval
+}
+
 // LinkService - the interface that defines the available methods
 type LinkService interface {
-	Save(ctx context.Context, did w3c.DID, maxIssuance *int, validUntil *time.Time, schemaID uuid.UUID, credentialExpiration *time.Time, credentialSignatureProof bool, credentialMTPProof bool, credentialAttributes domain.CredentialSubject, refreshService *verifiable.RefreshService, displayMethod *verifiable.DisplayMethod) (*domain.Link, error)
+	Save(ctx context.Context, did w3c.DID, maxIssuance *int, validUntil *time.Time, schemaID uuid.UUID, credentialExpiration *time.Time, credentialSignatureProof bool, credentialMTPProof bool, credentialAttributes domain.CredentialSubject, refreshService *verifiable.RefreshService, displayMethod *verifiable.DisplayMethod, derivation *domain.LinkDerivation, access LinkAccess) (*domain.Link, error)
 	Activate(ctx context.Context, issuerID w3c.DID, linkID uuid.UUID, active bool) error
+	Update(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, update LinkUpdate) (*domain.Link, error)
+	Clone(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) (*domain.Link, error)
 	Delete(ctx context.Context, id uuid.UUID, did w3c.DID) error
 	GetByID(ctx context.Context, issuerID w3c.DID, id uuid.UUID, serverURL string) (*domain.Link, error)
-	GetAll(ctx context.Context, issuerDID w3c.DID, status LinkStatus, query *string, serverURL string) ([]*domain.Link, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter *LinksFilter, serverURL string) ([]*domain.Link, uint, error)
 	CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, serverURL string) (*CreateQRCodeResponse, error)
-	IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, hostURL string) (*protocol.CredentialsOfferMessage, error)
-	ProcessCallBack(ctx context.Context, issuerDID w3c.DID, message string, linkID uuid.UUID, hostURL string) (*protocol.CredentialsOfferMessage, error)
+	IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, proofSubject domain.CredentialSubject, hostURL string) (*protocol.CredentialsOfferMessage, error)
+	ProcessCallBack(ctx context.Context, issuerDID w3c.DID, message string, linkID uuid.UUID, allowlistKey *string, inviteCode *string, hostURL string) (*protocol.CredentialsOfferMessage, error)
 	Validate(ctx context.Context, link *domain.Link) error
+	UploadAllowlist(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, entries []*domain.LinkAllowlistEntry) ([]*domain.LinkAllowlistEntry, error)
+	GetAllowlist(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]*domain.LinkAllowlistEntry, error)
+	GetUsage(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]*domain.LinkUsage, error)
+	GetStats(ctx context.Context, issuerDID w3c.DID, req LinkStatsRequest) (*domain.LinkStats, error)
+	CreateAllowlistQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, allowlistKey string, serverURL string) (*CreateQRCodeResponse, error)
+	CreateInvites(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, count int, maxUses int, serverURL string) ([]*domain.LinkInvite, error)
+	GetInvites(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, serverURL string) ([]*domain.LinkInvite, error)
+	RevokeInvite(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, inviteID uuid.UUID) error
+	GetInviteQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, inviteCode string, serverURL string) ([]byte, error)
 }
@@ -1,8 +1,15 @@
 package ports
 
-import "github.com/iden3/iden3comm/v2"
+import (
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
 
 // MediatypeManager - Define the interface for media type manager
 type MediatypeManager interface {
-	AllowMediaType(protoclMessage iden3comm.ProtocolMessage, mediaType iden3comm.MediaType) bool
+	AllowMediaType(issuerDID *w3c.DID, protoclMessage iden3comm.ProtocolMessage, mediaType iden3comm.MediaType) bool
+	Enabled() bool
+	Policy() *domain.MediaTypePolicy
 }
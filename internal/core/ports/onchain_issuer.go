@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+// OnchainIssuer - Define the interface to publish the claims of the credentials issued on chain
+type OnchainIssuer interface {
+	IsEnabled(issuerDID w3c.DID) bool
+	CredentialStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.CredentialStatus, error)
+	Publish(ctx context.Context, issuerDID w3c.DID, claim *core.Claim) (string, error)
+	Proof(ctx context.Context, issuerDID w3c.DID, claim *core.Claim) (*verifiable.Iden3SparseMerkleTreeProof, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// PaymentRepository is the interface that defines the available methods for the credential prices and payment requests
+type PaymentRepository interface {
+	SavePrice(ctx context.Context, conn db.Querier, price *domain.CredentialPrice) error
+	GetPrices(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]*domain.CredentialPrice, error)
+	GetLinkPrice(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaID uuid.UUID, linkID uuid.UUID) (*domain.CredentialPrice, error)
+	DeletePrice(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
+	SaveRequest(ctx context.Context, conn db.Querier, request *domain.PaymentRequest) error
+	GetRequest(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.PaymentRequest, error)
+	GetLastRequest(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID) (*domain.PaymentRequest, error)
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+// PaymentService manages the credential prices and answers the payment messages of the holders
+type PaymentService interface {
+	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error)
+	CreatePrice(ctx context.Context, price *domain.CredentialPrice) error
+	GetPrices(ctx context.Context, issuerDID w3c.DID) ([]*domain.CredentialPrice, error)
+	DeletePrice(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+}
+
+// PaymentVerifier checks that a transaction pays a payment request
+type PaymentVerifier interface {
+	Verify(ctx context.Context, request *domain.PaymentRequest, txID string) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// ProposalRuleRepository is the interface that defines the available methods for the credential proposal rules
+type ProposalRuleRepository interface {
+	Save(ctx context.Context, conn db.Querier, rule *domain.ProposalRule) error
+	GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]*domain.ProposalRule, error)
+	GetBySchemaType(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaType string) ([]*domain.ProposalRule, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
+}
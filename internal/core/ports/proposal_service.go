@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+// ProposalService answers the credential proposal requests with the proposal rules of the issuer
+type ProposalService interface {
+	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error)
+	CreateRule(ctx context.Context, rule *domain.ProposalRule) error
+	GetRules(ctx context.Context, issuerDID w3c.DID) ([]*domain.ProposalRule, error)
+	DeleteRule(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+}
@@ -13,5 +13,5 @@ import (
 type SchemaRepository interface {
 	Save(ctx context.Context, schema *domain.Schema) error
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
-	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter *SchemasFilter) ([]domain.Schema, uint, error)
 }
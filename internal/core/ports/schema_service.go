@@ -2,18 +2,45 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/pagination"
+	"github.com/wakeup-labs/issuer-node/internal/sqltools"
 )
 
 // SchemaService defines the methods that Schema manager will expose.
 type SchemaService interface {
 	ImportSchema(ctx context.Context, issuerDID w3c.DID, req *ImportSchemaRequest) (*domain.Schema, error)
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
-	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter *SchemasFilter) ([]domain.Schema, uint, error)
+}
+
+// SchemasFilter defines the filters, the page and the order of the schemas returned by GetAll.
+// All the schemas are returned when the page is not set.
+type SchemasFilter struct {
+	Query       string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Pagination  pagination.Filter
+	OrderBy     sqltools.OrderByFilters
+}
+
+// NewSchemasFilter creates a new SchemasFilter
+func NewSchemasFilter(query *string, createdFrom *time.Time, createdTo *time.Time, page *uint, maxResults *uint, orderBy sqltools.OrderByFilters) *SchemasFilter {
+	filter := &SchemasFilter{
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		Pagination:  *pagination.NewFilter(maxResults, page),
+		OrderBy:     orderBy,
+	}
+	if query != nil {
+		filter.Query = *query
+	}
+	return filter
 }
 
 // ImportSchemaRequest defines the request for importing a schema
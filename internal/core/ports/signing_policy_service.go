@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// SigningPolicyService evaluates the configured policies before the issuer signs a credential or publishes a state
+type SigningPolicyService interface {
+	RequiresCredentialApproval(schema string) bool
+	RequiresPublishApproval() bool
+	CheckCredentialIssuance(ctx context.Context, req *CreateClaimRequest) error
+	CheckDailyIssuance(ctx context.Context, issuerDID w3c.DID) error
+	CheckStatePublish(ctx context.Context) error
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-jwz/v2"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/lestrrat-go/jwx/v2/jws"
+
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+var (
+	ErrAgentMessageReplayed = errors.New("the message was already received")      // ErrAgentMessageReplayed means the issuer already received a message with the same id
+	ErrAgentMessageExpired  = errors.New("the message is expired")                // ErrAgentMessageExpired means the expires_time of the message has passed
+	ErrAgentMessageTime     = errors.New("the message created_time is not valid") // ErrAgentMessageTime means the message was created in the future or after its expiration
+)
+
+// agentMessageTimes are the iden3comm message headers that are not part of iden3comm.BasicMessage
+type agentMessageTimes struct {
+	CreatedTime *int64 `json:"created_time,omitempty"`
+	ExpiresTime *int64 `json:"expires_time,omitempty"`
+}
+
+type agentMessage struct {
+	repository ports.AgentMessageRepository
+	storage    *db.Storage
+	clockSkew  time.Duration
+}
+
+// NewAgentMessage returns the service that keeps the threads of the agent
+func NewAgentMessage(repository ports.AgentMessageRepository, storage *db.Storage, cfg config.Agent) ports.AgentMessageService {
+	return &agentMessage{
+		repository: repository,
+		storage:    storage,
+		clockSkew:  cfg.MessageClockSkew,
+	}
+}
+
+// Receive checks the created_time and expires_time of a message received by the agent and saves it in its thread.
+// Messages whose id was already received by the issuer are rejected, so they cannot be replayed.
+// A message whose processing fails has to be discarded, so the holder can send it again.
+func (a *agentMessage) Receive(ctx context.Context, envelope []byte, message *iden3comm.BasicMessage, mediatype iden3comm.MediaType) error {
+	issuerDID, err := w3c.ParseDID(message.To)
+	if err != nil {
+		return err
+	}
+
+	times, err := messageTimes(envelope, mediatype)
+	if err != nil {
+		log.Warn(ctx, "reading the message times", "err", err, "id", message.ID)
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	inbound := domain.NewAgentMessage(domain.AgentMessageInbound, message.ID, message.ThreadID, *issuerDID, message.From, message.Type, mediatype, message.Body)
+	if times.CreatedTime != nil {
+		inbound.CreatedTime = toTime(*times.CreatedTime)
+	}
+	if times.ExpiresTime != nil {
+		inbound.ExpiresTime = toTime(*times.ExpiresTime)
+	}
+	if err := a.checkTimes(inbound); err != nil {
+		return err
+	}
+
+	if err := a.repository.Save(ctx, a.storage.Pgx, inbound); err != nil {
+		if errors.Is(err, repositories.ErrAgentMessageDuplicated) {
+			log.Warn(ctx, "agent message replayed", "id", message.ID, "from", message.From)
+			return ErrAgentMessageReplayed
+		}
+		if errors.Is(err, repositories.ErrIdentityNotFound) {
+			return ErrIssuerNotFound
+		}
+		log.Error(ctx, "saving agent message", "err", err, "id", message.ID)
+		return err
+	}
+	return nil
+}
+
+// checkTimes rejects expired messages and messages created in the future, allowing the configured clock skew
+func (a *agentMessage) checkTimes(message *domain.AgentMessage) error {
+	now := time.Now()
+	if message.ExpiresTime != nil && now.After(message.ExpiresTime.Add(a.clockSkew)) {
+		return ErrAgentMessageExpired
+	}
+	if message.CreatedTime != nil {
+		if message.CreatedTime.After(now.Add(a.clockSkew)) {
+			return fmt.Errorf("%w: it is in the future", ErrAgentMessageTime)
+		}
+		if message.ExpiresTime != nil && message.CreatedTime.After(*message.ExpiresTime) {
+			return fmt.Errorf("%w: it is after the expires_time", ErrAgentMessageTime)
+		}
+	}
+	return nil
+}
+
+// Discard removes a received message whose processing failed, so it is not taken as a replay when the holder retries it
+func (a *agentMessage) Discard(ctx context.Context, message *iden3comm.BasicMessage) error {
+	issuerDID, err := w3c.ParseDID(message.To)
+	if err != nil {
+		return err
+	}
+	if err := a.repository.Delete(ctx, a.storage.Pgx, *issuerDID, domain.AgentMessageInbound, message.ID); err != nil {
+		log.Error(ctx, "discarding agent message", "err", err, "id", message.ID)
+		return err
+	}
+	return nil
+}
+
+// Send saves a message answered by the agent in its thread
+func (a *agentMessage) Send(ctx context.Context, message *domain.AgentMessage) error {
+	if err := a.repository.Save(ctx, a.storage.Pgx, message); err != nil {
+		log.Error(ctx, "saving agent response", "err", err, "id", message.MessageID)
+		return err
+	}
+	return nil
+}
+
+// GetThread returns the messages of the thread, oldest first
+func (a *agentMessage) GetThread(ctx context.Context, issuerDID w3c.DID, threadID string) ([]*domain.AgentMessage, error) {
+	return a.repository.GetThread(ctx, a.storage.Pgx, issuerDID, threadID)
+}
+
+// messageTimes returns the created_time and expires_time of the message in the envelope.
+// The payload of signed and ZKP envelopes is read without verifying it again, because the envelope is already unpacked.
+func messageTimes(envelope []byte, mediatype iden3comm.MediaType) (*agentMessageTimes, error) {
+	payload := envelope
+	switch mediatype {
+	case packers.MediaTypeSignedMessage:
+		message, err := jws.Parse(envelope)
+		if err != nil {
+			return nil, err
+		}
+		payload = message.Payload()
+	case packers.MediaTypeZKPMessage:
+		token, err := jwz.Parse(string(envelope))
+		if err != nil {
+			return nil, err
+		}
+		payload = token.GetPayload()
+	}
+
+	times := &agentMessageTimes{}
+	if err := json.Unmarshal(payload, times); err != nil {
+		return nil, err
+	}
+	return times, nil
+}
+
+func toTime(seconds int64) *time.Time {
+	t := time.Unix(seconds, 0)
+	return &t
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+func TestAgentMessage_messageTimes(t *testing.T) {
+	payload := []byte(`{"id":"1","type":"https://iden3-communication.io/credentials/1.0/fetch-request","created_time":1700000000,"expires_time":1700000600}`)
+	signed, err := jws.Sign(payload, jws.WithKey(jwa.HS256, []byte("secret")))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name      string
+		envelope  []byte
+		mediatype iden3comm.MediaType
+	}{
+		{name: "plain", envelope: payload, mediatype: packers.MediaTypePlainMessage},
+		{name: "signed", envelope: signed, mediatype: packers.MediaTypeSignedMessage},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			times, err := messageTimes(tc.envelope, tc.mediatype)
+			require.NoError(t, err)
+			require.NotNil(t, times.CreatedTime)
+			require.NotNil(t, times.ExpiresTime)
+			assert.Equal(t, int64(1700000000), *times.CreatedTime)
+			assert.Equal(t, int64(1700000600), *times.ExpiresTime)
+		})
+	}
+
+	times, err := messageTimes([]byte(`{"id":"1"}`), packers.MediaTypePlainMessage)
+	require.NoError(t, err)
+	assert.Nil(t, times.CreatedTime)
+	assert.Nil(t, times.ExpiresTime)
+}
+
+func TestAgentMessage_checkTimes(t *testing.T) {
+	service := &agentMessage{clockSkew: time.Minute}
+	now := time.Now()
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+	for _, tc := range []struct {
+		name        string
+		createdTime *time.Time
+		expiresTime *time.Time
+		expected    error
+	}{
+		{name: "without times"},
+		{name: "valid", createdTime: at(-time.Minute), expiresTime: at(time.Hour)},
+		{name: "created within the clock skew", createdTime: at(30 * time.Second)},
+		{name: "expired within the clock skew", expiresTime: at(-30 * time.Second)},
+		{name: "expired", expiresTime: at(-time.Hour), expected: ErrAgentMessageExpired},
+		{name: "created in the future", createdTime: at(time.Hour), expected: ErrAgentMessageTime},
+		{name: "created after expiration", createdTime: at(-10 * time.Second), expiresTime: at(-30 * time.Second), expected: ErrAgentMessageTime},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			message := &domain.AgentMessage{CreatedTime: tc.createdTime, ExpiresTime: tc.expiresTime}
+			err := service.checkTimes(message)
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+// agentMessages keeps the messages in memory, rejecting the duplicated ones like the agent_messages table
+type agentMessages struct {
+	ports.AgentMessageRepository
+	messages map[string]*domain.AgentMessage
+}
+
+func (r *agentMessages) key(issuerDID w3c.DID, direction domain.AgentMessageDirection, messageID string) string {
+	return issuerDID.String() + "|" + string(direction) + "|" + messageID
+}
+
+func (r *agentMessages) Save(_ context.Context, _ db.Querier, message *domain.AgentMessage) error {
+	key := r.key(message.IssuerDID, message.Direction, message.MessageID)
+	if _, ok := r.messages[key]; ok {
+		return repositories.ErrAgentMessageDuplicated
+	}
+	r.messages[key] = message
+	return nil
+}
+
+func (r *agentMessages) Delete(_ context.Context, _ db.Querier, issuerDID w3c.DID, direction domain.AgentMessageDirection, messageID string) error {
+	delete(r.messages, r.key(issuerDID, direction, messageID))
+	return nil
+}
+
+func TestAgentMessage_Discard(t *testing.T) {
+	ctx := context.Background()
+	repository := &agentMessages{messages: make(map[string]*domain.AgentMessage)}
+	service := &agentMessage{repository: repository, storage: &db.Storage{}, clockSkew: time.Minute}
+	message := &iden3comm.BasicMessage{
+		ID:       "e6a9c4bb-4b8a-4bd1-8b10-8e1d5a4d0b8e",
+		ThreadID: "e6a9c4bb-4b8a-4bd1-8b10-8e1d5a4d0b8e",
+		Type:     "https://iden3-communication.io/credentials/1.0/fetch-request",
+		From:     "did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq",
+		To:       "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR",
+	}
+	envelope := []byte(`{"id":"e6a9c4bb-4b8a-4bd1-8b10-8e1d5a4d0b8e"}`)
+
+	require.NoError(t, service.Receive(ctx, envelope, message, packers.MediaTypePlainMessage))
+	assert.ErrorIs(t, service.Receive(ctx, envelope, message, packers.MediaTypePlainMessage), ErrAgentMessageReplayed)
+
+	// a message whose processing failed can be sent again once discarded
+	require.NoError(t, service.Discard(ctx, message))
+	require.NoError(t, service.Receive(ctx, envelope, message, packers.MediaTypePlainMessage))
+	assert.Len(t, repository.messages, 1)
+}
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/packers/providers/bjj"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"gopkg.in/go-jose/go-jose.v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/packagemanager"
+)
+
+const (
+	ethVerificationMethodFragment = "ethereum-based-id"
+	bjjVerificationMethodFragment = "bjj-auth-key"
+	ethRecoveryMethodType         = "EcdsaSecp256k1RecoveryMethod2020"
+	bjjVerificationKeyType        = "EddsaBJJVerificationKey"
+)
+
+var (
+	ErrNoKeyAgreementKey         = errors.New("the DID document has no supported key agreement key") // ErrNoKeyAgreementKey means the holder cannot receive encrypted messages
+	ErrUnsupportedSigningKeyType = errors.New("the identity key type cannot sign agent responses")   // ErrUnsupportedSigningKeyType means the issuer identity has no key to sign JWS messages
+)
+
+type agentPacker struct {
+	kms             kms.KMSType
+	identityService ports.IdentityService
+	claimService    ports.ClaimService
+	didResolver     packers.DIDResolverHandlerFunc
+	packedResponses bool
+}
+
+// NewAgentPacker returns the packer for the agent responses.
+// Signed responses are JWS signed with the issuer key in the kms: the auth BJJ key for BJJ identities and the
+// ethereum key (ES256K-R) for ethereum based identities.
+// Encrypted responses are anoncrypt JWE of the plain response for the key agreement key of the holder DID document.
+// Anoncrypt does not authenticate the issuer, so holders that need it must ask for signed responses. Authcrypt is
+// out of scope: iden3comm has no authcrypt packer and the kms has no key agreement keys for the issuers.
+func NewAgentPacker(keyStore kms.KMSType, identityService ports.IdentityService, claimService ports.ClaimService, didResolver packers.DIDResolverHandlerFunc, packedResponses bool) ports.AgentPacker {
+	return &agentPacker{
+		kms:             keyStore,
+		identityService: identityService,
+		claimService:    claimService,
+		didResolver:     didResolver,
+		packedResponses: packedResponses,
+	}
+}
+
+// Pack returns the response packed with the negotiated media type.
+// If the issuer cannot sign the response or the holder has no key agreement key, it falls back to the next
+// media type available (encrypted -> signed -> plain).
+func (p *agentPacker) Pack(ctx context.Context, response *domain.Agent, requestMediaType iden3comm.MediaType, accept []iden3comm.MediaType) ([]byte, iden3comm.MediaType, error) {
+	target := p.responseMediaType(requestMediaType, accept)
+
+	message := *response
+	envelope, err := json.Marshal(message)
+	if err != nil {
+		return nil, "", err
+	}
+	if target == packers.MediaTypePlainMessage {
+		return envelope, packers.MediaTypePlainMessage, nil
+	}
+
+	if target == packers.MediaTypeEncryptedMessage {
+		// the anoncrypt payload is the plain message, as the iden3comm packers expect
+		message.Typ = packers.MediaTypePlainMessage
+		plain, err := json.Marshal(message)
+		if err != nil {
+			return nil, "", err
+		}
+		encrypted, err := p.encrypt(message.To, plain)
+		if err == nil {
+			return encrypted, packers.MediaTypeEncryptedMessage, nil
+		}
+		log.Warn(ctx, "agent response cannot be encrypted", "err", err, "to", message.To)
+	}
+
+	signed, err := p.sign(ctx, message)
+	if err != nil {
+		log.Warn(ctx, "agent response cannot be signed", "err", err, "from", message.From)
+		return envelope, packers.MediaTypePlainMessage, nil
+	}
+	return signed, packers.MediaTypeSignedMessage, nil
+}
+
+// responseMediaType returns the first supported media type of the accept list.
+// Without preference, packed requests get signed responses if packed responses are enabled.
+func (p *agentPacker) responseMediaType(requestMediaType iden3comm.MediaType, accept []iden3comm.MediaType) iden3comm.MediaType {
+	for _, mediaType := range accept {
+		switch mediaType {
+		case packers.MediaTypePlainMessage, packers.MediaTypeSignedMessage, packers.MediaTypeEncryptedMessage:
+			return mediaType
+		}
+	}
+	if p.packedResponses && requestMediaType != packers.MediaTypePlainMessage {
+		return packers.MediaTypeSignedMessage
+	}
+	return packers.MediaTypePlainMessage
+}
+
+func (p *agentPacker) sign(ctx context.Context, message domain.Agent) ([]byte, error) {
+	issuerDID, err := w3c.ParseDID(message.From)
+	if err != nil {
+		return nil, err
+	}
+	keyID, alg, vm, err := p.signingMethod(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	message.Typ = packers.MediaTypeSignedMessage
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	packer := packers.NewJWSPacker(p.didResolver, func(string) (crypto.Signer, error) {
+		return packagemanager.NewKMSSigner(ctx, p.kms, keyID), nil
+	})
+	return packer.Pack(payload, packers.SigningParams{
+		Alg: alg,
+		KID: vm.ID,
+		DIDDoc: &verifiable.DIDDocument{
+			Context:            []string{"https://www.w3.org/ns/did/v1"},
+			ID:                 issuerDID.String(),
+			VerificationMethod: []verifiable.CommonVerificationMethod{vm},
+		},
+	})
+}
+
+// signingMethod returns the kms key, the JWS algorithm and the verification method used to sign the issuer messages
+func (p *agentPacker) signingMethod(ctx context.Context, issuerDID *w3c.DID) (kms.KeyID, jwa.SignatureAlgorithm, verifiable.CommonVerificationMethod, error) {
+	identity, err := p.identityService.GetByDID(ctx, *issuerDID)
+	if err != nil {
+		return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+	}
+
+	switch identity.KeyType {
+	case string(kms.KeyTypeEthereum):
+		keyIDs, err := p.kms.KeysByIdentity(ctx, *issuerDID)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		var keyID *kms.KeyID
+		for i := range keyIDs {
+			if keyIDs[i].Type == kms.KeyTypeEthereum {
+				keyID = &keyIDs[i]
+				break
+			}
+		}
+		if keyID == nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, ErrUnsupportedSigningKeyType
+		}
+		id, err := core.IDFromDID(*issuerDID)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		address, err := core.EthAddressFromID(id)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		chainID, err := core.ChainIDfromDID(*issuerDID)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		return *keyID, packagemanager.ES256KR, verifiable.CommonVerificationMethod{
+			ID:                  fmt.Sprintf("%s#%s", issuerDID.String(), ethVerificationMethodFragment),
+			Type:                ethRecoveryMethodType,
+			Controller:          issuerDID.String(),
+			BlockchainAccountID: fmt.Sprintf("eip155:%d:0x%x", chainID, address),
+		}, nil
+	case string(kms.KeyTypeBabyJubJub):
+		authClaim, err := p.claimService.GetAuthClaim(ctx, issuerDID)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		keyID, err := p.identityService.GetKeyIDFromAuthClaim(ctx, authClaim)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		publicKeyBytes, err := p.kms.PublicKey(keyID)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		publicKey, err := kms.DecodeBJJPubKey(publicKeyBytes)
+		if err != nil {
+			return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, err
+		}
+		return keyID, bjj.Alg, verifiable.CommonVerificationMethod{
+			ID:         fmt.Sprintf("%s#%s", issuerDID.String(), bjjVerificationMethodFragment),
+			Type:       bjjVerificationKeyType,
+			Controller: issuerDID.String(),
+			PublicKeyJwk: map[string]interface{}{
+				"kty": "EC",
+				"crv": "BJJ",
+				"x":   base64.RawURLEncoding.EncodeToString(publicKey.X.Bytes()),
+				"y":   base64.RawURLEncoding.EncodeToString(publicKey.Y.Bytes()),
+			},
+		}, nil
+	default:
+		return kms.KeyID{}, "", verifiable.CommonVerificationMethod{}, ErrUnsupportedSigningKeyType
+	}
+}
+
+func (p *agentPacker) encrypt(to string, payload []byte) ([]byte, error) {
+	if to == "" {
+		return nil, errors.New("the response has no recipient")
+	}
+	didDoc, err := p.didResolver(to)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keyAgreementKey(didDoc)
+	if err != nil {
+		return nil, err
+	}
+	return packers.NewAnoncryptPacker(nil).Pack(payload, packers.AnoncryptPackerParams{RecipientKey: key})
+}
+
+// keyAgreementKey returns the first key agreement key of the DID document published as JWK
+func keyAgreementKey(didDoc *verifiable.DIDDocument) (*jose.JSONWebKey, error) {
+	for _, entry := range didDoc.KeyAgreement {
+		var vm *verifiable.CommonVerificationMethod
+		switch v := entry.(type) {
+		case string:
+			ref := v
+			if strings.HasPrefix(ref, "#") {
+				ref = didDoc.ID + ref
+			}
+			for i := range didDoc.VerificationMethod {
+				if didDoc.VerificationMethod[i].ID == ref {
+					vm = &didDoc.VerificationMethod[i]
+					break
+				}
+			}
+		default:
+			raw, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			if err := json.Unmarshal(raw, &vm); err != nil {
+				continue
+			}
+		}
+		if vm == nil || len(vm.PublicKeyJwk) == 0 {
+			continue
+		}
+
+		raw, err := json.Marshal(vm.PublicKeyJwk)
+		if err != nil {
+			continue
+		}
+		key := &jose.JSONWebKey{}
+		if err := key.UnmarshalJSON(raw); err != nil {
+			continue
+		}
+		if key.KeyID == "" {
+			key.KeyID = vm.ID
+		}
+		return key, nil
+	}
+	return nil, ErrNoKeyAgreementKey
+}
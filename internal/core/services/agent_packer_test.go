@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-jose/go-jose.v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+)
+
+// agentPackerIdentities returns the identities of the test, any other method panics
+type agentPackerIdentities struct {
+	ports.IdentityService
+	keyTypes map[string]kms.KeyType
+	authKey  kms.KeyID
+}
+
+func (i *agentPackerIdentities) GetByDID(_ context.Context, did w3c.DID) (*domain.Identity, error) {
+	return &domain.Identity{Identifier: did.String(), KeyType: string(i.keyTypes[did.String()])}, nil
+}
+
+func (i *agentPackerIdentities) GetKeyIDFromAuthClaim(_ context.Context, _ *domain.Claim) (kms.KeyID, error) {
+	return i.authKey, nil
+}
+
+type agentPackerClaims struct {
+	ports.ClaimService
+}
+
+func (c *agentPackerClaims) GetAuthClaim(_ context.Context, _ *w3c.DID) (*domain.Claim, error) {
+	return &domain.Claim{}, nil
+}
+
+func TestAgentPacker_Pack(t *testing.T) {
+	ctx := context.Background()
+
+	keysFile := filepath.Join(t.TempDir(), "kms.json")
+	require.NoError(t, os.WriteFile(keysFile, []byte("[]"), 0o600))
+	keyStore := kms.NewKMS()
+	fileManager := kms.NewLocalStorageFileManager(keysFile)
+	require.NoError(t, keyStore.RegisterKeyProvider(kms.KeyTypeBabyJubJub, kms.NewLocalStorageBJJKeyProvider(kms.KeyTypeBabyJubJub, fileManager)))
+	require.NoError(t, keyStore.RegisterKeyProvider(kms.KeyTypeEthereum, kms.NewLocalStorageEthKeyProvider(kms.KeyTypeEthereum, fileManager)))
+
+	// BJJ issuer
+	bjjDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	bjjKey, err := keyStore.CreateKey(kms.KeyTypeBabyJubJub, bjjDID)
+	require.NoError(t, err)
+	bjjPublicKeyBytes, err := keyStore.PublicKey(bjjKey)
+	require.NoError(t, err)
+	bjjPublicKey, err := kms.DecodeBJJPubKey(bjjPublicKeyBytes)
+	require.NoError(t, err)
+
+	// ethereum based issuer
+	ethKey, err := keyStore.CreateKey(kms.KeyTypeEthereum, nil)
+	require.NoError(t, err)
+	ethPublicKeyBytes, err := keyStore.PublicKey(ethKey)
+	require.NoError(t, err)
+	ethPublicKey, err := kms.DecodeETHPubKey(ethPublicKeyBytes)
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(*ethPublicKey)
+	var ethAddress [20]byte
+	copy(ethAddress[:], address.Bytes())
+	didType, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Amoy)
+	require.NoError(t, err)
+	ethDID, err := core.NewDID(didType, core.GenesisFromEthAddress(ethAddress))
+	require.NoError(t, err)
+	_, err = keyStore.LinkToIdentity(ctx, ethKey, *ethDID)
+	require.NoError(t, err)
+
+	// holders, with and without key agreement key
+	holderKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	holderJWK, err := jose.JSONWebKey{Key: &holderKey.PublicKey}.MarshalJSON()
+	require.NoError(t, err)
+	holderPublicKeyJWK := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(holderJWK, &holderPublicKeyJWK))
+	const (
+		holderDID          = "did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq"
+		holderWithoutKeyID = "did:polygonid:polygon:amoy:2qFjTM4kX3J6AYzHBY1Q3ztnxv1UfNaaNUGw8TKo4N"
+		holderKeyID        = holderDID + "#key-agreement"
+	)
+
+	didDocs := map[string]*verifiable.DIDDocument{
+		bjjDID.String(): {
+			ID: bjjDID.String(),
+			VerificationMethod: []verifiable.CommonVerificationMethod{{
+				ID:         bjjDID.String() + "#" + bjjVerificationMethodFragment,
+				Type:       bjjVerificationKeyType,
+				Controller: bjjDID.String(),
+				PublicKeyJwk: map[string]interface{}{
+					"kty": "EC",
+					"crv": "BJJ",
+					"x":   base64.RawURLEncoding.EncodeToString(bjjPublicKey.X.Bytes()),
+					"y":   base64.RawURLEncoding.EncodeToString(bjjPublicKey.Y.Bytes()),
+				},
+			}},
+		},
+		ethDID.String(): {
+			ID: ethDID.String(),
+			VerificationMethod: []verifiable.CommonVerificationMethod{{
+				ID:                  ethDID.String() + "#" + ethVerificationMethodFragment,
+				Type:                ethRecoveryMethodType,
+				Controller:          ethDID.String(),
+				BlockchainAccountID: fmt.Sprintf("eip155:80002:%s", address.Hex()),
+			}},
+		},
+		holderDID: {
+			ID:                 holderDID,
+			VerificationMethod: []verifiable.CommonVerificationMethod{{ID: holderKeyID, Type: "JsonWebKey2020", Controller: holderDID, PublicKeyJwk: holderPublicKeyJWK}},
+			KeyAgreement:       []interface{}{"#key-agreement"},
+		},
+		holderWithoutKeyID: {ID: holderWithoutKeyID},
+	}
+	didResolver := func(did string) (*verifiable.DIDDocument, error) {
+		doc, ok := didDocs[did]
+		if !ok {
+			return nil, fmt.Errorf("unknown did %s", did)
+		}
+		return doc, nil
+	}
+
+	// the holder unpacks the responses with the iden3comm packers
+	holder := iden3comm.NewPackageManager()
+	require.NoError(t, holder.RegisterPackers(
+		&packers.PlainMessagePacker{},
+		packers.NewJWSPacker(didResolver, nil),
+		packers.NewAnoncryptPacker(func(keyID string) (interface{}, error) {
+			if keyID != holderKeyID {
+				return nil, fmt.Errorf("unknown key %s", keyID)
+			}
+			return holderKey, nil
+		}),
+	))
+
+	identities := &agentPackerIdentities{
+		keyTypes: map[string]kms.KeyType{bjjDID.String(): kms.KeyTypeBabyJubJub, ethDID.String(): kms.KeyTypeEthereum},
+		authKey:  bjjKey,
+	}
+	packer := NewAgentPacker(keyStore, identities, &agentPackerClaims{}, didResolver, false)
+
+	type testConfig struct {
+		name     string
+		from     string
+		to       string
+		accept   iden3comm.MediaType
+		expected iden3comm.MediaType
+	}
+	for _, tc := range []testConfig{
+		{name: "plain", from: bjjDID.String(), to: holderDID, accept: packers.MediaTypePlainMessage, expected: packers.MediaTypePlainMessage},
+		{name: "signed by a BJJ identity", from: bjjDID.String(), to: holderDID, accept: packers.MediaTypeSignedMessage, expected: packers.MediaTypeSignedMessage},
+		{name: "signed by an ethereum based identity", from: ethDID.String(), to: holderDID, accept: packers.MediaTypeSignedMessage, expected: packers.MediaTypeSignedMessage},
+		{name: "encrypted", from: bjjDID.String(), to: holderDID, accept: packers.MediaTypeEncryptedMessage, expected: packers.MediaTypeEncryptedMessage},
+		{name: "encrypted for a holder without key agreement key", from: ethDID.String(), to: holderWithoutKeyID, accept: packers.MediaTypeEncryptedMessage, expected: packers.MediaTypeSignedMessage},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response := &domain.Agent{
+				ID:       uuid.NewString(),
+				Typ:      packers.MediaTypePlainMessage,
+				Type:     protocol.CredentialOfferMessageType,
+				ThreadID: uuid.NewString(),
+				Body:     protocol.CredentialsOfferMessageBody{URL: "https://issuer.example.com/v2/agent"},
+				From:     tc.from,
+				To:       tc.to,
+			}
+			envelope, mediaType, err := packer.Pack(ctx, response, packers.MediaTypeZKPMessage, []iden3comm.MediaType{tc.accept})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, mediaType)
+
+			message, unpackedMediaType, err := holder.Unpack(envelope)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, unpackedMediaType)
+			assert.Equal(t, response.ID, message.ID)
+			assert.Equal(t, response.ThreadID, message.ThreadID)
+			assert.Equal(t, response.Type, message.Type)
+			assert.Equal(t, tc.from, message.From)
+			assert.Equal(t, tc.to, message.To)
+		})
+	}
+}
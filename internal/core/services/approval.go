@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+// statePublishPayload is the payload stored for the state publish approval requests
+type statePublishPayload struct {
+	Identifier string `json:"identifier"`
+}
+
+type approval struct {
+	repo          ports.ApprovalRepository
+	signingPolicy ports.SigningPolicyService
+	claimService  ports.ClaimService
+	publisher     ports.Publisher
+	storage       *db.Storage
+	requester     string
+	approver      string
+}
+
+// NewApproval returns the service that manages the approval queue.
+// The requester is the API user and the approver the one configured in the signing policy,
+// so an operation always needs two different accounts to be executed.
+func NewApproval(repo ports.ApprovalRepository, signingPolicy ports.SigningPolicyService, claimService ports.ClaimService, publisher ports.Publisher, storage *db.Storage, auth config.HTTPBasicAuth, policy config.SigningPolicy) ports.ApprovalService {
+	return &approval{
+		repo:          repo,
+		signingPolicy: signingPolicy,
+		claimService:  claimService,
+		publisher:     publisher,
+		storage:       storage,
+		requester:     auth.User,
+		approver:      policy.ApproverUser,
+	}
+}
+
+// RequestCredentialIssuance queues the credential request until an approver accepts it
+func (a *approval) RequestCredentialIssuance(ctx context.Context, req *ports.CreateClaimRequest) (*domain.ApprovalRequest, error) {
+	if !a.signingPolicy.RequiresCredentialApproval(req.Schema) {
+		return nil, ErrApprovalNotEnabled
+	}
+	if err := a.signingPolicy.CheckDailyIssuance(ctx, *req.DID); err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return a.save(ctx, domain.NewApprovalRequest(*req.DID, domain.ApprovalOperationCredentialIssuance, payload, a.requester))
+}
+
+// RequestStatePublish queues the publication of the identity state until an approver accepts it
+func (a *approval) RequestStatePublish(ctx context.Context, issuerDID w3c.DID) (*domain.ApprovalRequest, error) {
+	if !a.signingPolicy.RequiresPublishApproval() {
+		return nil, ErrApprovalNotEnabled
+	}
+	payload, err := json.Marshal(statePublishPayload{Identifier: issuerDID.String()})
+	if err != nil {
+		return nil, err
+	}
+	return a.save(ctx, domain.NewApprovalRequest(issuerDID, domain.ApprovalOperationStatePublish, payload, a.requester))
+}
+
+func (a *approval) save(ctx context.Context, request *domain.ApprovalRequest) (*domain.ApprovalRequest, error) {
+	if err := a.repo.Save(ctx, a.storage.Pgx, request); err != nil {
+		log.Error(ctx, "saving approval request", "err", err, "operation", request.Operation)
+		return nil, err
+	}
+	log.Info(ctx, "operation queued for approval", "id", request.ID, "operation", request.Operation, "did", request.IssuerDID.String())
+	return request, nil
+}
+
+// GetAll returns the approval requests of the identity, optionally filtered by status
+func (a *approval) GetAll(ctx context.Context, issuerDID w3c.DID, status *domain.ApprovalStatus) ([]*domain.ApprovalRequest, error) {
+	return a.repo.GetAll(ctx, a.storage.Pgx, issuerDID, status)
+}
+
+// GetByID returns an approval request
+func (a *approval) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.ApprovalRequest, error) {
+	return a.repo.GetByID(ctx, a.storage.Pgx, issuerDID, id)
+}
+
+// Approve accepts a pending request and executes the operation.
+// The policies are evaluated before changing the status, so a request that cannot be executed now stays pending.
+// If the operation fails after the approval the request is marked as failed.
+func (a *approval) Approve(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.ApprovalRequest, error) {
+	request, err := a.repo.GetByID(ctx, a.storage.Pgx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !request.IsPending() {
+		return nil, repositories.ErrApprovalRequestNotPending
+	}
+
+	var execute func() (string, error)
+	switch request.Operation {
+	case domain.ApprovalOperationCredentialIssuance:
+		var req ports.CreateClaimRequest
+		if err := json.Unmarshal(request.Payload, &req); err != nil {
+			log.Error(ctx, "decoding approval request payload", "err", err, "id", id)
+			return nil, err
+		}
+		if err := a.signingPolicy.CheckDailyIssuance(ctx, issuerDID); err != nil {
+			return nil, err
+		}
+		req.ApprovalID = &request.ID
+		execute = func() (string, error) {
+			credential, err := a.claimService.Save(ctx, &req)
+			if err != nil {
+				return "", err
+			}
+			return credential.ID.String(), nil
+		}
+	case domain.ApprovalOperationStatePublish:
+		if err := a.signingPolicy.CheckStatePublish(ctx); err != nil {
+			return nil, err
+		}
+		execute = func() (string, error) {
+			published, err := a.publisher.PublishState(ctx, &issuerDID)
+			if err != nil {
+				return "", err
+			}
+			if published.TxID == nil {
+				return "", nil
+			}
+			return *published.TxID, nil
+		}
+	default:
+		return nil, ErrApprovalOperationMismatch
+	}
+
+	if err := a.review(ctx, request, domain.ApprovalStatusApproved, nil); err != nil {
+		return nil, err
+	}
+
+	resultID, err := execute()
+	if err != nil {
+		log.Error(ctx, "executing approved operation", "err", err, "id", id, "operation", request.Operation)
+		request.Status = domain.ApprovalStatusFailed
+		request.Reason = common.ToPointer(err.Error())
+	} else {
+		request.ResultID = &resultID
+	}
+	if err := a.repo.UpdateResult(ctx, a.storage.Pgx, request); err != nil {
+		log.Error(ctx, "saving approval request result", "err", err, "id", id)
+		return nil, err
+	}
+	return request, nil
+}
+
+// Reject discards a pending request
+func (a *approval) Reject(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, reason *string) (*domain.ApprovalRequest, error) {
+	request, err := a.repo.GetByID(ctx, a.storage.Pgx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !request.IsPending() {
+		return nil, repositories.ErrApprovalRequestNotPending
+	}
+	if err := a.review(ctx, request, domain.ApprovalStatusRejected, reason); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (a *approval) review(ctx context.Context, request *domain.ApprovalRequest, status domain.ApprovalStatus, reason *string) error {
+	request.Status = status
+	request.Reason = reason
+	request.ReviewedBy = &a.approver
+	request.ReviewedAt = common.ToPointer(time.Now())
+	if err := a.repo.Review(ctx, a.storage.Pgx, request); err != nil {
+		log.Error(ctx, "reviewing approval request", "err", err, "id", request.ID, "status", status)
+		return err
+	}
+	return nil
+}
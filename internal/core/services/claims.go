@@ -21,6 +21,7 @@ import (
 	"github.com/iden3/iden3comm/v2/packers"
 	"github.com/iden3/iden3comm/v2/protocol"
 	shell "github.com/ipfs/go-ipfs-api"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 
 	"github.com/wakeup-labs/issuer-node/internal/common"
@@ -41,22 +42,24 @@ import (
 )
 
 var (
-	ErrCredentialNotFound                = errors.New("credential not found")                                          // ErrCredentialNotFound Cannot retrieve the given claim
-	ErrDisplayMethodLacksURL             = errors.New("credential request with display method lacks url")              // ErrDisplayMethodLacksURL means the credential request includes a display method, but the url is not set
-	ErrEmptyMTPProof                     = errors.New("mtp credentials must have a mtp proof to be fetched")           // ErrEmptyMTPProof means that a credential of MTP type can not be fetched if it does not contain the proof
-	ErrJSONLdContext                     = errors.New("jsonLdContext must be a string")                                // ErrJSONLdContext Field jsonLdContext must be a string
-	ErrInvalidCredentialSubject          = errors.New("credential subject does not match the provided schema")         // ErrInvalidCredentialSubject means the credentialSubject does not match the schema provided
-	ErrLinkNotFound                      = errors.New("link not found")                                                // ErrLinkNotFound Cannot get the given link from the DB
-	ErrLoadingSchema                     = errors.New("cannot load schema")                                            // ErrLoadingSchema means the system cannot load the schema file
-	ErrMalformedURL                      = errors.New("malformed url")                                                 // ErrMalformedURL The schema url is wrong
-	ErrParseClaim                        = errors.New("cannot parse claim")                                            // ErrParseClaim Cannot parse claim
-	ErrProcessSchema                     = errors.New("cannot process schema")                                         // ErrProcessSchema Cannot process schema
-	ErrRefreshServiceLacksExpirationTime = errors.New("credential request with refresh service lacks expiration time") // ErrRefreshServiceLacksExpirationTime means the credential request includes a refresh service, but the expiration time is not set
-	ErrRefreshServiceLacksURL            = errors.New("credential request with refresh service lacks url")             // ErrRefreshServiceLacksURL means the credential request includes a refresh service, but the url is not set
-	ErrSchemaNotFound                    = errors.New("schema not found")                                              // ErrSchemaNotFound Cannot retrieve the given schema from DB
-	ErrUnsupportedDisplayMethodType      = errors.New("unsupported display method type")                               // ErrUnsupportedDisplayMethodType means the display method type is not supported
-	ErrUnsupportedRefreshServiceType     = errors.New("unsupported refresh service type")                              // ErrUnsupportedRefreshServiceType means the refresh service type is not supported
-	ErrWrongCredentialSubjectID          = errors.New("wrong format for credential subject ID")                        // ErrWrongCredentialSubjectID means the credential subject ID is wrong
+	ErrCredentialNotFound                = errors.New("credential not found")                                                 // ErrCredentialNotFound Cannot retrieve the given claim
+	ErrDisplayMethodLacksURL             = errors.New("credential request with display method lacks url")                     // ErrDisplayMethodLacksURL means the credential request includes a display method, but the url is not set
+	ErrEmptyMTPProof                     = errors.New("mtp credentials must have a mtp proof to be fetched")                  // ErrEmptyMTPProof means that a credential of MTP type can not be fetched if it does not contain the proof
+	ErrJSONLdContext                     = errors.New("jsonLdContext must be a string")                                       // ErrJSONLdContext Field jsonLdContext must be a string
+	ErrInvalidCredentialSubject          = errors.New("credential subject does not match the provided schema")                // ErrInvalidCredentialSubject means the credentialSubject does not match the schema provided
+	ErrLinkNotFound                      = errors.New("link not found")                                                       // ErrLinkNotFound Cannot get the given link from the DB
+	ErrLoadingSchema                     = errors.New("cannot load schema")                                                   // ErrLoadingSchema means the system cannot load the schema file
+	ErrMalformedURL                      = errors.New("malformed url")                                                        // ErrMalformedURL The schema url is wrong
+	ErrOnchainCredentialRevocation       = errors.New("credentials issued on chain must be revoked in the identity contract") // ErrOnchainCredentialRevocation means the credential is in the claims tree of the on-chain identity contract, not in the one of the node
+	ErrOnchainIssuanceSignatureProof     = errors.New("credentials issued on chain can only have a mtp proof")                // ErrOnchainIssuanceSignatureProof means a credential issued on chain was requested with a signature proof
+	ErrParseClaim                        = errors.New("cannot parse claim")                                                   // ErrParseClaim Cannot parse claim
+	ErrProcessSchema                     = errors.New("cannot process schema")                                                // ErrProcessSchema Cannot process schema
+	ErrRefreshServiceLacksExpirationTime = errors.New("credential request with refresh service lacks expiration time")        // ErrRefreshServiceLacksExpirationTime means the credential request includes a refresh service, but the expiration time is not set
+	ErrRefreshServiceLacksURL            = errors.New("credential request with refresh service lacks url")                    // ErrRefreshServiceLacksURL means the credential request includes a refresh service, but the url is not set
+	ErrSchemaNotFound                    = errors.New("schema not found")                                                     // ErrSchemaNotFound Cannot retrieve the given schema from DB
+	ErrUnsupportedDisplayMethodType      = errors.New("unsupported display method type")                                      // ErrUnsupportedDisplayMethodType means the display method type is not supported
+	ErrUnsupportedRefreshServiceType     = errors.New("unsupported refresh service type")                                     // ErrUnsupportedRefreshServiceType means the refresh service type is not supported
+	ErrWrongCredentialSubjectID          = errors.New("wrong format for credential subject ID")                               // ErrWrongCredentialSubjectID means the credential subject ID is wrong
 )
 
 type claim struct {
@@ -64,6 +67,7 @@ type claim struct {
 	cfg  config.UniversalLinks
 
 	icRepo                   ports.ClaimRepository
+	linkRepo                 ports.LinkRepository
 	identitySrv              ports.IdentityService
 	mtService                ports.MtService
 	qrService                ports.QrStoreService
@@ -73,14 +77,17 @@ type claim struct {
 	publisher                pubsub.Publisher
 	ipfsClient               *shell.Shell
 	revocationStatusResolver *revocationstatus.Resolver
+	onchainIssuer            ports.OnchainIssuer
 	mediatypeManager         ports.MediatypeManager
+	signingPolicy            ports.SigningPolicyService
 }
 
 // NewClaim creates a new claim service
-func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, ps pubsub.Publisher, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, mediatypeManager ports.MediatypeManager, cfg config.UniversalLinks) ports.ClaimService {
+func NewClaim(repo ports.ClaimRepository, linkRepo ports.LinkRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, ps pubsub.Publisher, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, onchainIssuer ports.OnchainIssuer, mediatypeManager ports.MediatypeManager, signingPolicy ports.SigningPolicyService, cfg config.UniversalLinks) ports.ClaimService {
 	s := &claim{
 		host:                     host,
 		icRepo:                   repo,
+		linkRepo:                 linkRepo,
 		identitySrv:              idenSrv,
 		mtService:                mtService,
 		qrService:                qrService,
@@ -89,7 +96,9 @@ func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrServi
 		loader:                   ld,
 		publisher:                ps,
 		revocationStatusResolver: revocationStatusResolver,
+		onchainIssuer:            onchainIssuer,
 		mediatypeManager:         mediatypeManager,
+		signingPolicy:            signingPolicy,
 		cfg:                      cfg,
 	}
 	if ipfsGatewayURL != "" {
@@ -111,6 +120,11 @@ func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domai
 	if err != nil {
 		return nil, err
 	}
+	if claim.IssuanceMode.IsOnchain() {
+		if err := c.publishOnchain(ctx, *req.DID, claim); err != nil {
+			return nil, err
+		}
+	}
 	if req.SignatureProof {
 		err = c.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: []string{claim.ID.String()}, IssuerID: req.DID.String()})
 		if err != nil {
@@ -121,6 +135,25 @@ func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domai
 	return claim, nil
 }
 
+// publishOnchain publishes the claim of a credential already saved to the identity contract of the issuer and stores the
+// transaction. The credential is deleted if the claim can not be published, so it is not left pending forever.
+func (c *claim) publishOnchain(ctx context.Context, issuerDID w3c.DID, claim *domain.Claim) error {
+	txID, err := c.onchainIssuer.Publish(ctx, issuerDID, claim.CoreClaim.Get())
+	if err != nil {
+		log.Error(ctx, "publishing the claim on chain", "err", err, "credential", claim.ID.String())
+		if err := c.icRepo.Delete(ctx, c.storage.Pgx, claim.ID); err != nil {
+			log.Error(ctx, "deleting the credential that could not be published on chain", "err", err, "credential", claim.ID.String())
+		}
+		return err
+	}
+	claim.OnchainTxID = &txID
+	if _, err := c.icRepo.UpdateOnchainTxID(ctx, c.storage.Pgx, issuerDID, claim.ID, txID); err != nil {
+		// the claim is published, so the credential is kept. Its proof is read from the contract, not from the transaction.
+		log.Error(ctx, "storing the on-chain transaction of the credential", "err", err, "credential", claim.ID.String(), "tx", txID)
+	}
+	return nil
+}
+
 // GetRevoked returns all the revoked credentials for the given state
 func (c *claim) GetRevoked(ctx context.Context, currentState string) ([]*domain.Claim, error) {
 	return c.icRepo.GetRevoked(ctx, c.storage.Pgx, currentState)
@@ -133,6 +166,11 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		return nil, err
 	}
 
+	if err := c.signingPolicy.CheckCredentialIssuance(ctx, req); err != nil {
+		log.Warn(ctx, "credential issuance rejected by the signing policy", "err", err, "schema", req.Schema)
+		return nil, err
+	}
+
 	var nonce uint64
 	var err error
 	if req.RevNonce != nil {
@@ -274,6 +312,11 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 	}
 
 	claim.MtProof = req.MTProof
+	claim.IssuanceMode = domain.CredentialIssuanceOffchain
+	if req.IssuanceMode.IsOnchain() {
+		claim.MtProof = true
+		claim.IssuanceMode = domain.CredentialIssuanceOnchain
+	}
 	claim.LinkID = req.LinkID
 	claim.CreatedAt = *vc.IssuanceDate
 	return claim, nil
@@ -376,6 +419,9 @@ func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid
 		log.Error(ctx, "getCredentialQrQrCode: store qr code", "err", err)
 		return nil, err
 	}
+	if err := c.MarkOffered(ctx, *issID, &qrCode); err != nil {
+		log.Error(ctx, "getCredentialQrQrCode: marking the credential as offered", "err", err)
+	}
 	return &ports.GetCredentialQrCodeResponse{
 		DeepLink:      qrlink.NewDeepLink(hostURL, qrID, nil),
 		UniversalLink: qrlink.NewUniversal(c.cfg.BaseUrl, hostURL, qrID, nil),
@@ -386,21 +432,16 @@ func (c *claim) GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid
 }
 
 func (c *claim) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
-	if !c.mediatypeManager.AllowMediaType(req.Type, mediatype) {
-		err := fmt.Errorf("unsupported media type '%s' for message type '%s'", mediatype, req.Type)
-		log.Error(ctx, "agent: unsupported media type", "err", err)
-		return nil, err
-	}
-
-	exists, err := c.identitySrv.Exists(ctx, *req.IssuerDID)
+	agent, err := c.agent(ctx, req, mediatype)
 	if err != nil {
-		log.Error(ctx, "loading issuer identity", "err", err, "issuerDID", req.IssuerDID)
-		return nil, err
+		return nil, newAgentProblem(req, err)
 	}
+	return agent, nil
+}
 
-	if !exists {
-		log.Warn(ctx, "issuer not found", "issuerDID", req.IssuerDID)
-		return nil, fmt.Errorf("cannot proceed with this identity, not found")
+func (c *claim) agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	if err := checkAgentRequest(ctx, c.mediatypeManager, c.identitySrv, req, mediatype); err != nil {
+		return nil, err
 	}
 
 	switch req.Type {
@@ -408,11 +449,46 @@ func (c *claim) Agent(ctx context.Context, req *ports.AgentRequest, mediatype id
 		return c.getAgentCredential(ctx, req)
 	case protocol.RevocationStatusRequestMessageType:
 		return c.getRevocationStatus(ctx, req)
+	case domain.DiscoverFeatureQueriesMessageType:
+		return c.discoverFeatures(ctx, req)
+	case domain.AckMessageType:
+		return nil, c.ack(ctx, req)
 	default:
 		return nil, errors.New("invalid type")
 	}
 }
 
+// checkAgentRequest checks that the message type can be sent with the media type and that the message is addressed
+// to an identity of the issuer
+func checkAgentRequest(ctx context.Context, mediatypeManager ports.MediatypeManager, identitySrv ports.IdentityService, req *ports.AgentRequest, mediatype iden3comm.MediaType) error {
+	if !mediatypeManager.AllowMediaType(req.IssuerDID, req.Type, mediatype) {
+		err := fmt.Errorf("%w '%s' for message type '%s'", ErrUnsupportedMediaType, mediatype, req.Type)
+		log.Error(ctx, "agent: unsupported media type", "err", err)
+		return err
+	}
+
+	exists, err := identitySrv.Exists(ctx, *req.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading issuer identity", "err", err, "issuerDID", req.IssuerDID)
+		return err
+	}
+
+	if !exists {
+		log.Warn(ctx, "issuer not found", "issuerDID", req.IssuerDID)
+		return ErrIssuerNotFound
+	}
+	return nil
+}
+
+// newAgentProblem returns the error to be reported to the sender of the agent request
+func newAgentProblem(req *ports.AgentRequest, err error) *ProblemReportError {
+	threadID := req.ThreadID
+	if threadID == "" {
+		threadID = req.ClaimID.String()
+	}
+	return &ProblemReportError{Err: err, ThreadID: threadID, From: req.IssuerDID.String(), To: req.UserDID.String()}
+}
+
 func (c *claim) GetAuthClaim(ctx context.Context, did *w3c.DID) (*domain.Claim, error) {
 	authHash, err := core.AuthSchemaHash.MarshalText()
 	if err != nil {
@@ -597,6 +673,19 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 		Description: description,
 	}
 
+	claims, err := c.icRepo.GetByRevocationNonce(ctx, querier, did, domain.RevNonceUint64(nonce))
+	if err != nil {
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return err
+		}
+		return fmt.Errorf("error getting the claim by revocation nonce: %w", err)
+	}
+	for _, claim := range claims {
+		if claim.IssuanceMode.IsOnchain() {
+			return ErrOnchainCredentialRevocation
+		}
+	}
+
 	identityTrees, err := c.mtService.GetIdentityMerkleTrees(ctx, querier, did)
 	if err != nil {
 		return fmt.Errorf("error getting merkle trees: %w", err)
@@ -607,15 +696,6 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 		return fmt.Errorf("error revoking the claim: %w", err)
 	}
 
-	var claims []*domain.Claim
-	claims, err = c.icRepo.GetByRevocationNonce(ctx, querier, did, domain.RevNonceUint64(nonce))
-	if err != nil {
-		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
-			return err
-		}
-		return fmt.Errorf("error getting the claim by revocation nonce: %w", err)
-	}
-
 	err = c.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
 			for _, claim := range claims {
@@ -681,13 +761,26 @@ func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.Agen
 	claim, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, basicMessage.IssuerDID, claimID)
 	if err != nil {
 		log.Error(ctx, "loading claim", "err", err)
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return nil, ErrCredentialNotFound
+		}
 		return nil, fmt.Errorf("failed get claim by claimID: %w", err)
 	}
 
 	if claim.OtherIdentifier != basicMessage.UserDID.String() {
-		err := fmt.Errorf("claim doesn't relate to sender")
-		log.Error(ctx, "claim doesn't relate to sender", err, "claimID", claim.ID)
-		return nil, err
+		log.Error(ctx, "claim doesn't relate to sender", "claimID", claim.ID)
+		return nil, ErrCredentialNotFound
+	}
+
+	if claim.Revoked {
+		log.Warn(ctx, "fetching a revoked claim", "claimID", claim.ID)
+		return nil, ErrCredentialRevoked
+	}
+
+	if claim.IssuanceMode.IsOnchain() && claim.MTPProof.Status != pgtype.Present {
+		if err := c.setOnchainProof(ctx, claim); err != nil {
+			return nil, err
+		}
 	}
 
 	vc, err := schemaPkg.FromClaimModelToW3CCredential(*claim)
@@ -696,6 +789,19 @@ func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.Agen
 		return nil, fmt.Errorf("failed to convert claim to  w3cCredential: %w", err)
 	}
 
+	threadID := basicMessage.ThreadID
+	if threadID == "" {
+		threadID = basicMessage.ClaimID.String()
+	}
+	if _, err := c.icRepo.UpdateDeliveryStatus(ctx, c.storage.Pgx, *basicMessage.IssuerDID, claim.ID, domain.CredentialDeliveryFetched, &threadID); err != nil {
+		log.Error(ctx, "updating the credential delivery status", "err", err, "claimID", claim.ID)
+	}
+	if claim.LinkID != nil {
+		if err := c.linkRepo.SaveEvent(ctx, domain.NewLinkEvent(*claim.LinkID, domain.LinkEventCredentialFetched, basicMessage.UserDID)); err != nil {
+			log.Error(ctx, "saving the link event", "err", err, "claimID", claim.ID)
+		}
+	}
+
 	return &domain.Agent{
 		ID:       uuid.NewString(),
 		Typ:      packers.MediaTypePlainMessage,
@@ -707,6 +813,78 @@ func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.Agen
 	}, err
 }
 
+// setOnchainProof builds the MTP proof of a credential issued on chain from the state of the identity contract
+// and keeps it, so the contract is only read until the proof is available.
+func (c *claim) setOnchainProof(ctx context.Context, claim *domain.Claim) error {
+	issuerDID, err := w3c.ParseDID(claim.Issuer)
+	if err != nil {
+		return err
+	}
+	proof, err := c.onchainIssuer.Proof(ctx, *issuerDID, claim.CoreClaim.Get())
+	if err != nil {
+		if errors.Is(err, domain.ErrOnchainClaimNotPublished) {
+			log.Warn(ctx, "fetching a credential that is not published on chain yet", "claimID", claim.ID, "tx", claim.OnchainTxID)
+		} else {
+			log.Error(ctx, "getting the on-chain proof of the claim", "err", err, "claimID", claim.ID)
+		}
+		return err
+	}
+	jsonProof, err := json.Marshal(proof)
+	if err != nil {
+		return err
+	}
+	if err := claim.MTPProof.Set(jsonProof); err != nil {
+		return err
+	}
+	if _, err := c.icRepo.UpdateClaimMTP(ctx, c.storage.Pgx, claim); err != nil {
+		log.Error(ctx, "saving the on-chain proof of the claim", "err", err, "claimID", claim.ID)
+	}
+	return nil
+}
+
+// MarkOffered sets the delivery status of the credentials of the offer to offered, unless they were already fetched.
+// The thread of the offer is kept, so the holder can acknowledge the credentials on it.
+func (c *claim) MarkOffered(ctx context.Context, issuerDID w3c.DID, offer *protocol.CredentialsOfferMessage) error {
+	for _, credential := range offer.Body.Credentials {
+		claimID, err := uuid.Parse(credential.ID)
+		if err != nil {
+			return fmt.Errorf("invalid credential id %s: %w", credential.ID, err)
+		}
+		if _, err := c.icRepo.UpdateDeliveryStatus(ctx, c.storage.Pgx, issuerDID, claimID, domain.CredentialDeliveryOffered, &offer.ThreadID); err != nil {
+			log.Error(ctx, "updating the credential delivery status", "err", err, "claimID", claimID)
+			return err
+		}
+	}
+	return nil
+}
+
+// ack marks as acknowledged the credentials fetched by the holder on the thread of the ack message.
+// Acks without OK status do not change the delivery status.
+func (c *claim) ack(ctx context.Context, req *ports.AgentRequest) error {
+	body := &domain.AckMessageBody{}
+	if len(req.Body) > 0 {
+		if err := json.Unmarshal(req.Body, body); err != nil {
+			log.Error(ctx, "unmarshalling ack body", "err", err)
+			return fmt.Errorf("invalid ack body: %w", err)
+		}
+	}
+	if body.Status != "" && body.Status != domain.AckStatusOK {
+		log.Info(ctx, "credential not acknowledged", "status", body.Status, "thid", req.ThreadID)
+		return nil
+	}
+	if req.ThreadID == "" {
+		return errors.New("invalid ack: the thread id is required")
+	}
+
+	acked, err := c.icRepo.UpdateDeliveryStatusByThread(ctx, c.storage.Pgx, *req.IssuerDID, *req.UserDID, req.ThreadID, domain.CredentialDeliveryAcked)
+	if err != nil {
+		log.Error(ctx, "updating the credentials delivery status", "err", err, "thid", req.ThreadID)
+		return err
+	}
+	log.Info(ctx, "credentials acknowledged", "count", acked, "thid", req.ThreadID)
+	return nil
+}
+
 func (c *claim) createVC(ctx context.Context, claimReq *ports.CreateClaimRequest, vcID uuid.UUID, jsonLdContext string, nonce uint64) (verifiable.W3CCredential, error) {
 	vCredential, err := c.newVerifiableCredential(ctx, claimReq, vcID, jsonLdContext, nonce) // create vc credential
 	if err != nil {
@@ -770,6 +948,19 @@ func (c *claim) guardCreateClaimRequest(req *ports.CreateClaimRequest) error {
 				return ErrUnsupportedDisplayMethodType
 			}
 		},
+		// check on-chain issuance
+		func() error {
+			if !req.IssuanceMode.IsOnchain() {
+				return nil
+			}
+			if c.onchainIssuer == nil || !c.onchainIssuer.IsEnabled(*req.DID) {
+				return domain.ErrOnchainIssuanceNotConfigured
+			}
+			if req.SignatureProof {
+				return ErrOnchainIssuanceSignatureProof
+			}
+			return nil
+		},
 		// check identity
 		func() error {
 			if _, found := req.CredentialSubject["id"]; found {
@@ -819,12 +1010,7 @@ func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.Cre
 
 	credentialSubject["type"] = claimReq.Type
 
-	latestIssuerState, err := c.identitySrv.GetLatestStateByID(ctx, *claimReq.DID)
-	if err != nil {
-		log.Error(ctx, "getting latest issuer state", "err", err)
-		return verifiable.W3CCredential{}, err
-	}
-	cs, err := c.revocationStatusResolver.GetCredentialRevocationStatus(ctx, *claimReq.DID, nonce, *latestIssuerState.State, claimReq.CredentialStatusType)
+	cs, err := c.credentialStatus(ctx, claimReq, nonce)
 	if err != nil {
 		log.Error(ctx, "getting credential status", "err", err)
 		return verifiable.W3CCredential{}, err
@@ -853,6 +1039,20 @@ func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.Cre
 	}, nil
 }
 
+// credentialStatus returns the status of the credential. The status of the credentials issued on chain is
+// resolved by the on-chain identity contract of the issuer.
+func (c *claim) credentialStatus(ctx context.Context, claimReq *ports.CreateClaimRequest, nonce uint64) (*verifiable.CredentialStatus, error) {
+	if claimReq.IssuanceMode.IsOnchain() {
+		return c.onchainIssuer.CredentialStatus(ctx, *claimReq.DID, nonce)
+	}
+	latestIssuerState, err := c.identitySrv.GetLatestStateByID(ctx, *claimReq.DID)
+	if err != nil {
+		log.Error(ctx, "getting latest issuer state", "err", err)
+		return nil, err
+	}
+	return c.revocationStatusResolver.GetCredentialRevocationStatus(ctx, *claimReq.DID, nonce, *latestIssuerState.State, claimReq.CredentialStatusType)
+}
+
 func (c *claim) buildCredentialID(credID uuid.UUID) urn.URN {
 	return urn.FromUUID(credID)
 }
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/notifications"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+var (
+	ErrInvalidConnectionMessage = errors.New("invalid connection message")                    // ErrInvalidConnectionMessage means the message requested for the connection can not be built
+	ErrProofRequestNotFound     = errors.New("the thread has no proof request to the holder") // ErrProofRequestNotFound means the proofs of the holder do not answer a proof request of the issuer
+	ErrProofNotVerified         = errors.New("the proofs cannot be verified")                 // ErrProofNotVerified means the proofs of the holder do not satisfy the proof request
+)
+
+type connectionMessage struct {
+	repository          ports.ConnectionMessageRepository
+	connService         ports.ConnectionService
+	claimService        ports.ClaimService
+	agentMessageService ports.AgentMessageService
+	notificationGateway ports.NotificationGateway
+	identityService     ports.IdentityService
+	mediatypeManager    ports.MediatypeManager
+	verifier            ports.ProofVerifier
+	storage             *db.Storage
+	serverURL           string
+}
+
+// NewConnectionMessage returns the service that sends messages to the connections of the issuers
+func NewConnectionMessage(repository ports.ConnectionMessageRepository, connService ports.ConnectionService, claimService ports.ClaimService, agentMessageService ports.AgentMessageService, notificationGateway ports.NotificationGateway, identityService ports.IdentityService, mediatypeManager ports.MediatypeManager, verifier ports.ProofVerifier, storage *db.Storage, serverURL string) ports.ConnectionMessageService {
+	return &connectionMessage{
+		repository:          repository,
+		connService:         connService,
+		claimService:        claimService,
+		agentMessageService: agentMessageService,
+		notificationGateway: notificationGateway,
+		identityService:     identityService,
+		mediatypeManager:    mediatypeManager,
+		verifier:            verifier,
+		storage:             storage,
+		serverURL:           serverURL,
+	}
+}
+
+// Send sends the message to the push service of the holder of the connection and records the delivery status.
+// The message is saved in its thread of the agent, so the answers of the holder are kept in the same thread.
+// Delivery errors do not fail the request, they are recorded in the status of the message.
+func (c *connectionMessage) Send(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID, req *ports.SendConnectionMessageRequest) (*domain.ConnectionMessage, error) {
+	conn, err := c.connService.GetByIDAndIssuerID(ctx, connID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New()
+	message := &iden3comm.BasicMessage{
+		ID:       id.String(),
+		Typ:      packers.MediaTypePlainMessage,
+		ThreadID: id.String(),
+		From:     issuerDID.String(),
+		To:       conn.UserDID.String(),
+	}
+	if req.ThreadID != nil && *req.ThreadID != "" {
+		if err := c.checkThread(ctx, conn, *req.ThreadID); err != nil {
+			return nil, err
+		}
+		message.ThreadID = *req.ThreadID
+	}
+
+	var offer *protocol.CredentialsOfferMessage
+	switch req.Type {
+	case ports.ConnectionMessageBasic:
+		if req.Content == "" {
+			return nil, fmt.Errorf("%w: the content of the basic message is required", ErrInvalidConnectionMessage)
+		}
+		message.Type = domain.BasicMessageType
+		message.Body, err = json.Marshal(domain.BasicMessageBody{Content: req.Content})
+	case ports.ConnectionMessageCredentialOffer:
+		offer, err = c.offer(ctx, conn, req.CredentialID)
+		if err != nil {
+			return nil, err
+		}
+		offer.ID, offer.ThreadID = message.ID, message.ThreadID
+		message.Type = offer.Type
+		message.Body, err = json.Marshal(offer.Body)
+	case ports.ConnectionMessageProofRequest:
+		if len(req.ProofRequests) == 0 {
+			return nil, fmt.Errorf("%w: at least one proof request is required", ErrInvalidConnectionMessage)
+		}
+		message.Type = protocol.ProofGenerationRequestMessageType
+		message.Body, err = json.Marshal(protocol.ProofGenerationRequestMessageBody{Scope: req.ProofRequests})
+	default:
+		return nil, fmt.Errorf("%w: unknown message type %q", ErrInvalidConnectionMessage, req.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	outbound := domain.NewAgentMessage(domain.AgentMessageOutbound, message.ID, message.ThreadID, issuerDID, message.To, message.Type, message.Typ, message.Body)
+	if err := c.agentMessageService.Send(ctx, outbound); err != nil {
+		return nil, err
+	}
+	if offer != nil {
+		if err := c.claimService.MarkOffered(ctx, issuerDID, offer); err != nil {
+			log.Error(ctx, "marking the credential as offered", "err", err, "thid", offer.ThreadID)
+		}
+	}
+
+	sent := domain.NewConnectionMessage(id, conn, message.ThreadID, message.Type)
+	c.deliver(ctx, conn, raw, sent)
+	if err := c.repository.Save(ctx, c.storage.Pgx, sent); err != nil {
+		log.Error(ctx, "saving connection message", "err", err, "id", sent.ID)
+		return nil, err
+	}
+	return sent, nil
+}
+
+// GetAll returns the messages of the connection, newest first
+func (c *connectionMessage) GetAll(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]*domain.ConnectionMessage, error) {
+	if _, err := c.connService.GetByIDAndIssuerID(ctx, connID, issuerDID); err != nil {
+		return nil, err
+	}
+	return c.repository.GetAll(ctx, c.storage.Pgx, issuerDID, connID)
+}
+
+// Agent verifies the proofs of the holder that answer a proof request sent to the connection. The result is recorded
+// in the messages of the connection, in the thread of the proof request, and the invalid proofs are reported to the holder.
+func (c *connectionMessage) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	if err := c.agent(ctx, req, mediatype); err != nil {
+		return nil, newAgentProblem(req, err)
+	}
+	return nil, nil
+}
+
+func (c *connectionMessage) agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) error {
+	if err := checkAgentRequest(ctx, c.mediatypeManager, c.identityService, req, mediatype); err != nil {
+		return err
+	}
+	if req.Type != protocol.ProofGenerationResponseMessageType {
+		return errors.New("invalid type")
+	}
+	body := &protocol.ResponseMessageBody{}
+	if err := json.Unmarshal(req.Body, body); err != nil {
+		log.Error(ctx, "unmarshalling proof response body", "err", err)
+		return fmt.Errorf("invalid proof response body: %w", err)
+	}
+
+	request, conn, err := c.proofRequest(ctx, *req.IssuerDID, *req.UserDID, req.ThreadID)
+	if err != nil {
+		return err
+	}
+
+	authRequest := protocol.AuthorizationRequestMessage{
+		ID:       request.MessageID,
+		Typ:      request.MediaType,
+		Type:     protocol.AuthorizationRequestMessageType,
+		ThreadID: request.ThreadID,
+		From:     req.IssuerDID.String(),
+		To:       req.UserDID.String(),
+	}
+	if err := json.Unmarshal(request.Body, &authRequest.Body); err != nil {
+		return err
+	}
+	authResponse := protocol.AuthorizationResponseMessage{
+		ID:       req.ClaimID.String(),
+		Typ:      mediatype,
+		Type:     protocol.AuthorizationResponseMessageType,
+		ThreadID: req.ThreadID,
+		Body:     protocol.AuthorizationMessageResponseBody{Scope: body.Scope},
+		From:     req.UserDID.String(),
+		To:       req.IssuerDID.String(),
+	}
+	verifyErr := c.verifier.VerifyAuthResponse(ctx, authResponse, authRequest, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
+
+	received := domain.NewConnectionMessage(req.ClaimID, conn, request.ThreadID, req.Type)
+	received.ProofVerified(verifyErr)
+	if err := c.repository.Save(ctx, c.storage.Pgx, received); err != nil {
+		log.Error(ctx, "saving connection message", "err", err, "id", received.ID)
+		return err
+	}
+	if verifyErr != nil {
+		log.Warn(ctx, "proof response not verified", "err", verifyErr, "thid", request.ThreadID)
+		return fmt.Errorf("%w: %s", ErrProofNotVerified, verifyErr)
+	}
+	return nil
+}
+
+// proofRequest returns the last proof request sent to the holder in the thread and its connection
+func (c *connectionMessage) proofRequest(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, threadID string) (*domain.AgentMessage, *domain.Connection, error) {
+	thread, err := c.agentMessageService.GetThread(ctx, issuerDID, threadID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := len(thread) - 1; i >= 0; i-- {
+		message := thread[i]
+		if message.Direction != domain.AgentMessageOutbound || message.Type != protocol.ProofGenerationRequestMessageType ||
+			message.HolderDID != userDID.String() {
+			continue
+		}
+		messageID, err := uuid.Parse(message.MessageID)
+		if err != nil {
+			continue
+		}
+		sent, err := c.repository.GetByID(ctx, c.storage.Pgx, issuerDID, messageID)
+		if errors.Is(err, repositories.ErrConnectionMessageNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		conn, err := c.connService.GetByIDAndIssuerID(ctx, sent.ConnectionID, issuerDID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return message, conn, nil
+	}
+	return nil, nil, ErrProofRequestNotFound
+}
+
+// checkThread returns an error unless the thread exists and all its messages are between the issuer and the holder of the connection
+func (c *connectionMessage) checkThread(ctx context.Context, conn *domain.Connection, threadID string) error {
+	thread, err := c.agentMessageService.GetThread(ctx, conn.IssuerDID, threadID)
+	if err != nil {
+		return err
+	}
+	if len(thread) == 0 {
+		return fmt.Errorf("%w: the thread does not exist", ErrInvalidConnectionMessage)
+	}
+	for _, message := range thread {
+		if message.HolderDID != conn.UserDID.String() {
+			return fmt.Errorf("%w: the thread is not of the connection", ErrInvalidConnectionMessage)
+		}
+	}
+	return nil
+}
+
+// offer returns the offer of a credential of the holder of the connection. Revoked credentials can not be offered.
+func (c *connectionMessage) offer(ctx context.Context, conn *domain.Connection, credentialID uuid.UUID) (*protocol.CredentialsOfferMessage, error) {
+	credential, err := c.claimService.GetByID(ctx, &conn.IssuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if credential.OtherIdentifier != conn.UserDID.String() {
+		return nil, fmt.Errorf("%w: the credential was not issued to the holder of the connection", ErrInvalidConnectionMessage)
+	}
+	if credential.Revoked {
+		return nil, fmt.Errorf("%w: the credential is revoked", ErrInvalidConnectionMessage)
+	}
+	if !credential.ValidProof() {
+		return nil, ErrEmptyMTPProof
+	}
+	return notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, c.serverURL), credential)
+}
+
+// deliver sends the message to the push service of the holder and records the result in the message
+func (c *connectionMessage) deliver(ctx context.Context, conn *domain.Connection, raw []byte, message *domain.ConnectionMessage) {
+	var userDoc verifiable.DIDDocument
+	if err := json.Unmarshal(conn.UserDoc, &userDoc); err != nil {
+		message.Failed(fmt.Errorf("invalid did document of the holder: %w", err))
+		return
+	}
+	result, err := c.notificationGateway.Notify(ctx, raw, userDoc)
+	if err != nil {
+		log.Warn(ctx, "sending connection message", "err", err, "id", message.ID, "connID", conn.ID)
+		message.Failed(err)
+		return
+	}
+	message.Delivered(result)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -35,8 +36,15 @@ func NewConnection(connRepo ports.ConnectionRepository, claimsRepo ports.ClaimRe
 
 func (c *connection) Create(ctx context.Context, connection *domain.Connection) error {
 	return c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
-		_, err := c.connRepo.Save(ctx, c.storage.Pgx, connection)
-		return err
+		previous, err := c.connRepo.GetByUserID(ctx, tx, connection.IssuerDID, connection.UserDID)
+		if err != nil && !errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return err
+		}
+		connID, err := c.connRepo.Save(ctx, tx, connection)
+		if err != nil {
+			return err
+		}
+		return c.connRepo.SaveEvents(ctx, tx, domain.NewConnectionEvents(previous, connection, connID, nil))
 	})
 }
 
@@ -109,6 +117,42 @@ func (c *connection) GetAllByIssuerID(ctx context.Context, issuerDID w3c.DID, fi
 	return conns, count, err
 }
 
+// Update changes the metadata and tags of the connection
+func (c *connection) Update(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *ports.UpdateConnectionRequest) (*domain.Connection, error) {
+	conn, err := c.GetByIDAndIssuerID(ctx, id, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Metadata != nil {
+		if err := conn.SetMetadata(*request.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	if request.Tags != nil {
+		if err := conn.SetTags(*request.Tags); err != nil {
+			return nil, err
+		}
+	}
+	conn.ModifiedAt = time.Now()
+
+	if err := c.connRepo.UpdateMetadata(ctx, c.storage.Pgx, conn); err != nil {
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, ErrConnectionDoesNotExist
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// GetHistory returns the history of the connection, newest first, and the total number of events matching the request
+func (c *connection) GetHistory(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, request *ports.GetConnectionHistoryRequest) ([]*domain.ConnectionEvent, uint, error) {
+	if _, err := c.GetByIDAndIssuerID(ctx, id, issuerDID); err != nil {
+		return nil, 0, err
+	}
+	return c.connRepo.GetEvents(ctx, c.storage.Pgx, id, request)
+}
+
 func (c *connection) delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID, pgx db.Querier) error {
 	err := c.connRepo.Delete(ctx, pgx, id, issuerDID)
 	if err != nil {
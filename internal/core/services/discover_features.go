@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2/packers"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+// agentProofTypes are the proof types of the issued credentials
+var agentProofTypes = []verifiable.ProofType{
+	verifiable.BJJSignatureProofType,
+	verifiable.Iden3SparseMerkleTreeProofType,
+}
+
+// discoverFeatures answers the discover-features queries with the features of the agent that match them
+func (c *claim) discoverFeatures(ctx context.Context, req *ports.AgentRequest) (*domain.Agent, error) {
+	queries := &domain.DiscoverFeatureQueriesMessageBody{}
+	if err := json.Unmarshal(req.Body, queries); err != nil {
+		log.Error(ctx, "unmarshalling discover features queries", "err", err)
+		return nil, fmt.Errorf("invalid discover features queries body: %w", err)
+	}
+
+	disclosures := make([]domain.DiscoverFeatureDisclosure, 0)
+	for _, query := range queries.Queries {
+		for _, feature := range c.features(req.IssuerDID, query.FeatureType) {
+			if matchFeature(query.Match, feature) {
+				disclosures = append(disclosures, domain.DiscoverFeatureDisclosure{FeatureType: query.FeatureType, ID: feature})
+			}
+		}
+	}
+
+	return &domain.Agent{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     domain.DiscoverFeatureDiscloseMessageType,
+		ThreadID: req.ThreadID,
+		Body:     domain.DiscoverFeatureDiscloseMessageBody{Disclosures: disclosures},
+		From:     req.IssuerDID.String(),
+		To:       req.UserDID.String(),
+	}, nil
+}
+
+// features returns the ids of the features of the given type.
+// The accepted media types are the ones allowed by the media type manager to the issuer for any of the agent protocols.
+func (c *claim) features(issuerDID *w3c.DID, featureType domain.DiscoverFeatureType) []string {
+	var features []string
+	switch featureType {
+	case domain.DiscoverFeatureTypeProtocol:
+		for _, message := range domain.AgentProtocols {
+			features = append(features, string(message))
+		}
+	case domain.DiscoverFeatureTypeAccept:
+		for _, mediaType := range domain.AgentMediaTypes {
+			for _, message := range domain.AgentProtocols {
+				if c.mediatypeManager.AllowMediaType(issuerDID, message, mediaType) {
+					features = append(features, string(mediaType))
+					break
+				}
+			}
+		}
+	case domain.DiscoverFeatureTypeProofType:
+		for _, proofType := range agentProofTypes {
+			features = append(features, string(proofType))
+		}
+	case domain.DiscoverFeatureTypeCredentialStatusType:
+		for _, statusType := range c.revocationStatusResolver.CredentialStatusTypes() {
+			features = append(features, string(statusType))
+		}
+	}
+	return features
+}
+
+// matchFeature checks the feature id against the query match. An empty match or * matches every feature.
+func matchFeature(match, feature string) bool {
+	if prefix, ok := strings.CutSuffix(match, "*"); ok {
+		return strings.HasPrefix(feature, prefix)
+	}
+	return match == "" || match == feature
+}
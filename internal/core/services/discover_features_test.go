@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/network"
+	"github.com/wakeup-labs/issuer-node/internal/revocationstatus"
+)
+
+func TestMatchFeature(t *testing.T) {
+	const feature = "https://iden3-communication.io/credentials/1.0/fetch-request"
+	type testConfig struct {
+		name     string
+		match    string
+		expected bool
+	}
+	for _, tc := range []testConfig{
+		{name: "empty match", match: "", expected: true},
+		{name: "wildcard", match: "*", expected: true},
+		{name: "same id", match: feature, expected: true},
+		{name: "prefix", match: "https://iden3-communication.io/credentials/*", expected: true},
+		{name: "other prefix", match: "https://didcomm.org/*", expected: false},
+		{name: "other id", match: "https://iden3-communication.io/credentials/1.0/offer", expected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, matchFeature(tc.match, feature))
+		})
+	}
+}
+
+func TestClaim_discoverFeatures(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	zkpOnlyDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFjTM4kX3J6AYzHBY1Q3ztnxv1UfNaaNUGw8TKo4N")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq")
+	require.NoError(t, err)
+
+	// the second issuer only accepts ZKP messages for every agent protocol
+	policy := domain.DefaultMediaTypePolicy()
+	zkpOnly := domain.MediaTypeAllowList{}
+	for _, message := range domain.AgentProtocols {
+		zkpOnly[message] = []string{string(packers.MediaTypeZKPMessage)}
+	}
+	policy.Identities[zkpOnlyDID.String()] = zkpOnly
+
+	service := &claim{
+		mediatypeManager:         NewMediaTypeManagerWithPolicy(policy, true),
+		revocationStatusResolver: revocationstatus.NewRevocationStatusResolver(network.Resolver{}),
+	}
+
+	type testConfig struct {
+		name      string
+		issuerDID *w3c.DID
+		queries   []domain.DiscoverFeatureQuery
+		expected  []domain.DiscoverFeatureDisclosure
+	}
+	for _, tc := range []testConfig{
+		{
+			name:      "media types allowed by the default policy",
+			issuerDID: issuerDID,
+			queries:   []domain.DiscoverFeatureQuery{{FeatureType: domain.DiscoverFeatureTypeAccept}},
+			expected: []domain.DiscoverFeatureDisclosure{
+				{FeatureType: domain.DiscoverFeatureTypeAccept, ID: string(packers.MediaTypePlainMessage)},
+				{FeatureType: domain.DiscoverFeatureTypeAccept, ID: string(packers.MediaTypeSignedMessage)},
+				{FeatureType: domain.DiscoverFeatureTypeAccept, ID: string(packers.MediaTypeZKPMessage)},
+			},
+		},
+		{
+			name:      "media types allowed by the policy of the issuer",
+			issuerDID: zkpOnlyDID,
+			queries:   []domain.DiscoverFeatureQuery{{FeatureType: domain.DiscoverFeatureTypeAccept, Match: "*"}},
+			expected: []domain.DiscoverFeatureDisclosure{
+				{FeatureType: domain.DiscoverFeatureTypeAccept, ID: string(packers.MediaTypeZKPMessage)},
+			},
+		},
+		{
+			name:      "media type not allowed by the policy of the issuer",
+			issuerDID: zkpOnlyDID,
+			queries:   []domain.DiscoverFeatureQuery{{FeatureType: domain.DiscoverFeatureTypeAccept, Match: string(packers.MediaTypeSignedMessage)}},
+			expected:  []domain.DiscoverFeatureDisclosure{},
+		},
+		{
+			name:      "protocols and credential status types",
+			issuerDID: issuerDID,
+			queries: []domain.DiscoverFeatureQuery{
+				{FeatureType: domain.DiscoverFeatureTypeProtocol, Match: "https://iden3-communication.io/revocation/*"},
+				{FeatureType: domain.DiscoverFeatureTypeCredentialStatusType, Match: "Iden3commRevocationStatusV1.0"},
+				{FeatureType: "unknown"},
+			},
+			expected: []domain.DiscoverFeatureDisclosure{
+				{FeatureType: domain.DiscoverFeatureTypeProtocol, ID: string(protocol.RevocationStatusRequestMessageType)},
+				{FeatureType: domain.DiscoverFeatureTypeCredentialStatusType, ID: "Iden3commRevocationStatusV1.0"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(domain.DiscoverFeatureQueriesMessageBody{Queries: tc.queries})
+			require.NoError(t, err)
+			req := &ports.AgentRequest{
+				Body:      body,
+				ThreadID:  "f3ca7cd4-5f8a-4ad5-9a3a-2d8c4bb5bd6e",
+				IssuerDID: tc.issuerDID,
+				UserDID:   userDID,
+				Typ:       packers.MediaTypePlainMessage,
+				Type:      domain.DiscoverFeatureQueriesMessageType,
+			}
+			agent, err := service.discoverFeatures(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, domain.DiscoverFeatureDiscloseMessageType, agent.Type)
+			assert.Equal(t, iden3comm.MediaType(packers.MediaTypePlainMessage), agent.Typ)
+			assert.Equal(t, req.ThreadID, agent.ThreadID)
+			assert.Equal(t, tc.issuerDID.String(), agent.From)
+			assert.Equal(t, userDID.String(), agent.To)
+			assert.Equal(t, domain.DiscoverFeatureDiscloseMessageBody{Disclosures: tc.expected}, agent.Body)
+		})
+	}
+
+	_, err = service.discoverFeatures(ctx, &ports.AgentRequest{Body: json.RawMessage(`{"queries": "all"}`), IssuerDID: issuerDID, UserDID: userDID})
+	assert.ErrorContains(t, err, "invalid discover features queries body")
+}
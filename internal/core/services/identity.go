@@ -518,7 +518,12 @@ func (i *identity) AuthenticateWithRequest(ctx context.Context, sessionID *uuid.
 	}
 	var connID uuid.UUID
 	if err := i.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
-		connID, err = i.connectionsRepository.Save(ctx, i.storage.Pgx, conn)
+		previous, err := i.connectionsRepository.GetByUserID(ctx, tx, *issuerDID, *userDID)
+		if err != nil && !errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return err
+		}
+
+		connID, err = i.connectionsRepository.Save(ctx, tx, conn)
 		if err != nil {
 			return err
 		}
@@ -526,7 +531,10 @@ func (i *identity) AuthenticateWithRequest(ctx context.Context, sessionID *uuid.
 		if sessionID == nil {
 			sessionID = common.ToPointer(uuid.New())
 		}
-		return i.connectionsRepository.SaveUserAuthentication(ctx, i.storage.Pgx, connID, *sessionID, conn.CreatedAt)
+		if err := i.connectionsRepository.SaveUserAuthentication(ctx, tx, connID, *sessionID, conn.CreatedAt); err != nil {
+			return err
+		}
+		return i.connectionsRepository.SaveEvents(ctx, tx, domain.NewConnectionEvents(previous, conn, connID, sessionID))
 	}); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	client "github.com/wakeup-labs/issuer-node/internal/http"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+const (
+	noIssuanceApproverReason = "the issuer does not accept issuance requests for this schema"
+	// issuanceWebhookSignatureHeader is the header with the signature of the body of the webhook requests
+	issuanceWebhookSignatureHeader = "X-Issuer-Signature"
+)
+
+type issuanceApprover struct {
+	cfg     config.IssuanceRequests
+	webhook *client.Client
+}
+
+// NewIssuanceApprover returns the approval hook of the issuance requests.
+// Requests of the auto approved schemas are approved, the rest are decided by the webhook if it is configured
+// and rejected otherwise. The webhook requests are signed with the configured secret.
+func NewIssuanceApprover(cfg config.IssuanceRequests) ports.IssuanceApprover {
+	approver := &issuanceApprover{cfg: cfg}
+	if cfg.WebhookURL != "" {
+		approver.webhook = client.NewClient(http.Client{Timeout: cfg.WebhookTimeout})
+	}
+	return approver
+}
+
+// Decide implements ports.IssuanceApprover
+func (a *issuanceApprover) Decide(ctx context.Context, req *domain.IssuanceRequest) (*domain.IssuanceDecision, error) {
+	if slices.Contains(a.cfg.AutoApproveSchemas, "*") || slices.Contains(a.cfg.AutoApproveSchemas, req.SchemaURL) {
+		return &domain.IssuanceDecision{Approved: true}, nil
+	}
+	if a.webhook == nil {
+		return &domain.IssuanceDecision{Approved: false, Reason: noIssuanceApproverReason}, nil
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{issuanceWebhookSignatureHeader: signIssuanceWebhook(a.cfg.WebhookSecret, payload)}
+	res, err := a.webhook.PostWithHeaders(ctx, a.cfg.WebhookURL, payload, headers)
+	if err != nil {
+		log.Error(ctx, "calling the issuance request webhook", "err", err, "thid", req.ThreadID)
+		return nil, err
+	}
+	decision := &domain.IssuanceDecision{}
+	if err := json.Unmarshal(res, decision); err != nil {
+		log.Error(ctx, "unmarshalling the issuance request webhook response", "err", err, "thid", req.ThreadID)
+		return nil, err
+	}
+	return decision, nil
+}
+
+// signIssuanceWebhook returns the signature of the webhook request body, sha256=<hex encoded HMAC-SHA256 keyed by the secret>
+func signIssuanceWebhook(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
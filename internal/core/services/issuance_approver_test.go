@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+func TestIssuanceApprover_Decide(t *testing.T) {
+	const (
+		approvedSchema = "https://example.com/schemas/approved.json"
+		webhookSchema  = "https://example.com/schemas/webhook.json"
+		secret         = "webhook secret"
+	)
+	ctx := context.Background()
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.Header.Get(issuanceWebhookSignatureHeader) != signIssuanceWebhook(secret, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		req := &domain.IssuanceRequest{}
+		require.NoError(t, json.Unmarshal(body, req))
+		decision := domain.IssuanceDecision{Approved: req.SchemaURL == webhookSchema}
+		if !decision.Approved {
+			decision.Reason = "unknown schema"
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(decision))
+	}))
+	defer webhook.Close()
+
+	type testConfig struct {
+		name     string
+		cfg      config.IssuanceRequests
+		schema   string
+		approved bool
+		reason   string
+		err      string
+	}
+	for _, tc := range []testConfig{
+		{
+			name:     "auto approved schema",
+			cfg:      config.IssuanceRequests{AutoApproveSchemas: []string{approvedSchema}},
+			schema:   approvedSchema,
+			approved: true,
+		},
+		{
+			name:     "all schemas auto approved",
+			cfg:      config.IssuanceRequests{AutoApproveSchemas: []string{"*"}},
+			schema:   webhookSchema,
+			approved: true,
+		},
+		{
+			name:   "no webhook",
+			cfg:    config.IssuanceRequests{AutoApproveSchemas: []string{approvedSchema}},
+			schema: webhookSchema,
+			reason: noIssuanceApproverReason,
+		},
+		{
+			name:     "approved by the webhook",
+			cfg:      config.IssuanceRequests{WebhookURL: webhook.URL, WebhookSecret: secret, WebhookTimeout: time.Second},
+			schema:   webhookSchema,
+			approved: true,
+		},
+		{
+			name:   "webhook with another secret",
+			cfg:    config.IssuanceRequests{WebhookURL: webhook.URL, WebhookSecret: "other secret", WebhookTimeout: time.Second},
+			schema: webhookSchema,
+			err:    "http request failed with status 401",
+		},
+		{
+			name:   "rejected by the webhook",
+			cfg:    config.IssuanceRequests{WebhookURL: webhook.URL, WebhookSecret: secret, WebhookTimeout: time.Second},
+			schema: approvedSchema,
+			reason: "unknown schema",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			decision, err := NewIssuanceApprover(tc.cfg).Decide(ctx, &domain.IssuanceRequest{SchemaURL: tc.schema})
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.approved, decision.Approved)
+			assert.Equal(t, tc.reason, decision.Reason)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/notifications"
+)
+
+// ErrIssuanceRequestRejected means the approval hook did not approve the credential requested by the holder
+var ErrIssuanceRequestRejected = errors.New("the credential issuance request was rejected")
+
+type issuanceRequest struct {
+	claimService     ports.ClaimService
+	identityService  ports.IdentityService
+	connService      ports.ConnectionService
+	approver         ports.IssuanceApprover
+	mediatypeManager ports.MediatypeManager
+	serverURL        string
+}
+
+// NewIssuanceRequest returns the service that handles the credential issuance requests of the holders
+func NewIssuanceRequest(claimService ports.ClaimService, identityService ports.IdentityService, connService ports.ConnectionService, approver ports.IssuanceApprover, mediatypeManager ports.MediatypeManager, serverURL string) ports.IssuanceRequestService {
+	return &issuanceRequest{
+		claimService:     claimService,
+		identityService:  identityService,
+		connService:      connService,
+		approver:         approver,
+		mediatypeManager: mediatypeManager,
+		serverURL:        serverURL,
+	}
+}
+
+// Agent issues the credential requested by the holder if the approval hook approves it and answers with
+// an offer on the same thread. The accepted media types are those of the media type policy, which only accepts ZKP
+// packed requests by default so the holder is authenticated.
+func (i *issuanceRequest) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	agent, err := i.agent(ctx, req, mediatype)
+	if err != nil {
+		return nil, newAgentProblem(req, err)
+	}
+	return agent, nil
+}
+
+func (i *issuanceRequest) agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	if err := checkAgentRequest(ctx, i.mediatypeManager, i.identityService, req, mediatype); err != nil {
+		return nil, err
+	}
+	if req.Type != protocol.CredentialIssuanceRequestMessageType {
+		return nil, errors.New("invalid type")
+	}
+
+	issuanceReq, err := i.issuanceRequest(req)
+	if err != nil {
+		log.Error(ctx, "invalid credential issuance request", "err", err)
+		return nil, err
+	}
+
+	conn, err := i.connService.GetByUserID(ctx, *req.IssuerDID, *req.UserDID)
+	if err != nil && !errors.Is(err, ErrConnectionDoesNotExist) {
+		log.Error(ctx, "loading the connection of the holder", "err", err, "thid", issuanceReq.ThreadID)
+		return nil, errors.New("the credential issuance request cannot be approved now")
+	}
+	if conn != nil {
+		issuanceReq.Connection = &domain.IssuanceRequestConnection{
+			ID:       conn.ID.String(),
+			Metadata: conn.Metadata,
+			Tags:     conn.Tags,
+		}
+	}
+
+	decision, err := i.approver.Decide(ctx, issuanceReq)
+	if err != nil {
+		return nil, errors.New("the credential issuance request cannot be approved now")
+	}
+	if !decision.Approved {
+		log.Info(ctx, "credential issuance request rejected", "thid", issuanceReq.ThreadID, "reason", decision.Reason)
+		return nil, fmt.Errorf("%w: %s", ErrIssuanceRequestRejected, decision.Reason)
+	}
+
+	identity, err := i.identityService.GetByDID(ctx, *req.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading issuer identity", "err", err, "issuerDID", req.IssuerDID)
+		return nil, err
+	}
+
+	var expiration *time.Time
+	if issuanceReq.Expiration != nil {
+		expiration = common.ToPointer(time.Unix(*issuanceReq.Expiration, 0))
+	}
+	claimReq := ports.NewCreateClaimRequest(req.IssuerDID,
+		nil,
+		issuanceReq.SchemaURL,
+		issuanceReq.Data,
+		expiration,
+		issuanceReq.SchemaType,
+		nil, nil, nil,
+		ports.ClaimRequestProofs{BJJSignatureProof2021: true},
+		nil,
+		false,
+		verifiable.CredentialStatusType(identity.AuthCoreClaimRevocationStatus.Type),
+		nil,
+		nil,
+		nil,
+	)
+	credential, err := i.claimService.Save(ctx, claimReq)
+	if err != nil {
+		log.Error(ctx, "issuing the requested credential", "err", err, "thid", issuanceReq.ThreadID)
+		return nil, err
+	}
+
+	offer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, i.serverURL), credential)
+	if err != nil {
+		return nil, err
+	}
+	if req.ThreadID != "" {
+		offer.ThreadID = req.ThreadID
+	}
+	if err := i.claimService.MarkOffered(ctx, *req.IssuerDID, offer); err != nil {
+		log.Error(ctx, "marking the requested credential as offered", "err", err, "thid", offer.ThreadID)
+	}
+	return &domain.Agent{
+		ID:       offer.ID,
+		Typ:      offer.Typ,
+		Type:     offer.Type,
+		ThreadID: req.ThreadID,
+		Body:     offer.Body,
+		From:     offer.From,
+		To:       offer.To,
+	}, nil
+}
+
+// issuanceRequest returns the issuance request of the message. The subject of the credential is always the holder.
+func (i *issuanceRequest) issuanceRequest(req *ports.AgentRequest) (*domain.IssuanceRequest, error) {
+	body := &protocol.CredentialIssuanceRequestMessageBody{}
+	if err := json.Unmarshal(req.Body, body); err != nil {
+		return nil, fmt.Errorf("invalid credential issuance request body: %w", err)
+	}
+	if body.Schema.URL == "" || body.Schema.Type == "" {
+		return nil, errors.New("invalid credential issuance request body: the schema url and type are required")
+	}
+
+	data := make(map[string]any)
+	if len(body.Data) > 0 {
+		if err := json.Unmarshal(body.Data, &data); err != nil {
+			return nil, fmt.Errorf("invalid credential issuance request data: %w", err)
+		}
+	}
+	data["id"] = req.UserDID.String()
+
+	issuanceReq := &domain.IssuanceRequest{
+		ThreadID:   req.ThreadID,
+		IssuerDID:  req.IssuerDID.String(),
+		HolderDID:  req.UserDID.String(),
+		SchemaURL:  body.Schema.URL,
+		SchemaType: body.Schema.Type,
+		Data:       data,
+	}
+	if body.Expiration != 0 {
+		issuanceReq.Expiration = &body.Expiration
+	}
+	return issuanceReq, nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+)
+
+// issuanceRequestClaims records the credentials issued by the issuance requests, any other method panics
+type issuanceRequestClaims struct {
+	ports.ClaimService
+	saved   []*ports.CreateClaimRequest
+	offered []*protocol.CredentialsOfferMessage
+}
+
+func (c *issuanceRequestClaims) Save(_ context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
+	c.saved = append(c.saved, req)
+	return &domain.Claim{ID: uuid.New(), Issuer: req.DID.String(), OtherIdentifier: req.CredentialSubject["id"].(string), SchemaType: req.Type}, nil
+}
+
+func (c *issuanceRequestClaims) MarkOffered(_ context.Context, _ w3c.DID, offer *protocol.CredentialsOfferMessage) error {
+	c.offered = append(c.offered, offer)
+	return nil
+}
+
+type issuanceRequestIdentities struct {
+	ports.IdentityService
+}
+
+func (i *issuanceRequestIdentities) Exists(_ context.Context, _ w3c.DID) (bool, error) {
+	return true, nil
+}
+
+func (i *issuanceRequestIdentities) GetByDID(_ context.Context, did w3c.DID) (*domain.Identity, error) {
+	return &domain.Identity{Identifier: did.String(), AuthCoreClaimRevocationStatus: domain.AuthCoreClaimRevocationStatus{Type: "Iden3commRevocationStatusV1.0"}}, nil
+}
+
+type issuanceRequestConnections struct {
+	ports.ConnectionService
+}
+
+func (c *issuanceRequestConnections) GetByUserID(_ context.Context, _ w3c.DID, _ w3c.DID) (*domain.Connection, error) {
+	return nil, ErrConnectionDoesNotExist
+}
+
+func TestIssuanceRequest_Agent(t *testing.T) {
+	const (
+		secret         = "webhook secret"
+		approvedSchema = "https://example.com/schemas/approved.json"
+		rejectedSchema = "https://example.com/schemas/rejected.json"
+		failingSchema  = "https://example.com/schemas/failing.json"
+	)
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq")
+	require.NoError(t, err)
+
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.Header.Get(issuanceWebhookSignatureHeader) != signIssuanceWebhook(secret, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		req := &domain.IssuanceRequest{}
+		require.NoError(t, json.Unmarshal(body, req))
+		switch req.SchemaURL {
+		case approvedSchema:
+			require.NoError(t, json.NewEncoder(w).Encode(domain.IssuanceDecision{Approved: true}))
+		case rejectedSchema:
+			require.NoError(t, json.NewEncoder(w).Encode(domain.IssuanceDecision{Reason: "not eligible"}))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer webhook.Close()
+
+	claims := &issuanceRequestClaims{}
+	approver := NewIssuanceApprover(config.IssuanceRequests{WebhookURL: webhook.URL, WebhookSecret: secret, WebhookTimeout: time.Second})
+	service := NewIssuanceRequest(claims, &issuanceRequestIdentities{}, &issuanceRequestConnections{}, approver, NewMediaTypeManagerWithPolicy(domain.DefaultMediaTypePolicy(), true), "https://issuer.example.com")
+
+	newRequest := func(schema string) *ports.AgentRequest {
+		body, err := json.Marshal(protocol.CredentialIssuanceRequestMessageBody{
+			Schema: protocol.Schema{URL: schema, Type: "KYCAgeCredential"},
+			Data:   json.RawMessage(`{"birthday": 19960424}`),
+		})
+		require.NoError(t, err)
+		return &ports.AgentRequest{
+			Body:      body,
+			ThreadID:  uuid.NewString(),
+			IssuerDID: issuerDID,
+			UserDID:   userDID,
+			ClaimID:   uuid.New(),
+			Typ:       packers.MediaTypeZKPMessage,
+			Type:      protocol.CredentialIssuanceRequestMessageType,
+		}
+	}
+
+	t.Run("approved", func(t *testing.T) {
+		req := newRequest(approvedSchema)
+		agent, err := service.Agent(ctx, req, packers.MediaTypeZKPMessage)
+		require.NoError(t, err)
+		assert.Equal(t, protocol.CredentialOfferMessageType, agent.Type)
+		assert.Equal(t, req.ThreadID, agent.ThreadID)
+		require.Len(t, claims.saved, 1)
+		assert.Equal(t, approvedSchema, claims.saved[0].Schema)
+		assert.Equal(t, userDID.String(), claims.saved[0].CredentialSubject["id"])
+		require.Len(t, claims.offered, 1)
+		assert.Equal(t, req.ThreadID, claims.offered[0].ThreadID)
+	})
+
+	for _, tc := range []struct {
+		name      string
+		schema    string
+		mediatype iden3comm.MediaType
+		err       string
+	}{
+		{name: "rejected", schema: rejectedSchema, err: "the credential issuance request was rejected: not eligible"},
+		{name: "webhook failure", schema: failingSchema, err: "the credential issuance request cannot be approved now"},
+		{name: "not allowed media type", schema: approvedSchema, mediatype: packers.MediaTypeSignedMessage, err: "unsupported media type"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mediatype := packers.MediaTypeZKPMessage
+			if tc.mediatype != "" {
+				mediatype = tc.mediatype
+			}
+			req := newRequest(tc.schema)
+			_, err := service.Agent(ctx, req, mediatype)
+			problem := &ProblemReportError{}
+			require.ErrorAs(t, err, &problem)
+			assert.ErrorContains(t, problem.Err, tc.err)
+			assert.Equal(t, req.ThreadID, problem.ThreadID)
+		})
+	}
+	assert.Len(t, claims.saved, 1)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -41,6 +42,24 @@ var (
 	ErrLinkMaxExceeded = errors.New("cannot issue a credential for an expired link")
 	// ErrLinkInactive - link inactive
 	ErrLinkInactive = errors.New("cannot issue a credential for an inactive link")
+	// ErrLinkHolderNotAllowed - the holder is not on the allowlist of the link
+	ErrLinkHolderNotAllowed = errors.New("the holder is not on the allowlist of the link")
+	// ErrLinkAllowlistKeyNotFound - the email hash or code is not on the allowlist of the link
+	ErrLinkAllowlistKeyNotFound = errors.New("the key is not on the allowlist of the link")
+	// ErrLinkAllowlistEntryClaimed - the credential of the allowlist entry has already been issued
+	ErrLinkAllowlistEntryClaimed = errors.New("the credential of the allowlist entry has already been issued")
+	// ErrLinkInviteRequired - the link only issues credentials to the holders with an invite code
+	ErrLinkInviteRequired = errors.New("the link requires an invite code")
+	// ErrLinkInviteInvalid - the invite code is not of the link, is revoked or has no uses left
+	ErrLinkInviteInvalid = errors.New("the invite code is not valid, revoked or already used")
+	// ErrLinkInviteNotFound - the invite is not of the link or is already revoked
+	ErrLinkInviteNotFound = errors.New("link invite not found")
+	// ErrInvalidLinkUpdate - the update of the link is not valid
+	ErrInvalidLinkUpdate = errors.New("invalid link update")
+	// ErrLinkProofRequired - the link derives attributes from a proof that the holder has not sent
+	ErrLinkProofRequired = errors.New("the link requires a proof of the holder, scan the link again")
+	// ErrLinkCloneExpired - the expiration date of the link has passed, so it cannot be copied to a new link
+	ErrLinkCloneExpired = errors.New("cannot clone an expired link")
 )
 
 // Link - represents a link in the issuer node
@@ -52,6 +71,7 @@ type Link struct {
 	claimRepository  ports.ClaimRepository
 	linkRepository   ports.LinkRepository
 	schemaRepository ports.SchemaRepository
+	paymentRepo      ports.PaymentRepository
 	loader           loader.DocumentLoader
 	sessionManager   ports.SessionRepository
 	publisher        pubsub.Publisher
@@ -60,7 +80,7 @@ type Link struct {
 }
 
 // NewLinkService - constructor
-func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrService ports.QrStoreService, claimRepository ports.ClaimRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, identityService ports.IdentityService, networkResolver network.Resolver, cfg config.UniversalLinks) ports.LinkService {
+func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrService ports.QrStoreService, claimRepository ports.ClaimRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, paymentRepo ports.PaymentRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, identityService ports.IdentityService, networkResolver network.Resolver, cfg config.UniversalLinks) ports.LinkService {
 	return &Link{
 		storage:          storage,
 		claimsService:    claimsService,
@@ -68,6 +88,7 @@ func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrSer
 		claimRepository:  claimRepository,
 		linkRepository:   linkRepository,
 		schemaRepository: schemaRepository,
+		paymentRepo:      paymentRepo,
 		loader:           ld,
 		sessionManager:   sessionManager,
 		publisher:        publisher,
@@ -90,36 +111,155 @@ func (ls *Link) Save(
 	credentialSubject domain.CredentialSubject,
 	refreshService *verifiable.RefreshService,
 	displayMethod *verifiable.DisplayMethod,
+	derivation *domain.LinkDerivation,
+	access ports.LinkAccess,
 ) (*domain.Link, error) {
 	schemaDB, err := ls.schemaRepository.GetByID(ctx, did, schemaID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := ls.validateCredentialSubjectAgainstSchema(ctx, credentialSubject, schemaDB); err != nil {
-		log.Error(ctx, "validating credential subject", "err", err, "subject", credentialSubject, "schema-id", schemaDB.ID, "schema-type", schemaDB.Type)
-		return nil, ErrInvalidCredentialSubject
-	}
-	if err = ls.validateRefreshService(refreshService, credentialExpiration); err != nil {
-		log.Error(ctx, "validating refresh service", "err", err)
+	link := domain.NewLink(did, maxIssuance, validUntil, schemaID, credentialExpiration, credentialSignatureProof, credentialMTPProof, credentialSubject, refreshService, displayMethod, derivation)
+	link.Allowlist = access.Allowlist
+	link.InviteOnly = access.InviteOnly
+	if err := ls.validateLinkCredential(ctx, link, schemaDB); err != nil {
 		return nil, err
 	}
-	if err = ls.validateDisplayMethod(displayMethod); err != nil {
-		log.Error(ctx, "validating display method", "err", err)
+	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
+	if err != nil {
 		return nil, err
 	}
 
-	link := domain.NewLink(did, maxIssuance, validUntil, schemaID, credentialExpiration, credentialSignatureProof, credentialMTPProof, credentialSubject, refreshService, displayMethod)
-	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
+	link.Schema = schemaDB
+
+	return link, nil
+}
+
+// Update - changes the mutable fields of a link. The authorization request of the link is kept, so the QR codes
+// already shared keep working. Active links must remain valid to issue credentials after the update.
+func (ls *Link) Update(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, update ports.LinkUpdate) (*domain.Link, error) {
+	if update.IsActivation() {
+		if err := ls.Activate(ctx, issuerDID, linkID, *update.Active); err != nil {
+			return nil, err
+		}
+		return ls.linkRepository.GetByID(ctx, issuerDID, linkID)
+	}
+
+	link, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
 	if err != nil {
 		return nil, err
 	}
 
-	link.Schema = schemaDB
+	for _, field := range update.Unset {
+		switch field {
+		case ports.LinkFieldValidUntil:
+			link.ValidUntil = nil
+		case ports.LinkFieldMaxIssuance:
+			link.MaxIssuance = nil
+		case ports.LinkFieldCredentialExpiration:
+			link.CredentialExpiration = nil
+		case ports.LinkFieldRefreshService:
+			link.RefreshService = nil
+		case ports.LinkFieldDisplayMethod:
+			link.DisplayMethod = nil
+		default:
+			return nil, fmt.Errorf("%w: %s cannot be unset", ErrInvalidLinkUpdate, field)
+		}
+	}
+	if update.Active != nil {
+		link.Active = *update.Active
+	}
+	if update.ValidUntil != nil {
+		link.ValidUntil = update.ValidUntil
+	}
+	if update.MaxIssuance != nil {
+		if *update.MaxIssuance <= 0 {
+			return nil, fmt.Errorf("%w: limitedClaims must be higher than 0", ErrInvalidLinkUpdate)
+		}
+		link.MaxIssuance = update.MaxIssuance
+	}
+	if update.CredentialExpiration != nil {
+		link.CredentialExpiration = update.CredentialExpiration
+	}
+	if update.CredentialSubject != nil {
+		link.CredentialSubject = update.CredentialSubject
+	}
+	if update.RefreshService != nil {
+		link.RefreshService = update.RefreshService
+	}
+	if update.DisplayMethod != nil {
+		link.DisplayMethod = update.DisplayMethod
+	}
+	if update.Allowlist != nil {
+		link.Allowlist = *update.Allowlist
+	}
+	if update.InviteOnly != nil {
+		link.InviteOnly = *update.InviteOnly
+	}
 
+	if err := ls.validateLinkCredential(ctx, link, link.Schema); err != nil {
+		return nil, err
+	}
+	if link.Active {
+		if err := ls.Validate(ctx, link); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := ls.linkRepository.Save(ctx, ls.storage.Pgx, link); err != nil {
+		return nil, err
+	}
 	return link, nil
 }
 
+// Clone - creates a new link with the schema, attributes and settings of an existing link.
+// The access of the link is kept, but the allowlist and the invites are not copied. Expired links cannot be cloned.
+func (ls *Link) Clone(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) (*domain.Link, error) {
+	template, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	if template.ValidUntil != nil && template.ValidUntil.Before(time.Now()) {
+		return nil, ErrLinkCloneExpired
+	}
+	return ls.Save(ctx, issuerDID, template.MaxIssuance, template.ValidUntil, template.SchemaID, template.CredentialExpiration,
+		template.CredentialSignatureProof, template.CredentialMTPProof, template.CredentialSubject, template.RefreshService,
+		template.DisplayMethod, template.Derivation, ports.LinkAccess{Allowlist: template.Allowlist, InviteOnly: template.InviteOnly})
+}
+
+// validateLinkCredential validates the credential subject of the link, with the placeholders of its derived attributes,
+// against the schema, and the refresh service and display method of the credentials
+func (ls *Link) validateLinkCredential(ctx context.Context, link *domain.Link, schemaDB *domain.Schema) error {
+	placeholders, err := ls.derivedPlaceholders(ctx, link.Derivation, schemaDB)
+	if err != nil {
+		log.Error(ctx, "validating link derivation", "err", err)
+		return err
+	}
+	subject := make(domain.CredentialSubject, len(link.CredentialSubject)+len(placeholders))
+	for key, val := range link.CredentialSubject {
+		subject[key] = val
+	}
+	for key, val := range placeholders {
+		subject[key] = val
+	}
+	if err := ls.validateCredentialSubjectAgainstSchema(ctx, subject, schemaDB); err != nil {
+		log.Error(ctx, "validating credential subject", "err", err, "subject", link.CredentialSubject, "schema-id", schemaDB.ID, "schema-type", schemaDB.Type)
+		return ErrInvalidCredentialSubject
+	}
+	if err = ls.validateRefreshService(link.RefreshService, link.CredentialExpiration); err != nil {
+		log.Error(ctx, "validating refresh service", "err", err)
+		return err
+	}
+	if err = ls.validateDisplayMethod(link.DisplayMethod); err != nil {
+		log.Error(ctx, "validating display method", "err", err)
+		return err
+	}
+	return nil
+}
+
 // Activate - activates or deactivates a credential link
 func (ls *Link) Activate(ctx context.Context, issuerID w3c.DID, linkID uuid.UUID, active bool) error {
 	link, err := ls.linkRepository.GetByID(ctx, issuerID, linkID)
@@ -151,6 +291,10 @@ func (ls *Link) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, se
 	}
 
 	if link.AuthorizationRequestMessage == nil {
+		scope := make([]protocol.ZeroKnowledgeProofRequest, 0)
+		if link.Derivation != nil {
+			scope = link.Derivation.ProofRequests
+		}
 		reqID := uuid.New().String()
 		authorizationRequestMessage := &protocol.AuthorizationRequestMessage{
 			From:     issuerDID.String(),
@@ -161,7 +305,7 @@ func (ls *Link) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, se
 			Body: protocol.AuthorizationRequestMessageBody{
 				CallbackURL: fmt.Sprintf(ports.LinksCallbackURL, serverURL, issuerDID.String(), link.ID.String()),
 				Reason:      authReason,
-				Scope:       make([]protocol.ZeroKnowledgeProofRequest, 0),
+				Scope:       scope,
 			},
 		}
 		if err := ls.linkRepository.AddAuthorizationRequest(ctx, link.ID, issuerDID, authorizationRequestMessage); err != nil {
@@ -181,17 +325,17 @@ func (ls *Link) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, se
 	return link, nil
 }
 
-// GetAll returns all links from issueDID of type lType filtered by query string
-func (ls *Link) GetAll(ctx context.Context, issuerDID w3c.DID, status ports.LinkStatus, query *string, serverURL string) ([]*domain.Link, error) {
-	links, err := ls.linkRepository.GetAll(ctx, issuerDID, status, query)
+// GetAll returns the links from issueDID that match the filter and the total number of them
+func (ls *Link) GetAll(ctx context.Context, issuerDID w3c.DID, filter *ports.LinksFilter, serverURL string) ([]*domain.Link, uint, error) {
+	links, total, err := ls.linkRepository.GetAll(ctx, issuerDID, filter)
 	if err != nil {
-		return links, err
+		return links, 0, err
 	}
 
 	for _, link := range links {
 		ls.addLinksToLink(link, serverURL, issuerDID)
 	}
-	return links, nil
+	return links, total, nil
 }
 
 func (ls *Link) addLinksToLink(link *domain.Link, serverURL string, issuerDID w3c.DID) {
@@ -226,6 +370,7 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid
 		return nil, err
 	}
 	raw = link.AuthorizationRequestMessage.Bytes
+	ls.recordEvent(ctx, link.ID, domain.LinkEventQRDisplayed, nil)
 	return &ports.CreateQRCodeResponse{
 		DeepLink:      qrlink.NewDeepLink(serverURL, linkID, &issuerDID),
 		UniversalLink: qrlink.NewUniversal(ls.cfg.BaseUrl, serverURL, link.ID, &issuerDID),
@@ -236,7 +381,8 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid
 }
 
 // IssueOrFetchClaim - Create a new claim
-func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, hostURL string) (*protocol.CredentialsOfferMessage, error) {
+// proofSubject are the attributes derived from the proof of the holder, required by the links with a derivation.
+func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, proofSubject domain.CredentialSubject, hostURL string) (*protocol.CredentialsOfferMessage, error) {
 	link, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
 	if err != nil {
 		log.Error(ctx, "cannot fetch the link", "err", err)
@@ -268,17 +414,37 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 		Iden3SparseMerkleTreeProof: link.CredentialMTPProof,
 	}
 	if len(issuedByUser) == 0 {
+		if link.Derivation != nil && proofSubject == nil {
+			return nil, ErrLinkProofRequired
+		}
+
+		allowlistEntry, err := ls.allowlistEntry(ctx, link, userDID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ls.checkPayment(ctx, issuerDID, userDID, link, schema, proofSubject, hostURL); err != nil {
+			return nil, err
+		}
+
 		identity, err := ls.identityService.GetByDID(ctx, issuerDID)
 		if err != nil {
 			log.Error(ctx, "cannot fetch the identity", "err", err)
 			return nil, err
 		}
 		credentialStatusType := verifiable.CredentialStatusType(identity.AuthCoreClaimRevocationStatus.Type)
-		link.CredentialSubject["id"] = userDID.String()
+		credentialSubject := link.CredentialSubject
+		if allowlistEntry != nil {
+			credentialSubject = allowlistEntry.Subject(link.CredentialSubject)
+		}
+		for key, val := range proofSubject {
+			credentialSubject[key] = val
+		}
+		credentialSubject["id"] = userDID.String()
 		claimReq := ports.NewCreateClaimRequest(&issuerDID,
 			nil,
 			schema.URL,
-			link.CredentialSubject,
+			credentialSubject,
 			link.CredentialExpiration,
 			schema.Type,
 			nil, nil, nil,
@@ -299,43 +465,62 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 
 		err = ls.storage.Pgx.BeginFunc(ctx,
 			func(tx pgx.Tx) error {
-				link.IssuedClaims += 1
-				_, err := ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
-				if err != nil {
+				if err := ls.linkRepository.ReserveIssuance(ctx, tx, issuerDID, linkID); err != nil {
+					if errors.Is(err, repositories.ErrLinkMaxIssuanceReached) {
+						log.Debug(ctx, "cannot dispatch more credentials for this link")
+						return ErrLinkMaxExceeded
+					}
 					return err
 				}
 
-				credentialIssuedID, err = ls.claimRepository.Save(ctx, ls.storage.Pgx, credentialIssued)
+				credentialIssuedID, err = ls.claimRepository.Save(ctx, tx, credentialIssued)
 				if err != nil {
 					return err
 				}
 
+				if err := ls.linkRepository.SaveUsage(ctx, tx, domain.NewLinkUsage(linkID, userDID, credentialIssuedID)); err != nil {
+					return err
+				}
+
+				if allowlistEntry != nil {
+					if err := ls.linkRepository.ClaimAllowlistEntry(ctx, tx, allowlistEntry.ID, credentialIssuedID); err != nil {
+						if errors.Is(err, repositories.ErrLinkAllowlistEntryNotFound) {
+							return ErrLinkAllowlistEntryClaimed
+						}
+						return err
+					}
+				}
 				return nil
 			})
 		if err != nil {
 			return nil, err
 		}
+		ls.recordEvent(ctx, linkID, domain.LinkEventCredentialIssued, &userDID)
 	} else {
 		credentialIssuedID = issuedByUser[0].ID
 		credentialIssued = issuedByUser[0]
 	}
 
 	credentialIssued.ID = credentialIssuedID
-	if link.CredentialSignatureProof {
-		credOffer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, hostURL), credentialIssued)
-		return credOffer, err
-	} else {
-		if credentialIssued.MTPProof.Bytes != nil {
-			credOffer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, hostURL), credentialIssued)
-			return credOffer, err
-		}
+	if !link.CredentialSignatureProof && credentialIssued.MTPProof.Bytes == nil {
 		log.Info(ctx, "credential issued without MTP proof. Publishing state have to be done", "credential", credentialIssued.ID.String())
 		return nil, nil
 	}
+	credOffer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, hostURL), credentialIssued)
+	if err != nil {
+		return nil, err
+	}
+	if err := ls.claimsService.MarkOffered(ctx, issuerDID, credOffer); err != nil {
+		log.Error(ctx, "marking the link credential as offered", "err", err, "credential", credentialIssued.ID.String())
+	}
+	ls.recordEvent(ctx, linkID, domain.LinkEventOfferCreated, &userDID)
+	return credOffer, nil
 }
 
 // ProcessCallBack - process the callback.
-func (ls *Link) ProcessCallBack(ctx context.Context, issuerID w3c.DID, message string, linkID uuid.UUID, hostURL string) (*protocol.CredentialsOfferMessage, error) {
+// The email hash or code of allowlistKey binds its allowlist entry to the holder before issuing the credential.
+// Invite-only links take a use of inviteCode for the holders that do not have a credential of the link yet.
+func (ls *Link) ProcessCallBack(ctx context.Context, issuerID w3c.DID, message string, linkID uuid.UUID, allowlistKey *string, inviteCode *string, hostURL string) (*protocol.CredentialsOfferMessage, error) {
 	link, err := ls.linkRepository.GetByID(ctx, issuerID, linkID)
 	if err != nil {
 		log.Error(ctx, "error fetching the link from the database", "err", err)
@@ -348,9 +533,11 @@ func (ls *Link) ProcessCallBack(ctx context.Context, issuerID w3c.DID, message s
 		return nil, err
 	}
 
+	ls.recordEvent(ctx, link.ID, domain.LinkEventCallbackReceived, nil)
 	arm, err := ls.identityService.AuthenticateWithRequest(ctx, nil, authenticationRequest, message, hostURL)
 	if err != nil {
 		log.Error(ctx, "error authenticating", "err", err.Error())
+		ls.recordEvent(ctx, link.ID, domain.LinkEventAuthenticationFailed, nil)
 		return nil, err
 	}
 
@@ -366,14 +553,488 @@ func (ls *Link) ProcessCallBack(ctx context.Context, issuerID w3c.DID, message s
 		return nil, err
 	}
 
-	offer, err := ls.IssueOrFetchClaim(ctx, *issuerDID, *userDID, linkID, hostURL)
+	var proofSubject domain.CredentialSubject
+	if link.Derivation != nil {
+		if proofSubject, err = ls.deriveProofSubject(link.Derivation, arm); err != nil {
+			log.Error(ctx, "error deriving the attributes from the proof", "err", err)
+			return nil, &ProblemReportError{Err: err, ThreadID: authenticationRequest.ThreadID, From: issuerDID.String(), To: userDID.String()}
+		}
+	}
+
+	if allowlistKey != nil && *allowlistKey != "" {
+		if err := ls.bindAllowlistEntry(ctx, linkID, *allowlistKey, *userDID); err != nil {
+			log.Error(ctx, "error binding the allowlist entry", "err", err)
+			return nil, &ProblemReportError{Err: err, ThreadID: authenticationRequest.ThreadID, From: issuerDID.String(), To: userDID.String()}
+		}
+	}
+
+	usedInvite, err := ls.useInvite(ctx, link, *userDID, inviteCode)
 	if err != nil {
+		log.Error(ctx, "error using the invite", "err", err)
+		return nil, &ProblemReportError{Err: err, ThreadID: authenticationRequest.ThreadID, From: issuerDID.String(), To: userDID.String()}
+	}
+
+	offer, err := ls.IssueOrFetchClaim(ctx, *issuerDID, *userDID, linkID, proofSubject, hostURL)
+	if err != nil {
+		var paymentRequired *PaymentRequiredError
+		if errors.As(err, &paymentRequired) {
+			return nil, err
+		}
+		if usedInvite {
+			if err := ls.linkRepository.ReleaseInvite(ctx, ls.storage.Pgx, linkID, *inviteCode, *userDID); err != nil {
+				log.Error(ctx, "error releasing the invite", "err", err)
+			}
+		}
 		log.Error(ctx, "error issuing claim", "err", err)
-		return nil, err
+		return nil, &ProblemReportError{Err: err, ThreadID: authenticationRequest.ThreadID, From: issuerDID.String(), To: userDID.String()}
 	}
 	return offer, nil
 }
 
+// useInvite takes a use of the invite code for the holders without a credential of an invite-only link.
+// It returns true if a new use was taken, so it can be released if the credential is not issued. The holders that
+// already have a use of the code keep it, so they can scan it again, e.g. after paying for the credential.
+// The use is kept when the holder must pay, and it is released after domain.LinkInviteReservation if they never do.
+func (ls *Link) useInvite(ctx context.Context, link *domain.Link, userDID w3c.DID, inviteCode *string) (bool, error) {
+	if !link.InviteOnly {
+		return false, nil
+	}
+	issuedByUser, err := ls.claimRepository.GetClaimsIssuedForUser(ctx, ls.storage.Pgx, w3c.DID(link.IssuerDID), userDID, link.ID)
+	if err != nil {
+		return false, err
+	}
+	if len(issuedByUser) > 0 {
+		return false, nil
+	}
+	if inviteCode == nil || *inviteCode == "" {
+		return false, ErrLinkInviteRequired
+	}
+	var taken bool
+	err = ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		taken, err = ls.linkRepository.UseInvite(ctx, tx, link.ID, *inviteCode, userDID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkInviteNotFound) {
+			return false, ErrLinkInviteInvalid
+		}
+		return false, err
+	}
+	return taken, nil
+}
+
+// UploadAllowlist adds the entries to the allowlist of the link, which is only checked if the link has the allowlist
+// enabled. The string attributes of the entries are converted to the type of their schema attribute, so CSV values
+// can be used, and the credential subject of every entry is validated against the schema of the link.
+func (ls *Link) UploadAllowlist(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, entries []*domain.LinkAllowlistEntry) ([]*domain.LinkAllowlistEntry, error) {
+	link, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: no entries", domain.ErrInvalidLinkAllowlist)
+	}
+
+	jsonSchema, err := jsonschema.Load(ctx, link.Schema.URL, ls.loader)
+	if err != nil {
+		log.Error(ctx, "cannot load the schema", "err", err, "url", link.Schema.URL)
+		return nil, ErrLoadingSchema
+	}
+	for _, entry := range entries {
+		entry.LinkID = link.ID
+		if err := typeAllowlistAttributes(jsonSchema, entry.CredentialSubject); err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", domain.ErrInvalidLinkAllowlist, entry.Key, err)
+		}
+		if err := ls.validateCredentialSubjectAgainstSchema(ctx, entry.Subject(link.CredentialSubject), link.Schema); err != nil {
+			log.Warn(ctx, "validating allowlist entry subject", "err", err, "key", entry.Key, "schema-id", link.Schema.ID)
+			return nil, fmt.Errorf("%w: %s: %s", domain.ErrInvalidLinkAllowlist, entry.Key, ErrInvalidCredentialSubject)
+		}
+	}
+
+	err = ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return ls.linkRepository.SaveAllowlistEntries(ctx, tx, entries)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetAllowlist returns the allowlist of the link
+func (ls *Link) GetAllowlist(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]*domain.LinkAllowlistEntry, error) {
+	if _, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID); err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	return ls.linkRepository.GetAllowlist(ctx, ls.storage.Pgx, linkID)
+}
+
+// GetUsage returns the credentials issued by the link, the latest first
+func (ls *Link) GetUsage(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) ([]*domain.LinkUsage, error) {
+	if _, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID); err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	return ls.linkRepository.GetUsage(ctx, linkID)
+}
+
+// GetStats returns the events of the links of the issuer between req.From and req.To, grouped in buckets of req.Interval.
+// The events can be filtered by a link or by the schema of the links.
+func (ls *Link) GetStats(ctx context.Context, issuerDID w3c.DID, req ports.LinkStatsRequest) (*domain.LinkStats, error) {
+	if req.LinkID != nil {
+		if _, err := ls.linkRepository.GetByID(ctx, issuerDID, *req.LinkID); err != nil {
+			if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+				return nil, ErrLinkNotFound
+			}
+			return nil, err
+		}
+	}
+	if req.SchemaID != nil {
+		if _, err := ls.schemaRepository.GetByID(ctx, issuerDID, *req.SchemaID); err != nil {
+			if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+				return nil, ErrSchemaNotFound
+			}
+			return nil, err
+		}
+	}
+
+	// the buckets are built before counting, so a wrong range is rejected without querying the events
+	if _, err := domain.NewLinkStats(req.From, req.To, req.Interval, nil); err != nil {
+		return nil, err
+	}
+	counts, err := ls.linkRepository.CountEvents(ctx, issuerDID, req)
+	if err != nil {
+		log.Error(ctx, "cannot count the link events", "err", err)
+		return nil, err
+	}
+	return domain.NewLinkStats(req.From, req.To, req.Interval, counts)
+}
+
+// recordEvent saves an event of the funnel of the link. The events are only used by the stats,
+// so the errors are logged and the flow of the link goes on.
+func (ls *Link) recordEvent(ctx context.Context, linkID uuid.UUID, eventType domain.LinkEventType, holderDID *w3c.DID) {
+	if err := ls.linkRepository.SaveEvent(ctx, domain.NewLinkEvent(linkID, eventType, holderDID)); err != nil {
+		log.Error(ctx, "cannot save the link event", "err", err, "linkID", linkID, "type", eventType)
+	}
+}
+
+// CreateAllowlistQRCode generates the qr code of the link for the holder of the email hash or code of an allowlist entry.
+// The callback of the authorization request carries the key, so it is stored in the qr store instead of the link.
+func (ls *Link) CreateAllowlistQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, allowlistKey string, serverURL string) (*ports.CreateQRCodeResponse, error) {
+	linkQRCode, err := ls.CreateQRCode(ctx, issuerDID, linkID, serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := ls.linkRepository.GetAllowlistEntryByKey(ctx, ls.storage.Pgx, linkID, allowlistKey)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkAllowlistEntryNotFound) {
+			return nil, ErrLinkAllowlistKeyNotFound
+		}
+		return nil, err
+	}
+	if entry.IsClaimed() {
+		return nil, ErrLinkAllowlistEntryClaimed
+	}
+
+	var authorizationRequestMessage protocol.AuthorizationRequestMessage
+	if err := json.Unmarshal([]byte(linkQRCode.QrCodeRaw), &authorizationRequestMessage); err != nil {
+		log.Error(ctx, "cannot unmarshal the authorization", "err", err)
+		return nil, err
+	}
+	authorizationRequestMessage.Body.CallbackURL += "&allowlistKey=" + url.QueryEscape(allowlistKey)
+	raw, err := json.Marshal(authorizationRequestMessage)
+	if err != nil {
+		return nil, err
+	}
+	qrID, err := ls.qrService.Store(ctx, raw, DefaultQRBodyTTL)
+	if err != nil {
+		log.Error(ctx, "cannot store the qr code", "err", err)
+		return nil, err
+	}
+	return &ports.CreateQRCodeResponse{
+		DeepLink:      qrlink.NewDeepLink(serverURL, qrID, nil),
+		UniversalLink: qrlink.NewUniversal(ls.cfg.BaseUrl, serverURL, qrID, nil),
+		QrID:          qrID,
+		Link:          linkQRCode.Link,
+		QrCodeRaw:     string(raw),
+	}, nil
+}
+
+// CreateInvites generates count invite codes for the link that can be used by maxUses holders each.
+// The codes are only required by the links that are invite-only.
+func (ls *Link) CreateInvites(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, count int, maxUses int, serverURL string) ([]*domain.LinkInvite, error) {
+	if _, err := ls.GetByID(ctx, issuerDID, linkID, serverURL); err != nil {
+		return nil, err
+	}
+	invites, err := domain.NewLinkInvites(linkID, count, maxUses)
+	if err != nil {
+		return nil, err
+	}
+	err = ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return ls.linkRepository.SaveInvites(ctx, tx, invites)
+	})
+	if err != nil {
+		log.Error(ctx, "cannot save the link invites", "err", err)
+		return nil, err
+	}
+	for _, invite := range invites {
+		ls.addLinksToInvite(invite, serverURL, issuerDID)
+	}
+	return invites, nil
+}
+
+// GetInvites returns the invites of the link
+func (ls *Link) GetInvites(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, serverURL string) ([]*domain.LinkInvite, error) {
+	if _, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID); err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	invites, err := ls.linkRepository.GetInvites(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	for _, invite := range invites {
+		ls.addLinksToInvite(invite, serverURL, issuerDID)
+	}
+	return invites, nil
+}
+
+// RevokeInvite revokes an invite of the link, so it cannot be used anymore
+func (ls *Link) RevokeInvite(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, inviteID uuid.UUID) error {
+	if _, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID); err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			return ErrLinkNotFound
+		}
+		return err
+	}
+	if err := ls.linkRepository.RevokeInvite(ctx, linkID, inviteID); err != nil {
+		if errors.Is(err, repositories.ErrLinkInviteNotFound) {
+			return ErrLinkInviteNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// GetInviteQRCode returns the authorization request of the link for the holder of the invite code.
+// The callback of the request carries the code, which is used when the holder scans it.
+func (ls *Link) GetInviteQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID, inviteCode string, serverURL string) ([]byte, error) {
+	linkQRCode, err := ls.CreateQRCode(ctx, issuerDID, linkID, serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := ls.linkRepository.GetInviteByCode(ctx, linkID, inviteCode)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkInviteNotFound) {
+			return nil, ErrLinkInviteInvalid
+		}
+		return nil, err
+	}
+	// the uses are checked when the holder scans the code, as the holders that already used it can scan it again
+	if invite.IsRevoked() {
+		return nil, ErrLinkInviteInvalid
+	}
+
+	var authorizationRequestMessage protocol.AuthorizationRequestMessage
+	if err := json.Unmarshal([]byte(linkQRCode.QrCodeRaw), &authorizationRequestMessage); err != nil {
+		log.Error(ctx, "cannot unmarshal the authorization", "err", err)
+		return nil, err
+	}
+	authorizationRequestMessage.Body.CallbackURL += "&inviteCode=" + url.QueryEscape(inviteCode)
+	return json.Marshal(authorizationRequestMessage)
+}
+
+func (ls *Link) addLinksToInvite(invite *domain.LinkInvite, serverURL string, issuerDID w3c.DID) {
+	invite.DeepLink = qrlink.NewInviteDeepLink(serverURL, invite.LinkID, issuerDID, invite.Code)
+	invite.UniversalLink = qrlink.NewInviteUniversal(ls.cfg.BaseUrl, serverURL, invite.LinkID, issuerDID, invite.Code)
+}
+
+// allowlistEntry returns the allowlist entry of the holder, or nil if the link has no allowlist
+func (ls *Link) allowlistEntry(ctx context.Context, link *domain.Link, userDID w3c.DID) (*domain.LinkAllowlistEntry, error) {
+	if !link.Allowlist {
+		return nil, nil
+	}
+	entry, err := ls.linkRepository.GetAllowlistEntryByHolder(ctx, ls.storage.Pgx, link.ID, userDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkAllowlistEntryNotFound) {
+			return nil, ErrLinkHolderNotAllowed
+		}
+		log.Error(ctx, "cannot fetch the allowlist entry", "err", err)
+		return nil, err
+	}
+	if entry.IsClaimed() {
+		return nil, ErrLinkAllowlistEntryClaimed
+	}
+	return entry, nil
+}
+
+func (ls *Link) bindAllowlistEntry(ctx context.Context, linkID uuid.UUID, allowlistKey string, userDID w3c.DID) error {
+	err := ls.linkRepository.BindAllowlistEntry(ctx, ls.storage.Pgx, linkID, allowlistKey, userDID)
+	if errors.Is(err, repositories.ErrLinkAllowlistEntryNotFound) {
+		return ErrLinkAllowlistKeyNotFound
+	}
+	return err
+}
+
+// deriveProofSubject derives the attributes of the link from the values disclosed in the verified proofs of the holder
+func (ls *Link) deriveProofSubject(derivation *domain.LinkDerivation, arm *protocol.AuthorizationResponseMessage) (domain.CredentialSubject, error) {
+	disclosed, err := domain.DisclosedValues(arm.Body.Scope)
+	if err != nil {
+		return nil, err
+	}
+	return derivation.Derive(disclosed, time.Now().UTC())
+}
+
+// derivedPlaceholders validates the derivation and returns a value of the type of each derived attribute,
+// so the credential subject of the link can be validated against the schema before the holders disclose their values
+func (ls *Link) derivedPlaceholders(ctx context.Context, derivation *domain.LinkDerivation, schemaDB *domain.Schema) (domain.CredentialSubject, error) {
+	if derivation == nil {
+		return nil, nil
+	}
+	if err := derivation.Validate(); err != nil {
+		return nil, err
+	}
+
+	jsonSchema, err := jsonschema.Load(ctx, schemaDB.URL, ls.loader)
+	if err != nil {
+		log.Error(ctx, "cannot load the schema", "err", err, "url", schemaDB.URL)
+		return nil, ErrLoadingSchema
+	}
+	placeholders := make(domain.CredentialSubject, len(derivation.Attributes))
+	for _, attr := range derivation.Attributes {
+		schemaAttr, err := jsonSchema.AttributeByID(attr.Attribute)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidLinkDerivation, err)
+		}
+		switch {
+		case schemaAttr.Type == domain.TypeBoolean:
+			placeholders[attr.Attribute] = false
+		case schemaAttr.Type == domain.TypeInteger || schemaAttr.Type == "number":
+			placeholders[attr.Attribute] = 0
+		case schemaAttr.Format == "date-time":
+			placeholders[attr.Attribute] = time.Now().UTC().Format(time.RFC3339)
+		default:
+			placeholders[attr.Attribute] = ""
+		}
+	}
+	return placeholders, nil
+}
+
+// typeAllowlistAttributes converts the string values of the attributes to the type of the schema attributes
+func typeAllowlistAttributes(jsonSchema *jsonschema.JSONSchema, credentialSubject domain.CredentialSubject) error {
+	for id, val := range credentialSubject {
+		str, ok := val.(string)
+		if !ok {
+			continue
+		}
+		attr, err := jsonSchema.AttributeByID(id)
+		if err != nil {
+			return err
+		}
+		switch attr.Type {
+		case domain.TypeInteger:
+			i, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				return fmt.Errorf("attribute %s must be an integer", id)
+			}
+			credentialSubject[id] = i
+		case "number":
+			f, err := strconv.ParseFloat(str, 64)
+			if err != nil {
+				return fmt.Errorf("attribute %s must be a number", id)
+			}
+			credentialSubject[id] = f
+		case domain.TypeBoolean:
+			b, err := strconv.ParseBool(str)
+			if err != nil {
+				return fmt.Errorf("attribute %s must be a boolean", id)
+			}
+			credentialSubject[id] = b
+		}
+	}
+	return nil
+}
+
+// checkPayment returns a PaymentRequiredError with the payment request of the link price until the user pays it.
+// The pending request is sent again while the price does not change. Links without price are free.
+// The request keeps the last proofSubject of the holder, so the credential can be issued when the payment is received.
+func (ls *Link) checkPayment(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, link *domain.Link, schema *domain.Schema, proofSubject domain.CredentialSubject, hostURL string) error {
+	price, err := ls.paymentRepo.GetLinkPrice(ctx, ls.storage.Pgx, issuerDID, link.SchemaID, link.ID)
+	if errors.Is(err, repositories.ErrCredentialPriceNotFound) {
+		return nil
+	}
+	if err != nil {
+		log.Error(ctx, "cannot fetch the link price", "err", err)
+		return err
+	}
+
+	request, err := ls.paymentRepo.GetLastRequest(ctx, ls.storage.Pgx, issuerDID, userDID, link.ID)
+	if err != nil && !errors.Is(err, repositories.ErrPaymentRequestNotFound) {
+		log.Error(ctx, "cannot fetch the payment request", "err", err)
+		return err
+	}
+	if request != nil && request.Status == domain.PaymentRequestStatusPaid {
+		return nil
+	}
+	if request == nil || !request.HasPrice(price) {
+		if request, err = ls.newPaymentRequest(ctx, price, userDID, link.ID, proofSubject); err != nil {
+			return err
+		}
+	} else if proofSubject != nil {
+		request.CredentialSubject = proofSubject
+		if err := ls.paymentRepo.SaveRequest(ctx, ls.storage.Pgx, request); err != nil {
+			log.Error(ctx, "cannot save the payment request", "err", err)
+			return err
+		}
+	}
+
+	jsonSchema, err := jsonschema.Load(ctx, schema.URL, ls.loader)
+	if err != nil {
+		log.Error(ctx, "cannot load the schema", "err", err, "url", schema.URL)
+		return err
+	}
+	schemaContext, err := jsonSchema.JSONLdContext()
+	if err != nil {
+		return err
+	}
+	return &PaymentRequiredError{
+		Request: newPaymentRequestMessage(request, protocol.CredentialInfo{Type: schema.Type, Context: schemaContext}, fmt.Sprintf(ports.AgentUrl, hostURL)),
+	}
+}
+
+// newPaymentRequest saves a pending payment request with the price in the chain of its network
+func (ls *Link) newPaymentRequest(ctx context.Context, price *domain.CredentialPrice, userDID w3c.DID, linkID uuid.UUID, credentialSubject domain.CredentialSubject) (*domain.PaymentRequest, error) {
+	resolverPrefix := price.Blockchain + ":" + price.Network
+	client, err := ls.networkResolver.GetEthClient(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "cannot get the payment network client", "err", err, "network", resolverPrefix)
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		log.Error(ctx, "cannot get the payment chain id", "err", err, "network", resolverPrefix)
+		return nil, err
+	}
+
+	request := domain.NewPaymentRequest(price, userDID, linkID, chainID.String(), credentialSubject)
+	if err := ls.paymentRepo.SaveRequest(ctx, ls.storage.Pgx, request); err != nil {
+		log.Error(ctx, "cannot save the payment request", "err", err)
+		return nil, err
+	}
+	return request, nil
+}
+
 // Validate - validate the link
 // It checks if the link is active, not expired and has not exceeded the maximum number of claims
 func (ls *Link) Validate(ctx context.Context, link *domain.Link) error {
@@ -1,31 +1,40 @@
 package services
 
 import (
+	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/iden3comm/v2"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 )
 
 // MediaTypeManager manages the list of allowed media types for the protocol message type
 // if strictMode is true, then all messages that do not exist in the allowed list will be rejected
 type MediaTypeManager struct {
-	enabled   bool
-	allowList map[iden3comm.ProtocolMessage][]string
+	enabled bool
+	policy  *domain.MediaTypePolicy
 }
 
 // NewMediaTypeManager create instance of MediaTypeManager
 func NewMediaTypeManager(allowList map[iden3comm.ProtocolMessage][]string, enabled bool) *MediaTypeManager {
+	return NewMediaTypeManagerWithPolicy(&domain.MediaTypePolicy{Default: allowList}, enabled)
+}
+
+// NewMediaTypeManagerWithPolicy create instance of MediaTypeManager with allow lists per issuer identity
+func NewMediaTypeManagerWithPolicy(policy *domain.MediaTypePolicy, enabled bool) *MediaTypeManager {
 	return &MediaTypeManager{
-		enabled:   enabled,
-		allowList: allowList,
+		enabled: enabled,
+		policy:  policy,
 	}
 }
 
-// AllowMediaType check if the protocol message supports the mediaType type
-func (m *MediaTypeManager) AllowMediaType(protoclMessage iden3comm.ProtocolMessage, mediaType iden3comm.MediaType) bool {
+// AllowMediaType check if the protocol message supports the mediaType type for the issuer.
+// A nil issuer uses the default allow list.
+func (m *MediaTypeManager) AllowMediaType(issuerDID *w3c.DID, protoclMessage iden3comm.ProtocolMessage, mediaType iden3comm.MediaType) bool {
 	if !m.enabled {
 		return true
 	}
 
-	al, ok := m.allowList[protoclMessage]
+	al, ok := m.policy.AllowList(issuerDID)[protoclMessage]
 	if !ok {
 		return false
 	}
@@ -36,3 +45,13 @@ func (m *MediaTypeManager) AllowMediaType(protoclMessage iden3comm.ProtocolMessa
 	}
 	return false
 }
+
+// Enabled returns false if every media type is allowed
+func (m *MediaTypeManager) Enabled() bool {
+	return m.enabled
+}
+
+// Policy returns the media type policy
+func (m *MediaTypeManager) Policy() *domain.MediaTypePolicy {
+	return m.policy
+}
@@ -79,7 +79,7 @@ func TestMediatypeManager_AllowList(t *testing.T) {
 				tt.allowList, tt.enabled,
 			)
 			actual := mdm.AllowMediaType(
-				tt.targetProtocolMessage, tt.targetMediatype,
+				nil, tt.targetProtocolMessage, tt.targetMediatype,
 			)
 			require.Equal(t, tt.expected, actual)
 		})
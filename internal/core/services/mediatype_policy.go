@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+// LoadMediaTypePolicy reads and validates the media type policy file. Without path, the default policy is returned.
+func LoadMediaTypePolicy(ctx context.Context, path string) (*domain.MediaTypePolicy, error) {
+	if path == "" {
+		log.Info(ctx, "media type policy file not configured, using the default media type policy")
+		return domain.DefaultMediaTypePolicy(), nil
+	}
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidMediaTypePolicy, err)
+	}
+	defer func() { _ = f.Close() }()
+	return domain.ParseMediaTypePolicy(f)
+}
@@ -56,7 +56,7 @@ func TestNotification_SendNotification(t *testing.T) {
 		true,
 	)
 
-	credentialsService := NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	credentialsService := NewClaim(claimsRepo, repositories.NewLink(*storage), identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, nil, mediaTypeManager, NewSigningPolicy(cfg.SigningPolicy, claimsRepo, repositories.NewApproval(), storage), cfg.UniversalLinks)
 	connectionsService := NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "optimism-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
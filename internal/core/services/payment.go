@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/network"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+var (
+	ErrInvalidCredentialPrice = errors.New("invalid credential price")                                          // ErrInvalidCredentialPrice means the price amount, recipient, network or link are not valid
+	ErrPaymentNotVerified     = errors.New("the payment cannot be verified")                                    // ErrPaymentNotVerified means the transaction does not pay the payment request
+	ErrCredentialPending      = errors.New("the credential will be offered once the issuer state is published") // ErrCredentialPending means the paid credential has no proof yet
+)
+
+// PaymentRequiredError means the credential cannot be issued until the holder pays the payment request
+type PaymentRequiredError struct {
+	Request *protocol.CredentialPaymentRequestMessage
+}
+
+// Error implements error
+func (e *PaymentRequiredError) Error() string {
+	return "payment required"
+}
+
+// newPaymentRequestMessage returns the payment-request message of the request. Its thread is the payment request ID.
+func newPaymentRequestMessage(request *domain.PaymentRequest, credential protocol.CredentialInfo, agentURL string) *protocol.CredentialPaymentRequestMessage {
+	info := protocol.CredentialPaymentInfo{
+		Credentials: []protocol.CredentialInfo{credential},
+		Type:        domain.PaymentTypeCrypto,
+		Data: protocol.CredentialPaymentData{
+			ID:       request.ID.String(),
+			Type:     domain.PaymentTypeCrypto,
+			Amount:   request.Amount.String(),
+			ChainID:  request.ChainID,
+			Address:  request.Recipient,
+			Currency: domain.PaymentCurrencyETH,
+		},
+	}
+	if request.Description != nil {
+		info.Description = *request.Description
+	}
+	return &protocol.CredentialPaymentRequestMessage{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialPaymentRequestMessageType,
+		ThreadID: request.ID.String(),
+		Body: protocol.CredentialPaymentRequestBody{
+			Agent:    agentURL,
+			Payments: []protocol.CredentialPaymentInfo{info},
+		},
+		From: request.IssuerDID.String(),
+		To:   request.UserDID.String(),
+	}
+}
+
+type payment struct {
+	repository       ports.PaymentRepository
+	schemaRepository ports.SchemaRepository
+	linkService      ports.LinkService
+	identityService  ports.IdentityService
+	mediatypeManager ports.MediatypeManager
+	verifier         ports.PaymentVerifier
+	networkResolver  network.Resolver
+	storage          *db.Storage
+	serverURL        string
+}
+
+// NewPayment returns the service that manages the credential prices and verifies the payments of the holders
+func NewPayment(repository ports.PaymentRepository, schemaRepository ports.SchemaRepository, linkService ports.LinkService, identityService ports.IdentityService, mediatypeManager ports.MediatypeManager, verifier ports.PaymentVerifier, networkResolver network.Resolver, storage *db.Storage, serverURL string) ports.PaymentService {
+	return &payment{
+		repository:       repository,
+		schemaRepository: schemaRepository,
+		linkService:      linkService,
+		identityService:  identityService,
+		mediatypeManager: mediatypeManager,
+		verifier:         verifier,
+		networkResolver:  networkResolver,
+		storage:          storage,
+		serverURL:        serverURL,
+	}
+}
+
+// Agent verifies the transactions of a payment message and answers with the offer of the paid credentials.
+// The media types of the payment messages are checked with the media type policy, which requires ZKP packed messages
+// by default so the payments are made by the holder of the payment requests.
+func (p *payment) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	agent, err := p.agent(ctx, req, mediatype)
+	if err != nil {
+		return nil, newAgentProblem(req, err)
+	}
+	return agent, nil
+}
+
+func (p *payment) agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	if err := checkAgentRequest(ctx, p.mediatypeManager, p.identityService, req, mediatype); err != nil {
+		return nil, err
+	}
+	if req.Type != protocol.CredentialPaymentMessageType {
+		return nil, errors.New("invalid type")
+	}
+	body := &protocol.CredentialPaymentBody{}
+	if err := json.Unmarshal(req.Body, body); err != nil {
+		log.Error(ctx, "unmarshalling payment body", "err", err)
+		return nil, fmt.Errorf("invalid payment body: %w", err)
+	}
+	if len(body.Payments) == 0 {
+		return nil, errors.New("invalid payment body: no payments")
+	}
+
+	var offer *protocol.CredentialsOfferMessage
+	for _, paid := range body.Payments {
+		request, err := p.pay(ctx, req, paid.ID, paid.PaymentData.TxID)
+		if err != nil {
+			return nil, err
+		}
+		linkOffer, err := p.linkService.IssueOrFetchClaim(ctx, *req.IssuerDID, *req.UserDID, request.LinkID, request.CredentialSubject, p.serverURL)
+		if errors.Is(err, ErrLinkProofRequired) {
+			// the request has no proof of the holder, which is sent with the next scan of the link
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if linkOffer == nil {
+			continue
+		}
+		if offer == nil {
+			offer = linkOffer
+		} else {
+			offer.Body.Credentials = append(offer.Body.Credentials, linkOffer.Body.Credentials...)
+		}
+	}
+	if offer == nil {
+		return nil, ErrCredentialPending
+	}
+
+	return &domain.Agent{
+		ID:       offer.ID,
+		Typ:      offer.Typ,
+		Type:     offer.Type,
+		ThreadID: req.ThreadID,
+		Body:     offer.Body,
+		From:     offer.From,
+		To:       offer.To,
+	}, nil
+}
+
+// pay verifies the transaction of the payment request of the holder and marks the request as paid.
+// Paid requests are not verified again.
+func (p *payment) pay(ctx context.Context, req *ports.AgentRequest, id string, txID string) (*domain.PaymentRequest, error) {
+	requestID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment id %s", id)
+	}
+	request, err := p.repository.GetRequest(ctx, p.storage.Pgx, *req.IssuerDID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.UserDID.String() != req.UserDID.String() {
+		return nil, repositories.ErrPaymentRequestNotFound
+	}
+	if request.Status == domain.PaymentRequestStatusPaid {
+		return request, nil
+	}
+
+	txID = common.HexToHash(txID).Hex()
+	if err := p.verifier.Verify(ctx, request, txID); err != nil {
+		log.Warn(ctx, "payment not verified", "err", err, "id", request.ID, "txID", txID)
+		return nil, fmt.Errorf("%w: %s", ErrPaymentNotVerified, err)
+	}
+	request.Status = domain.PaymentRequestStatusPaid
+	request.TxID = &txID
+	paidAt := time.Now()
+	request.PaidAt = &paidAt
+	if err := p.repository.SaveRequest(ctx, p.storage.Pgx, request); err != nil {
+		if errors.Is(err, repositories.ErrPaymentTransactionUsed) {
+			return nil, fmt.Errorf("%w: %s", ErrPaymentNotVerified, err)
+		}
+		log.Error(ctx, "saving the paid payment request", "err", err, "id", request.ID)
+		return nil, err
+	}
+	return request, nil
+}
+
+// CreatePrice validates and saves a credential price. Link prices must be of a link of the same schema.
+func (p *payment) CreatePrice(ctx context.Context, price *domain.CredentialPrice) error {
+	if _, err := p.schemaRepository.GetByID(ctx, price.IssuerDID, price.SchemaID); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return ErrSchemaNotFound
+		}
+		return err
+	}
+	if price.LinkID != nil {
+		link, err := p.linkService.GetByID(ctx, price.IssuerDID, *price.LinkID, p.serverURL)
+		if err != nil {
+			return err
+		}
+		if link.SchemaID != price.SchemaID {
+			return fmt.Errorf("%w: the link is not of the price schema", ErrInvalidCredentialPrice)
+		}
+	}
+	if price.Amount == nil || price.Amount.Sign() <= 0 {
+		return fmt.Errorf("%w: the amount must be positive", ErrInvalidCredentialPrice)
+	}
+	if !common.IsHexAddress(price.Recipient) {
+		return fmt.Errorf("%w: invalid recipient address", ErrInvalidCredentialPrice)
+	}
+	if _, err := p.networkResolver.GetEthClient(price.Blockchain + ":" + price.Network); err != nil {
+		return fmt.Errorf("%w: unsupported network %s:%s", ErrInvalidCredentialPrice, price.Blockchain, price.Network)
+	}
+
+	return p.repository.SavePrice(ctx, p.storage.Pgx, price)
+}
+
+// GetPrices returns the credential prices of the issuer
+func (p *payment) GetPrices(ctx context.Context, issuerDID w3c.DID) ([]*domain.CredentialPrice, error) {
+	return p.repository.GetPrices(ctx, p.storage.Pgx, issuerDID)
+}
+
+// DeletePrice deletes a credential price. The pending payment requests of the price are no longer required.
+func (p *payment) DeletePrice(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	return p.repository.DeletePrice(ctx, p.storage.Pgx, issuerDID, id)
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+var (
+	ErrUnsupportedMediaType = errors.New("unsupported media type")                       // ErrUnsupportedMediaType means the message was packed with a media type not allowed for its type
+	ErrIssuerNotFound       = errors.New("cannot proceed with this identity, not found") // ErrIssuerNotFound means the message is addressed to an identity that is not managed by this issuer
+	ErrCredentialRevoked    = errors.New("the credential is revoked")                    // ErrCredentialRevoked means the requested credential cannot be fetched because it is revoked
+)
+
+// Problem report codes sent to the holders. See https://identity.foundation/didcomm-messaging/spec/#problem-codes
+const (
+	ProblemCodeInvalidMessage       protocol.ProblemErrorCode = "e.p.msg"
+	ProblemCodeUnsupportedMediaType protocol.ProblemErrorCode = "e.p.msg.unsupported-media-type"
+	ProblemCodeMessageReplayed      protocol.ProblemErrorCode = "e.p.msg.replayed"
+	ProblemCodeMessageExpired       protocol.ProblemErrorCode = "e.p.msg.expired"
+	ProblemCodeMessageTime          protocol.ProblemErrorCode = "e.p.msg.invalid-time"
+	ProblemCodeIssuerNotFound       protocol.ProblemErrorCode = "e.p.did.issuer-not-found"
+	ProblemCodeCredentialNotFound   protocol.ProblemErrorCode = "e.p.req.credential-not-found"
+	ProblemCodeCredentialRevoked    protocol.ProblemErrorCode = "e.p.req.credential-revoked"
+	ProblemCodeLinkExpired          protocol.ProblemErrorCode = "e.p.req.time.link-expired"
+	ProblemCodeLinkExhausted        protocol.ProblemErrorCode = "e.p.req.link-exhausted"
+	ProblemCodeLinkInactive         protocol.ProblemErrorCode = "e.p.req.link-inactive"
+	ProblemCodeLinkHolderNotAllowed protocol.ProblemErrorCode = "e.p.req.link-holder-not-allowed"
+	ProblemCodeLinkAllowlistClaimed protocol.ProblemErrorCode = "e.p.req.link-allowlist-claimed"
+	ProblemCodeLinkDerivation       protocol.ProblemErrorCode = "e.p.req.link-derivation"
+	ProblemCodeLinkInviteInvalid    protocol.ProblemErrorCode = "e.p.req.link-invite-invalid"
+	ProblemCodeProposalNotFound     protocol.ProblemErrorCode = "e.p.req.proposal-not-found"
+	ProblemCodeIssuanceRejected     protocol.ProblemErrorCode = "e.p.req.issuance-rejected"
+	ProblemCodePaymentNotVerified   protocol.ProblemErrorCode = "e.p.req.payment-not-verified"
+	ProblemCodeCredentialPending    protocol.ProblemErrorCode = "w.p.req.credential-pending"
+)
+
+// ProblemReportError is an error that is answered to the holder with a problem-report message.
+// ThreadID is the thread of the message that failed, From the issuer and To the holder.
+type ProblemReportError struct {
+	Err      error
+	ThreadID string
+	From     string
+	To       string
+}
+
+// Error implements error
+func (e *ProblemReportError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the original error
+func (e *ProblemReportError) Unwrap() error {
+	return e.Err
+}
+
+// NewProblemReport returns the problem-report message for the error.
+// The pthid of the message links to the failing thread when the error is a ProblemReportError.
+func NewProblemReport(err error) *protocol.ProblemReportMessage {
+	id := uuid.NewString()
+	report := &protocol.ProblemReportMessage{
+		ID:       id,
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.ProblemReportMessageType,
+		ThreadID: id,
+		Body: protocol.ProblemReportMessageBody{
+			Code:    problemCode(err),
+			Comment: err.Error(),
+		},
+	}
+
+	var problem *ProblemReportError
+	if errors.As(err, &problem) {
+		report.ParentThreadID = problem.ThreadID
+		report.From = problem.From
+		report.To = problem.To
+	}
+	return report
+}
+
+func problemCode(err error) protocol.ProblemErrorCode {
+	switch {
+	case errors.Is(err, ErrUnsupportedMediaType):
+		return ProblemCodeUnsupportedMediaType
+	case errors.Is(err, ErrAgentMessageReplayed):
+		return ProblemCodeMessageReplayed
+	case errors.Is(err, ErrAgentMessageExpired):
+		return ProblemCodeMessageExpired
+	case errors.Is(err, ErrAgentMessageTime):
+		return ProblemCodeMessageTime
+	case errors.Is(err, ErrIssuerNotFound):
+		return ProblemCodeIssuerNotFound
+	case errors.Is(err, ErrCredentialNotFound):
+		return ProblemCodeCredentialNotFound
+	case errors.Is(err, ErrCredentialRevoked):
+		return ProblemCodeCredentialRevoked
+	case errors.Is(err, ErrLinkAlreadyExpired):
+		return ProblemCodeLinkExpired
+	case errors.Is(err, ErrLinkMaxExceeded):
+		return ProblemCodeLinkExhausted
+	case errors.Is(err, ErrLinkInactive):
+		return ProblemCodeLinkInactive
+	case errors.Is(err, ErrLinkHolderNotAllowed), errors.Is(err, ErrLinkAllowlistKeyNotFound):
+		return ProblemCodeLinkHolderNotAllowed
+	case errors.Is(err, ErrLinkAllowlistEntryClaimed):
+		return ProblemCodeLinkAllowlistClaimed
+	case errors.Is(err, domain.ErrLinkDerivation):
+		return ProblemCodeLinkDerivation
+	case errors.Is(err, ErrLinkInviteRequired), errors.Is(err, ErrLinkInviteInvalid):
+		return ProblemCodeLinkInviteInvalid
+	case errors.Is(err, ErrCredentialProposalNotFound):
+		return ProblemCodeProposalNotFound
+	case errors.Is(err, ErrIssuanceRequestRejected):
+		return ProblemCodeIssuanceRejected
+	case errors.Is(err, ErrPaymentNotVerified):
+		return ProblemCodePaymentNotVerified
+	case errors.Is(err, ErrCredentialPending):
+		return ProblemCodeCredentialPending
+	default:
+		return ProblemCodeInvalidMessage
+	}
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProblemReport(t *testing.T) {
+	type expected struct {
+		code     protocol.ProblemErrorCode
+		comment  string
+		pthid    string
+		from, to string
+	}
+	type testConfig struct {
+		name     string
+		err      error
+		expected expected
+	}
+	for _, tc := range []testConfig{
+		{
+			name: "unsupported media type",
+			err: &ProblemReportError{
+				Err:      fmt.Errorf("%w '%s' for message type '%s'", ErrUnsupportedMediaType, "application/iden3comm-plain-json", protocol.CredentialFetchRequestMessageType),
+				ThreadID: "thread",
+				From:     "did:iden3:issuer",
+				To:       "did:iden3:holder",
+			},
+			expected: expected{
+				code:    ProblemCodeUnsupportedMediaType,
+				comment: "unsupported media type 'application/iden3comm-plain-json' for message type 'https://iden3-communication.io/credentials/1.0/fetch-request'",
+				pthid:   "thread",
+				from:    "did:iden3:issuer",
+				to:      "did:iden3:holder",
+			},
+		},
+		{
+			name: "revoked credential",
+			err:  &ProblemReportError{Err: ErrCredentialRevoked, ThreadID: "thread"},
+			expected: expected{
+				code:    ProblemCodeCredentialRevoked,
+				comment: ErrCredentialRevoked.Error(),
+				pthid:   "thread",
+			},
+		},
+		{
+			name: "expired link",
+			err:  &ProblemReportError{Err: fmt.Errorf("cannot issue: %w", ErrLinkAlreadyExpired), ThreadID: "auth-thread"},
+			expected: expected{
+				code:    ProblemCodeLinkExpired,
+				comment: "cannot issue: " + ErrLinkAlreadyExpired.Error(),
+				pthid:   "auth-thread",
+			},
+		},
+		{
+			name: "holder not on the link allowlist",
+			err:  &ProblemReportError{Err: ErrLinkHolderNotAllowed, ThreadID: "auth-thread"},
+			expected: expected{
+				code:    ProblemCodeLinkHolderNotAllowed,
+				comment: ErrLinkHolderNotAllowed.Error(),
+				pthid:   "auth-thread",
+			},
+		},
+		{
+			name: "invite required by the link",
+			err:  &ProblemReportError{Err: ErrLinkInviteRequired, ThreadID: "auth-thread"},
+			expected: expected{
+				code:    ProblemCodeLinkInviteInvalid,
+				comment: ErrLinkInviteRequired.Error(),
+				pthid:   "auth-thread",
+			},
+		},
+		{
+			name: "unknown error without thread",
+			err:  errors.New("cannot proceed with the given request"),
+			expected: expected{
+				code:    ProblemCodeInvalidMessage,
+				comment: "cannot proceed with the given request",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			report := NewProblemReport(tc.err)
+			require.NotNil(t, report)
+			assert.Equal(t, protocol.ProblemReportMessageType, report.Type)
+			assert.Equal(t, report.ID, report.ThreadID)
+			assert.Equal(t, tc.expected.code, report.Body.Code)
+			assert.Equal(t, tc.expected.comment, report.Body.Comment)
+			assert.Equal(t, tc.expected.pthid, report.ParentThreadID)
+			assert.Equal(t, tc.expected.from, report.From)
+			assert.Equal(t, tc.expected.to, report.To)
+			_, err := protocol.ParseProblemErrorCode(string(report.Body.Code))
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/repositories"
+)
+
+var (
+	ErrCredentialProposalNotFound = errors.New("there is no way to obtain the requested credentials") // ErrCredentialProposalNotFound means that no proposal rule matches the requested credentials
+	ErrInvalidProposalRule        = errors.New("invalid credential proposal rule")                    // ErrInvalidProposalRule means the rule lacks the link or url required by its type
+)
+
+type proposal struct {
+	repository       ports.ProposalRuleRepository
+	schemaRepository ports.SchemaRepository
+	linkService      ports.LinkService
+	identityService  ports.IdentityService
+	mediatypeManager ports.MediatypeManager
+	storage          *db.Storage
+	serverURL        string
+}
+
+// NewProposal returns the service that answers the credential proposal requests
+func NewProposal(repository ports.ProposalRuleRepository, schemaRepository ports.SchemaRepository, linkService ports.LinkService, identityService ports.IdentityService, mediatypeManager ports.MediatypeManager, storage *db.Storage, serverURL string) ports.ProposalService {
+	return &proposal{
+		repository:       repository,
+		schemaRepository: schemaRepository,
+		linkService:      linkService,
+		identityService:  identityService,
+		mediatypeManager: mediatypeManager,
+		storage:          storage,
+		serverURL:        serverURL,
+	}
+}
+
+// Agent answers a credential proposal request with a proposal for every rule of the requested credential types.
+// Rules of links that cannot issue credentials anymore are skipped.
+func (p *proposal) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	agent, err := p.agent(ctx, req, mediatype)
+	if err != nil {
+		return nil, newAgentProblem(req, err)
+	}
+	return agent, nil
+}
+
+func (p *proposal) agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*domain.Agent, error) {
+	if err := checkAgentRequest(ctx, p.mediatypeManager, p.identityService, req, mediatype); err != nil {
+		return nil, err
+	}
+	if req.Type != protocol.CredentialProposalRequestMessageType {
+		return nil, errors.New("invalid type")
+	}
+
+	body := &protocol.CredentialsProposalRequestBody{}
+	if err := json.Unmarshal(req.Body, body); err != nil {
+		log.Error(ctx, "unmarshalling credential proposal request body", "err", err)
+		return nil, fmt.Errorf("invalid credential proposal request body: %w", err)
+	}
+
+	proposals := make([]protocol.CredentialProposalInfo, 0)
+	for _, credential := range body.Credentials {
+		rules, err := p.repository.GetBySchemaType(ctx, p.storage.Pgx, *req.IssuerDID, credential.Type)
+		if err != nil {
+			log.Error(ctx, "loading credential proposal rules", "err", err, "type", credential.Type)
+			return nil, err
+		}
+		for _, rule := range rules {
+			info, err := p.proposalInfo(ctx, rule, credential)
+			if err != nil {
+				log.Warn(ctx, "skipping credential proposal rule", "err", err, "rule", rule.ID)
+				continue
+			}
+			proposals = append(proposals, *info)
+		}
+	}
+	if len(proposals) == 0 {
+		return nil, ErrCredentialProposalNotFound
+	}
+
+	return &domain.Agent{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialProposalMessageType,
+		ThreadID: req.ThreadID,
+		Body:     protocol.CredentialsProposalBody{Proposals: proposals},
+		From:     req.IssuerDID.String(),
+		To:       req.UserDID.String(),
+	}, nil
+}
+
+// proposalInfo returns the proposal of the rule. Link rules propose the universal link of the link offer.
+func (p *proposal) proposalInfo(ctx context.Context, rule *domain.ProposalRule, credential protocol.CredentialInfo) (*protocol.CredentialProposalInfo, error) {
+	info := &protocol.CredentialProposalInfo{
+		Credentials: []protocol.CredentialInfo{credential},
+	}
+	if rule.Description != nil {
+		info.Description = *rule.Description
+	}
+
+	switch rule.Type {
+	case domain.ProposalRuleTypeLink:
+		if rule.LinkID == nil {
+			return nil, ErrInvalidProposalRule
+		}
+		offer, err := p.linkService.CreateQRCode(ctx, rule.IssuerDID, *rule.LinkID, p.serverURL)
+		if err != nil {
+			return nil, err
+		}
+		info.Type = domain.CredentialProposalTypeLinkOffer
+		info.URL = offer.UniversalLink
+		if offer.Link.ValidUntil != nil {
+			info.Expiration = offer.Link.ValidUntil.UTC().Format(time.RFC3339)
+		}
+	case domain.ProposalRuleTypeWeb, domain.ProposalRuleTypeVerification:
+		if rule.URL == nil {
+			return nil, ErrInvalidProposalRule
+		}
+		info.Type = protocol.CredentialProposalTypeWeb
+		if rule.Type == domain.ProposalRuleTypeVerification {
+			info.Type = domain.CredentialProposalTypeVerification
+		}
+		info.URL = *rule.URL
+	default:
+		return nil, ErrInvalidProposalRule
+	}
+	return info, nil
+}
+
+// CreateRule validates and saves a proposal rule. Link rules must point to a link of the same schema.
+func (p *proposal) CreateRule(ctx context.Context, rule *domain.ProposalRule) error {
+	if _, err := p.schemaRepository.GetByID(ctx, rule.IssuerDID, rule.SchemaID); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return ErrSchemaNotFound
+		}
+		return err
+	}
+
+	switch rule.Type {
+	case domain.ProposalRuleTypeLink:
+		if rule.LinkID == nil {
+			return fmt.Errorf("%w: link rules require a link", ErrInvalidProposalRule)
+		}
+		link, err := p.linkService.GetByID(ctx, rule.IssuerDID, *rule.LinkID, p.serverURL)
+		if err != nil {
+			return err
+		}
+		if link.SchemaID != rule.SchemaID {
+			return fmt.Errorf("%w: the link is not of the rule schema", ErrInvalidProposalRule)
+		}
+		rule.URL = nil
+	case domain.ProposalRuleTypeWeb, domain.ProposalRuleTypeVerification:
+		if rule.URL == nil {
+			return fmt.Errorf("%w: %s rules require a url", ErrInvalidProposalRule, rule.Type)
+		}
+		if _, err := url.ParseRequestURI(*rule.URL); err != nil {
+			return ErrMalformedURL
+		}
+		rule.LinkID = nil
+	default:
+		return fmt.Errorf("%w: unknown type %s", ErrInvalidProposalRule, rule.Type)
+	}
+
+	return p.repository.Save(ctx, p.storage.Pgx, rule)
+}
+
+// GetRules returns the proposal rules of the issuer
+func (p *proposal) GetRules(ctx context.Context, issuerDID w3c.DID) ([]*domain.ProposalRule, error) {
+	return p.repository.GetAll(ctx, p.storage.Pgx, issuerDID)
+}
+
+// DeleteRule deletes a proposal rule
+func (p *proposal) DeleteRule(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	return p.repository.Delete(ctx, p.storage.Pgx, issuerDID, id)
+}
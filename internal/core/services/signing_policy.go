@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/config"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+const (
+	allSchemas = "*"
+	day        = 24 * time.Hour
+)
+
+var (
+	ErrApprovalRequired           = errors.New("the operation requires the approval of an approver")           // ErrApprovalRequired means the operation has to go through the approval queue
+	ErrDailyIssuanceLimitReached  = errors.New("the daily credential issuance limit has been reached")         // ErrDailyIssuanceLimitReached means the identity cannot issue more credentials today
+	ErrOutsidePublishWindow       = errors.New("states cannot be published outside the configured window")     // ErrOutsidePublishWindow means the state cannot be published at this time
+	ErrApprovalOperationMismatch  = errors.New("the approval request does not belong to this operation")       // ErrApprovalOperationMismatch means the approval request holds another operation
+	ErrApprovalRequestNotApproved = errors.New("the approval request has not been approved")                   // ErrApprovalRequestNotApproved means the approval request is not in approved status
+	ErrApprovalNotEnabled         = errors.New("the operation does not require approval, execute it directly") // ErrApprovalNotEnabled means there is no policy requiring approval for the operation
+)
+
+type signingPolicy struct {
+	cfg          config.SigningPolicy
+	claimsRepo   ports.ClaimRepository
+	approvalRepo ports.ApprovalRepository
+	storage      *db.Storage
+	now          func() time.Time
+}
+
+// NewSigningPolicy returns the service that evaluates the signing policies
+func NewSigningPolicy(cfg config.SigningPolicy, claimsRepo ports.ClaimRepository, approvalRepo ports.ApprovalRepository, storage *db.Storage) ports.SigningPolicyService {
+	return &signingPolicy{
+		cfg:          cfg,
+		claimsRepo:   claimsRepo,
+		approvalRepo: approvalRepo,
+		storage:      storage,
+		now:          time.Now,
+	}
+}
+
+// RequiresCredentialApproval returns true if credentials of the given schema must be approved before being signed
+func (p *signingPolicy) RequiresCredentialApproval(schema string) bool {
+	return slices.Contains(p.cfg.ApprovalSchemas, allSchemas) || slices.Contains(p.cfg.ApprovalSchemas, schema)
+}
+
+// RequiresPublishApproval returns true if states must be approved before being published
+func (p *signingPolicy) RequiresPublishApproval() bool {
+	return p.cfg.PublishApproval
+}
+
+// CheckCredentialIssuance returns an error if the credential cannot be signed.
+// Credentials of schemas that require approval can only be signed through an approved request.
+func (p *signingPolicy) CheckCredentialIssuance(ctx context.Context, req *ports.CreateClaimRequest) error {
+	if p.RequiresCredentialApproval(req.Schema) {
+		if req.ApprovalID == nil {
+			return ErrApprovalRequired
+		}
+		approval, err := p.approvalRepo.GetByID(ctx, p.storage.Pgx, *req.DID, *req.ApprovalID)
+		if err != nil {
+			log.Error(ctx, "getting approval request", "err", err, "id", req.ApprovalID)
+			return err
+		}
+		if approval.Operation != domain.ApprovalOperationCredentialIssuance {
+			return ErrApprovalOperationMismatch
+		}
+		if approval.Status != domain.ApprovalStatusApproved {
+			return ErrApprovalRequestNotApproved
+		}
+	}
+	return p.CheckDailyIssuance(ctx, *req.DID)
+}
+
+// CheckDailyIssuance returns ErrDailyIssuanceLimitReached if the identity already issued the configured
+// number of credentials in the current day (UTC).
+func (p *signingPolicy) CheckDailyIssuance(ctx context.Context, issuerDID w3c.DID) error {
+	if p.cfg.DailyIssuanceLimit == 0 {
+		return nil
+	}
+	issued, err := p.claimsRepo.CountIssuedSince(ctx, p.storage.Pgx, issuerDID, p.now().UTC().Truncate(day))
+	if err != nil {
+		log.Error(ctx, "counting issued credentials", "err", err, "did", issuerDID.String())
+		return err
+	}
+	if uint(issued) >= p.cfg.DailyIssuanceLimit {
+		log.Warn(ctx, "daily issuance limit reached", "did", issuerDID.String(), "limit", p.cfg.DailyIssuanceLimit)
+		return ErrDailyIssuanceLimitReached
+	}
+	return nil
+}
+
+// CheckStatePublish returns ErrOutsidePublishWindow if the current time is out of the publish window
+func (p *signingPolicy) CheckStatePublish(_ context.Context) error {
+	if p.cfg.PublishWindow == "" {
+		return nil
+	}
+	start, end, err := p.cfg.PublishWindowRange()
+	if err != nil {
+		return err
+	}
+	now := p.now().UTC()
+	offset := now.Sub(now.Truncate(day))
+	inWindow := offset >= start && offset < end
+	if end < start {
+		inWindow = offset >= start || offset < end
+	}
+	if !inWindow {
+		return ErrOutsidePublishWindow
+	}
+	return nil
+}
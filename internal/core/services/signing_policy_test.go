@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wakeup-labs/issuer-node/internal/config"
+)
+
+func TestSigningPolicy_CheckStatePublish(t *testing.T) {
+	ctx := context.Background()
+	type testConfig struct {
+		name     string
+		window   string
+		now      time.Time
+		expected error
+	}
+	for _, tc := range []testConfig{
+		{
+			name:   "no window",
+			window: "",
+			now:    time.Date(2024, 10, 21, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "inside the window",
+			window: "08:00-18:00",
+			now:    time.Date(2024, 10, 21, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "after the window",
+			window:   "08:00-18:00",
+			now:      time.Date(2024, 10, 21, 18, 0, 0, 0, time.UTC),
+			expected: ErrOutsidePublishWindow,
+		},
+		{
+			name:   "inside a window spanning midnight",
+			window: "22:00-06:00",
+			now:    time.Date(2024, 10, 21, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "outside a window spanning midnight",
+			window:   "22:00-06:00",
+			now:      time.Date(2024, 10, 21, 12, 0, 0, 0, time.UTC),
+			expected: ErrOutsidePublishWindow,
+		},
+		{
+			name:   "the window is in UTC",
+			window: "08:00-18:00",
+			now:    time.Date(2024, 10, 21, 20, 0, 0, 0, time.FixedZone("UTC+10", 10*60*60)),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := &signingPolicy{
+				cfg: config.SigningPolicy{PublishWindow: tc.window},
+				now: func() time.Time { return tc.now },
+			}
+			assert.ErrorIs(t, policy.CheckStatePublish(ctx), tc.expected)
+		})
+	}
+}
+
+func TestSigningPolicy_RequiresCredentialApproval(t *testing.T) {
+	const schema = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+
+	policy := NewSigningPolicy(config.SigningPolicy{}, nil, nil, nil)
+	assert.False(t, policy.RequiresCredentialApproval(schema))
+
+	policy = NewSigningPolicy(config.SigningPolicy{ApprovalSchemas: []string{schema}}, nil, nil, nil)
+	assert.True(t, policy.RequiresCredentialApproval(schema))
+	assert.False(t, policy.RequiresCredentialApproval("https://schema.org/other.json"))
+
+	policy = NewSigningPolicy(config.SigningPolicy{ApprovalSchemas: []string{"*"}}, nil, nil, nil)
+	assert.True(t, policy.RequiresCredentialApproval("https://schema.org/other.json"))
+}
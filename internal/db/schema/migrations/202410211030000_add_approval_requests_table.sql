@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE approval_requests
+(
+    id           UUID PRIMARY KEY NOT NULL,
+    issuer_id    text             NOT NULL,
+    operation    text             NOT NULL,
+    payload      jsonb            NOT NULL,
+    status       text             NOT NULL,
+    requested_by text             NOT NULL,
+    reviewed_by  text             NULL,
+    reason       text             NULL,
+    result_id    text             NULL,
+    created_at   timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reviewed_at  timestamptz      NULL,
+    CONSTRAINT approval_requests_identities_id_key foreign key (issuer_id) references identities (identifier)
+);
+
+CREATE INDEX approval_requests_issuer_id_status_idx ON approval_requests (issuer_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS approval_requests;
+-- +goose StatementEnd
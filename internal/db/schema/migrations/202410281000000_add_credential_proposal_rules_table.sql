@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_proposal_rules
+(
+    id          UUID PRIMARY KEY NOT NULL,
+    issuer_id   text             NOT NULL,
+    schema_id   uuid             NOT NULL,
+    type        text             NOT NULL,
+    link_id     uuid             NULL,
+    url         text             NULL,
+    description text             NULL,
+    created_at  timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_proposal_rules_identities_id_key foreign key (issuer_id) references identities (identifier),
+    CONSTRAINT credential_proposal_rules_schemas_id_key foreign key (schema_id) references schemas (id) ON DELETE CASCADE,
+    CONSTRAINT credential_proposal_rules_links_id_key foreign key (link_id) references links (id) ON DELETE CASCADE
+);
+
+CREATE INDEX credential_proposal_rules_issuer_id_schema_id_idx ON credential_proposal_rules (issuer_id, schema_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS credential_proposal_rules;
+-- +goose StatementEnd
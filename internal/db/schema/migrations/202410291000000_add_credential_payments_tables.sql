@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_prices
+(
+    id          UUID PRIMARY KEY NOT NULL,
+    issuer_id   text             NOT NULL,
+    schema_id   uuid             NOT NULL,
+    link_id     uuid             NULL,
+    amount      text             NOT NULL,
+    blockchain  text             NOT NULL,
+    network     text             NOT NULL,
+    recipient   text             NOT NULL,
+    description text             NULL,
+    created_at  timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_prices_identities_id_key foreign key (issuer_id) references identities (identifier),
+    CONSTRAINT credential_prices_schemas_id_key foreign key (schema_id) references schemas (id) ON DELETE CASCADE,
+    CONSTRAINT credential_prices_links_id_key foreign key (link_id) references links (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX credential_prices_schema_id_idx ON credential_prices (issuer_id, schema_id) WHERE link_id IS NULL;
+CREATE UNIQUE INDEX credential_prices_link_id_idx ON credential_prices (link_id) WHERE link_id IS NOT NULL;
+
+CREATE TABLE payment_requests
+(
+    id          UUID PRIMARY KEY NOT NULL,
+    issuer_id   text             NOT NULL,
+    user_id     text             NOT NULL,
+    link_id     uuid             NOT NULL,
+    amount      text             NOT NULL,
+    blockchain  text             NOT NULL,
+    network     text             NOT NULL,
+    chain_id    text             NOT NULL,
+    recipient   text             NOT NULL,
+    description text             NULL,
+    status      text             NOT NULL,
+    tx_id       text             NULL,
+    created_at  timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    paid_at     timestamptz      NULL,
+    CONSTRAINT payment_requests_identities_id_key foreign key (issuer_id) references identities (identifier),
+    CONSTRAINT payment_requests_links_id_key foreign key (link_id) references links (id) ON DELETE CASCADE,
+    CONSTRAINT payment_requests_tx_id_key UNIQUE (tx_id)
+);
+
+CREATE INDEX payment_requests_issuer_id_user_id_link_id_idx ON payment_requests (issuer_id, user_id, link_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment_requests;
+DROP TABLE IF EXISTS credential_prices;
+-- +goose StatementEnd
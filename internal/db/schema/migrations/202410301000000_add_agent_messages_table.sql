@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE agent_messages
+(
+    id           UUID PRIMARY KEY NOT NULL,
+    message_id   text             NOT NULL,
+    thread_id    text             NOT NULL,
+    issuer_id    text             NOT NULL,
+    holder_id    text             NOT NULL,
+    direction    text             NOT NULL,
+    type         text             NOT NULL,
+    media_type   text             NOT NULL,
+    body         jsonb            NULL,
+    created_time timestamptz      NULL,
+    expires_time timestamptz      NULL,
+    created_at   timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT agent_messages_identities_id_key foreign key (issuer_id) references identities (identifier),
+    CONSTRAINT agent_messages_message_id_key UNIQUE (issuer_id, direction, message_id)
+);
+
+CREATE INDEX agent_messages_issuer_id_thread_id_idx ON agent_messages (issuer_id, thread_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS agent_messages;
+-- +goose StatementEnd
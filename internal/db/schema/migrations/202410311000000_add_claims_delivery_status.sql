@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE claims
+    ADD COLUMN delivery_status     text        NULL,
+    ADD COLUMN delivery_thread_id  text        NULL,
+    ADD COLUMN delivery_updated_at timestamptz NULL;
+
+CREATE INDEX claims_identifier_delivery_status_idx ON claims (identifier, delivery_status);
+CREATE INDEX claims_identifier_delivery_thread_id_idx ON claims (identifier, delivery_thread_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_identifier_delivery_thread_id_idx;
+DROP INDEX IF EXISTS claims_identifier_delivery_status_idx;
+ALTER TABLE claims
+    DROP COLUMN IF EXISTS delivery_status,
+    DROP COLUMN IF EXISTS delivery_thread_id,
+    DROP COLUMN IF EXISTS delivery_updated_at;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE claims
+    ADD COLUMN issuance_mode text NOT NULL DEFAULT 'offchain',
+    ADD COLUMN onchain_tx_id text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE claims
+    DROP COLUMN IF EXISTS issuance_mode,
+    DROP COLUMN IF EXISTS onchain_tx_id;
+-- +goose StatementEnd
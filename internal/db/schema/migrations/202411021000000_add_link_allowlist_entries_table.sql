@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE link_allowlist_entries
+(
+    id                    UUID PRIMARY KEY NOT NULL,
+    link_id               uuid             NOT NULL,
+    key_type              text             NOT NULL,
+    key                   text             NOT NULL,
+    credential_attributes jsonb            NOT NULL,
+    holder_id             text             NULL,
+    claim_id              uuid             NULL,
+    claimed_at            timestamptz      NULL,
+    created_at            timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT link_allowlist_entries_links_id_key foreign key (link_id) references links (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX link_allowlist_entries_key_idx ON link_allowlist_entries (link_id, key_type, key);
+CREATE UNIQUE INDEX link_allowlist_entries_holder_id_idx ON link_allowlist_entries (link_id, holder_id) WHERE holder_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_allowlist_entries;
+-- +goose StatementEnd
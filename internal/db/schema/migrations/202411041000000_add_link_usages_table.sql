@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE links
+    ADD COLUMN issued_claims integer NOT NULL DEFAULT 0;
+
+UPDATE links
+SET issued_claims = (SELECT count(claims.id) FROM claims WHERE claims.link_id = links.id AND claims.identifier = links.issuer_id);
+
+CREATE TABLE link_usages
+(
+    id         UUID PRIMARY KEY NOT NULL,
+    link_id    uuid             NOT NULL,
+    holder_id  text             NOT NULL,
+    claim_id   uuid             NOT NULL,
+    created_at timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT link_usages_links_id_key foreign key (link_id) references links (id) ON DELETE CASCADE
+);
+
+CREATE INDEX link_usages_link_id_idx ON link_usages (link_id, created_at);
+
+INSERT INTO link_usages (id, link_id, holder_id, claim_id, created_at)
+SELECT gen_random_uuid(), claims.link_id, claims.other_identifier, claims.id, claims.created_at
+FROM claims
+         JOIN links ON links.id = claims.link_id AND links.issuer_id = claims.identifier
+WHERE claims.other_identifier IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_usages;
+ALTER TABLE links
+    DROP COLUMN IF EXISTS issued_claims;
+-- +goose StatementEnd
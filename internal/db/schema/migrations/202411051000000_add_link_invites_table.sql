@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE link_invites
+(
+    id         UUID PRIMARY KEY NOT NULL,
+    link_id    uuid             NOT NULL,
+    code       text             NOT NULL,
+    max_uses   integer          NOT NULL,
+    uses       integer          NOT NULL DEFAULT 0,
+    revoked_at timestamptz      NULL,
+    created_at timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT link_invites_links_id_key foreign key (link_id) references links (id) ON DELETE CASCADE,
+    CONSTRAINT link_invites_uses_check CHECK (uses >= 0 AND uses <= max_uses)
+);
+
+CREATE UNIQUE INDEX link_invites_code_idx ON link_invites (link_id, code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_invites;
+-- +goose StatementEnd
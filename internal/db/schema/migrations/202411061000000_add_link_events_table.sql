@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE link_events
+(
+    id         UUID PRIMARY KEY NOT NULL,
+    link_id    uuid             NOT NULL,
+    type       text             NOT NULL,
+    holder_id  text             NULL,
+    created_at timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT link_events_links_id_key foreign key (link_id) references links (id) ON DELETE CASCADE
+);
+
+CREATE INDEX link_events_link_id_created_at_idx ON link_events (link_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_events;
+-- +goose StatementEnd
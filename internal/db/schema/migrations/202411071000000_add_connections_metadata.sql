@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE connections
+    ADD COLUMN metadata jsonb  NOT NULL DEFAULT '{}'::jsonb,
+    ADD COLUMN tags     text[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX connections_metadata_idx ON connections USING gin (metadata jsonb_path_ops);
+CREATE INDEX connections_tags_idx ON connections USING gin (tags);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS connections_tags_idx;
+DROP INDEX IF EXISTS connections_metadata_idx;
+ALTER TABLE connections
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS tags;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_messages
+(
+    id            UUID PRIMARY KEY NOT NULL,
+    connection_id uuid             NOT NULL,
+    issuer_id     text             NOT NULL,
+    thread_id     text             NOT NULL,
+    type          text             NOT NULL,
+    status        text             NOT NULL,
+    reason        text             NULL,
+    created_at    timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT connection_messages_connections_id_key foreign key (connection_id) references connections (id) ON DELETE CASCADE
+);
+
+CREATE INDEX connection_messages_connection_id_created_at_idx ON connection_messages (connection_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS connection_messages;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_events
+(
+    id            UUID PRIMARY KEY NOT NULL,
+    connection_id uuid             NOT NULL,
+    type          text             NOT NULL,
+    session_id    uuid             NULL,
+    user_doc      jsonb            NULL,
+    push_service  jsonb            NULL,
+    created_at    timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT connection_events_connections_id_key foreign key (connection_id) references connections (id) ON DELETE CASCADE
+);
+
+CREATE INDEX connection_events_connection_id_created_at_idx ON connection_events (connection_id, created_at);
+
+-- the history of the existing connections starts with their current document and the sessions already recorded
+INSERT INTO connection_events (id, connection_id, type, user_doc, push_service, created_at)
+SELECT gen_random_uuid(), connections.id, 'created', connections.user_doc,
+       CASE WHEN jsonb_typeof(connections.user_doc -> 'service') = 'array' THEN
+           (SELECT service FROM jsonb_array_elements(connections.user_doc -> 'service') AS service
+            WHERE service ->> 'type' = 'push-notification' LIMIT 1)
+       END,
+       connections.created_at
+FROM connections;
+
+INSERT INTO connection_events (id, connection_id, type, session_id, created_at)
+SELECT gen_random_uuid(), connection_id, 'authenticated', session_id, created_at
+FROM user_authentications;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS connection_events;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE links ADD COLUMN invite_only boolean NOT NULL DEFAULT false;
+
+-- the links with invites were invite-only
+UPDATE links SET invite_only = true WHERE EXISTS(SELECT 1 FROM link_invites WHERE link_invites.link_id = links.id);
+
+CREATE TABLE link_invite_uses
+(
+    invite_id  uuid        NOT NULL,
+    holder_id  text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (invite_id, holder_id),
+    CONSTRAINT link_invite_uses_link_invites_id_key foreign key (invite_id) references link_invites (id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_invite_uses;
+ALTER TABLE links DROP COLUMN IF EXISTS invite_only;
+-- +goose StatementEnd
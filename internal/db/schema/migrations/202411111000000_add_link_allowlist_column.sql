@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE links ADD COLUMN allowlist boolean NOT NULL DEFAULT false;
+
+-- the links with allowlist entries had the allowlist enabled
+UPDATE links SET allowlist = true WHERE EXISTS(SELECT 1 FROM link_allowlist_entries WHERE link_allowlist_entries.link_id = links.id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN IF EXISTS allowlist;
+-- +goose StatementEnd
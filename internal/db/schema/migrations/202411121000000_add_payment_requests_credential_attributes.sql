@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE payment_requests ADD COLUMN credential_attributes jsonb NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment_requests DROP COLUMN IF EXISTS credential_attributes;
+-- +goose StatementEnd
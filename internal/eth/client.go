@@ -514,6 +514,11 @@ func (c *Client) getGasPrice(ctx context.Context) (*big.Int, error) {
 	return gasPrice, err
 }
 
+// Address returns the ethereum address of the kms key
+func (c *Client) Address(k kms.KeyID) (common.Address, error) {
+	return c.getAddress(k)
+}
+
 // getAddress - get address by keyID
 func (c *Client) getAddress(k kms.KeyID) (common.Address, error) {
 	if c.kms == nil {
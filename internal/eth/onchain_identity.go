@@ -0,0 +1,111 @@
+package eth
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// OnchainIdentityABI is the part of the ABI of the iden3 on-chain identity contracts used to issue credentials
+const OnchainIdentityABI = `[
+	{
+		"inputs": [
+			{"internalType": "uint256", "name": "hashIndex", "type": "uint256"},
+			{"internalType": "uint256", "name": "hashValue", "type": "uint256"}
+		],
+		"name": "addClaimHashAndTransit",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"internalType": "uint256", "name": "claimIndexHash", "type": "uint256"}
+		],
+		"name": "getClaimProofWithStateInfo",
+		"outputs": [
+			{
+				"components": [
+					{"internalType": "uint256", "name": "root", "type": "uint256"},
+					{"internalType": "bool", "name": "existence", "type": "bool"},
+					{"internalType": "uint256[]", "name": "siblings", "type": "uint256[]"},
+					{"internalType": "uint256", "name": "index", "type": "uint256"},
+					{"internalType": "uint256", "name": "value", "type": "uint256"},
+					{"internalType": "bool", "name": "auxExistence", "type": "bool"},
+					{"internalType": "uint256", "name": "auxIndex", "type": "uint256"},
+					{"internalType": "uint256", "name": "auxValue", "type": "uint256"}
+				],
+				"internalType": "struct SmtLib.Proof",
+				"name": "",
+				"type": "tuple"
+			},
+			{
+				"components": [
+					{"internalType": "uint256", "name": "state", "type": "uint256"},
+					{"internalType": "uint256", "name": "claimsRoot", "type": "uint256"},
+					{"internalType": "uint256", "name": "revocationsRoot", "type": "uint256"},
+					{"internalType": "uint256", "name": "rootsRoot", "type": "uint256"}
+				],
+				"internalType": "struct IdentityLib.StateInfo",
+				"name": "",
+				"type": "tuple"
+			}
+		],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+// OnchainClaimProof is the proof of a claim in the claims tree of an on-chain identity contract
+type OnchainClaimProof struct {
+	Root         *big.Int
+	Existence    bool
+	Siblings     []*big.Int
+	Index        *big.Int
+	Value        *big.Int
+	AuxExistence bool
+	AuxIndex     *big.Int
+	AuxValue     *big.Int
+}
+
+// OnchainStateInfo is the latest state of an on-chain identity contract and the roots of its trees
+type OnchainStateInfo struct {
+	State           *big.Int
+	ClaimsRoot      *big.Int
+	RevocationsRoot *big.Int
+	RootsRoot       *big.Int
+}
+
+// OnchainIdentity is a binding of an on-chain identity contract
+type OnchainIdentity struct {
+	contract *bind.BoundContract
+}
+
+// NewOnchainIdentity binds the on-chain identity contract deployed at address
+func NewOnchainIdentity(address common.Address, backend bind.ContractBackend) (*OnchainIdentity, error) {
+	parsed, err := abi.JSON(strings.NewReader(OnchainIdentityABI))
+	if err != nil {
+		return nil, err
+	}
+	return &OnchainIdentity{contract: bind.NewBoundContract(address, parsed, backend, backend, backend)}, nil
+}
+
+// AddClaimHashAndTransit adds the claim hashes to the claims tree of the identity and transits its state in the same transaction
+func (o *OnchainIdentity) AddClaimHashAndTransit(opts *bind.TransactOpts, hashIndex, hashValue *big.Int) (*types.Transaction, error) {
+	return o.contract.Transact(opts, "addClaimHashAndTransit", hashIndex, hashValue)
+}
+
+// GetClaimProofWithStateInfo returns the proof of the claim in the claims tree of the latest state of the identity
+func (o *OnchainIdentity) GetClaimProofWithStateInfo(opts *bind.CallOpts, claimIndexHash *big.Int) (OnchainClaimProof, OnchainStateInfo, error) {
+	var out []interface{}
+	if err := o.contract.Call(opts, &out, "getClaimProofWithStateInfo", claimIndexHash); err != nil {
+		return OnchainClaimProof{}, OnchainStateInfo{}, err
+	}
+	proof := *abi.ConvertType(out[0], new(OnchainClaimProof)).(*OnchainClaimProof)
+	stateInfo := *abi.ConvertType(out[1], new(OnchainStateInfo)).(*OnchainStateInfo)
+	return proof, stateInfo, nil
+}
@@ -0,0 +1,218 @@
+package gateways
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/wakeup-labs/issuer-node/internal/common"
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/eth"
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/network"
+	"github.com/wakeup-labs/issuer-node/internal/revocationstatus"
+)
+
+// OnchainIssuerBackend is the part of the ethereum client used to issue credentials on chain
+type OnchainIssuerBackend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// OnchainIssuer publishes the claims of the credentials issued on chain to the on-chain identity contract
+// of their issuer and builds the proofs of the credentials from the state of the contract.
+type OnchainIssuer struct {
+	publishingKeyID kms.KeyID
+	settings        func(resolverPrefix string) network.OnchainIssuanceSettings
+	backends        func(resolverPrefix string) (OnchainIssuerBackend, error)
+	signers         func(ctx context.Context, resolverPrefix string, keyID kms.KeyID) (*bind.TransactOpts, error)
+}
+
+// NewOnchainIssuer returns an on-chain issuer that sends the transactions with the ethereum clients of the network resolver.
+// publishingKeyPath is the default publishing key, used for the networks without an on-chain issuance publishing key.
+func NewOnchainIssuer(networkResolver network.Resolver, publishingKeyPath string) *OnchainIssuer {
+	return &OnchainIssuer{
+		publishingKeyID: kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath},
+		settings:        networkResolver.GetOnchainIssuanceSettings,
+		backends: func(resolverPrefix string) (OnchainIssuerBackend, error) {
+			client, err := networkResolver.GetEthClient(resolverPrefix)
+			if err != nil {
+				return nil, err
+			}
+			return client.GetEthereumClient(), nil
+		},
+		signers: func(ctx context.Context, resolverPrefix string, keyID kms.KeyID) (*bind.TransactOpts, error) {
+			client, err := networkResolver.GetEthClient(resolverPrefix)
+			if err != nil {
+				return nil, err
+			}
+			return client.CreateTxOpts(ctx, keyID)
+		},
+	}
+}
+
+// IsEnabled returns true if the identity has an on-chain identity contract to issue credentials
+func (o *OnchainIssuer) IsEnabled(issuerDID w3c.DID) bool {
+	_, _, err := o.contractAddress(issuerDID)
+	return err == nil
+}
+
+// CredentialStatus returns the status of the credentials issued on chain by the identity,
+// which is resolved by its on-chain identity contract
+func (o *OnchainIssuer) CredentialStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.CredentialStatus, error) {
+	resolverPrefix, address, err := o.contractAddress(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := o.backends(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "getting the on-chain issuance network client", "err", err, "network", resolverPrefix)
+		return nil, err
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		log.Error(ctx, "getting the chain id", "err", err, "network", resolverPrefix)
+		return nil, err
+	}
+	return revocationstatus.OnchainIdentityCredentialStatus(issuerDID, nonce, address, chainID.String()), nil
+}
+
+// Publish adds the claim to the claims tree of the on-chain identity contract of the issuer and returns the
+// transaction ID. The transaction is not awaited, the proof of the claim is available once it is mined.
+func (o *OnchainIssuer) Publish(ctx context.Context, issuerDID w3c.DID, claim *core.Claim) (string, error) {
+	resolverPrefix, address, err := o.contractAddress(issuerDID)
+	if err != nil {
+		return "", err
+	}
+	hashIndex, hashValue, err := claim.HiHv()
+	if err != nil {
+		return "", err
+	}
+	backend, err := o.backends(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "getting the on-chain issuance network client", "err", err, "network", resolverPrefix)
+		return "", err
+	}
+	contract, err := eth.NewOnchainIdentity(address, backend)
+	if err != nil {
+		return "", err
+	}
+
+	keyID := o.publishingKeyID
+	if key := o.settings(resolverPrefix).PublishingKey; key != "" {
+		keyID.ID = key
+	}
+	opts, err := o.signers(ctx, resolverPrefix, keyID)
+	if err != nil {
+		log.Error(ctx, "failed to create tx opts", "err", err)
+		return "", err
+	}
+	opts.Context = ctx
+
+	tx, err := contract.AddClaimHashAndTransit(opts, hashIndex, hashValue)
+	if err != nil {
+		log.Error(ctx, "adding the claim to the on-chain identity", "err", err, "contract", address.Hex())
+		return "", err
+	}
+	log.Info(ctx, "claim published on chain", "tx", tx.Hash().Hex(), "contract", address.Hex())
+	return tx.Hash().Hex(), nil
+}
+
+// Proof returns the MTP proof of the claim in the latest state of the on-chain identity contract of the issuer.
+// It returns domain.ErrOnchainClaimNotPublished if the claim is not in the claims tree of the contract yet.
+func (o *OnchainIssuer) Proof(ctx context.Context, issuerDID w3c.DID, claim *core.Claim) (*verifiable.Iden3SparseMerkleTreeProof, error) {
+	resolverPrefix, address, err := o.contractAddress(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	hashIndex, err := claim.HIndex()
+	if err != nil {
+		return nil, err
+	}
+	backend, err := o.backends(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "getting the on-chain issuance network client", "err", err, "network", resolverPrefix)
+		return nil, err
+	}
+	contract, err := eth.NewOnchainIdentity(address, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	onchainProof, stateInfo, err := contract.GetClaimProofWithStateInfo(&bind.CallOpts{Context: ctx}, hashIndex)
+	if err != nil {
+		log.Error(ctx, "getting the claim proof from the on-chain identity", "err", err, "contract", address.Hex())
+		return nil, err
+	}
+	if !onchainProof.Existence {
+		return nil, domain.ErrOnchainClaimNotPublished
+	}
+
+	siblings := make([]*merkletree.Hash, 0, len(onchainProof.Siblings))
+	for _, sibling := range onchainProof.Siblings {
+		hash, err := merkletree.NewHashFromBigInt(sibling)
+		if err != nil {
+			return nil, err
+		}
+		siblings = append(siblings, hash)
+	}
+	mtp, err := merkletree.NewProofFromData(true, siblings, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := onchainStateHexes(stateInfo.State, stateInfo.ClaimsRoot, stateInfo.RevocationsRoot, stateInfo.RootsRoot)
+	if err != nil {
+		return nil, err
+	}
+	coreClaimHex, err := claim.Hex()
+	if err != nil {
+		return nil, err
+	}
+
+	return &verifiable.Iden3SparseMerkleTreeProof{
+		Type: verifiable.Iden3SparseMerkleTreeProofType,
+		IssuerData: verifiable.IssuerData{
+			ID: issuerDID.String(),
+			State: verifiable.State{
+				Value:              &state[0],
+				ClaimsTreeRoot:     &state[1],
+				RevocationTreeRoot: &state[2],
+				RootOfRoots:        &state[3],
+			},
+		},
+		CoreClaim: coreClaimHex,
+		MTP:       mtp,
+	}, nil
+}
+
+func (o *OnchainIssuer) contractAddress(issuerDID w3c.DID) (string, ethCommon.Address, error) {
+	resolverPrefix, err := common.ResolverPrefix(&issuerDID)
+	if err != nil {
+		return "", ethCommon.Address{}, err
+	}
+	address, ok := o.settings(resolverPrefix).ContractFor(issuerDID.String())
+	if !ok {
+		return "", ethCommon.Address{}, domain.ErrOnchainIssuanceNotConfigured
+	}
+	return resolverPrefix, address, nil
+}
+
+func onchainStateHexes(values ...*big.Int) ([]string, error) {
+	hexes := make([]string, 0, len(values))
+	for _, value := range values {
+		hash, err := merkletree.NewHashFromBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		hexes = append(hexes, hash.Hex())
+	}
+	return hexes, nil
+}
@@ -0,0 +1,175 @@
+package gateways
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/eth"
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+	"github.com/wakeup-labs/issuer-node/internal/network"
+)
+
+// stubContract returns the runtime code of a contract that returns data for any call
+func stubContract(data []byte) []byte {
+	size := []byte{byte(len(data) >> 8), byte(len(data))}
+	code := []byte{0x61, size[0], size[1], 0x60, 0x0e, 0x60, 0x00, 0x39, 0x61, size[0], size[1], 0x60, 0x00, 0xf3} // CODECOPY(0, 14, size) RETURN(0, size)
+	return append(code, data...)
+}
+
+func TestOnchainIssuer(t *testing.T) {
+	ctx := context.Background()
+	parsed, err := abi.JSON(strings.NewReader(eth.OnchainIdentityABI))
+	require.NoError(t, err)
+	getProof := parsed.Methods["getClaimProofWithStateInfo"]
+
+	claim, err := core.NewClaim(core.SchemaHash{1}, core.WithIndexDataInts(big.NewInt(10), nil), core.WithRevocationNonce(5))
+	require.NoError(t, err)
+	hashIndex, hashValue, err := claim.HiHv()
+	require.NoError(t, err)
+
+	stateInfo := eth.OnchainStateInfo{State: big.NewInt(100), ClaimsRoot: big.NewInt(101), RevocationsRoot: big.NewInt(0), RootsRoot: big.NewInt(103)}
+	published, err := getProof.Outputs.Pack(eth.OnchainClaimProof{
+		Root:      big.NewInt(101),
+		Existence: true,
+		Siblings:  []*big.Int{big.NewInt(0), big.NewInt(7), big.NewInt(0)},
+		Index:     hashIndex,
+		Value:     hashValue,
+		AuxIndex:  big.NewInt(0),
+		AuxValue:  big.NewInt(0),
+	}, stateInfo)
+	require.NoError(t, err)
+	notPublished, err := getProof.Outputs.Pack(eth.OnchainClaimProof{
+		Root:     big.NewInt(101),
+		Siblings: []*big.Int{},
+		Index:    hashIndex,
+		Value:    big.NewInt(0),
+		AuxIndex: big.NewInt(0),
+		AuxValue: big.NewInt(0),
+	}, stateInfo)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	publisher := crypto.PubkeyToAddress(key.PublicKey)
+	identityContract := common.HexToAddress("0x5fbdb2315678afecb367f032d93f642f64180aa3")
+	pendingContract := common.HexToAddress("0xe7f1725e7734ce288f8367e1bb143e90bb3f0512")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		publisher:        {Balance: big.NewInt(1e18)},
+		identityContract: {Code: stubContract(published)},
+		pendingContract:  {Code: stubContract(notPublished)},
+	})
+	defer func() { require.NoError(t, backend.Close()) }()
+	client := backend.Client()
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+
+	// the DIDs of the on-chain identities are derived from the addresses of their contracts
+	didType, err := core.BuildDIDType(core.DIDMethodIden3, core.Polygon, core.Amoy)
+	require.NoError(t, err)
+	issuerDID, err := core.ParseDIDFromID(core.NewID(didType, core.GenesisFromEthAddress(identityContract)))
+	require.NoError(t, err)
+	pendingDID, err := core.ParseDIDFromID(core.NewID(didType, core.GenesisFromEthAddress(pendingContract)))
+	require.NoError(t, err)
+	otherDID, err := w3c.ParseDID("did:iden3:polygon:amoy:x7Z95VkUuyo6mqraJw2VGwCfqTzdqhM1RVjRHzcpK")
+	require.NoError(t, err)
+
+	settings := network.OnchainIssuanceSettings{
+		PublishingKey: "onchain-pbkey",
+		Identities: map[string]string{
+			issuerDID.String():  identityContract.Hex(),
+			pendingDID.String(): pendingContract.Hex(),
+		},
+	}
+	require.NoError(t, settings.Validate())
+
+	var signedWith kms.KeyID
+	issuer := &OnchainIssuer{
+		publishingKeyID: kms.KeyID{Type: kms.KeyTypeEthereum, ID: "pbkey"},
+		settings:        func(string) network.OnchainIssuanceSettings { return settings },
+		backends:        func(string) (OnchainIssuerBackend, error) { return client, nil },
+		signers: func(_ context.Context, _ string, keyID kms.KeyID) (*bind.TransactOpts, error) {
+			signedWith = keyID
+			return bind.NewKeyedTransactorWithChainID(key, chainID)
+		},
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		assert.False(t, issuer.IsEnabled(*otherDID))
+		_, err := issuer.Publish(ctx, *otherDID, claim)
+		assert.ErrorIs(t, err, domain.ErrOnchainIssuanceNotConfigured)
+		_, err = issuer.Proof(ctx, *otherDID, claim)
+		assert.ErrorIs(t, err, domain.ErrOnchainIssuanceNotConfigured)
+	})
+
+	t.Run("credential status", func(t *testing.T) {
+		assert.True(t, issuer.IsEnabled(*issuerDID))
+		status, err := issuer.CredentialStatus(ctx, *issuerDID, 5)
+		require.NoError(t, err)
+		assert.Equal(t, verifiable.Iden3OnchainSparseMerkleTreeProof2023, status.Type)
+		assert.Equal(t, uint64(5), status.RevocationNonce)
+		assert.Equal(t, issuerDID.String()+"/credentialStatus?revocationNonce=5&contractAddress="+chainID.String()+":"+identityContract.Hex(), status.ID)
+	})
+
+	t.Run("publish", func(t *testing.T) {
+		txID, err := issuer.Publish(ctx, *issuerDID, claim)
+		require.NoError(t, err)
+		assert.Equal(t, "onchain-pbkey", signedWith.ID)
+		backend.Commit()
+
+		receipt, err := client.TransactionReceipt(ctx, common.HexToHash(txID))
+		require.NoError(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+		tx, _, err := client.TransactionByHash(ctx, common.HexToHash(txID))
+		require.NoError(t, err)
+		assert.Equal(t, identityContract, *tx.To())
+		method, err := parsed.MethodById(tx.Data())
+		require.NoError(t, err)
+		assert.Equal(t, "addClaimHashAndTransit", method.Name)
+		args, err := method.Inputs.Unpack(tx.Data()[4:])
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{hashIndex, hashValue}, args)
+	})
+
+	t.Run("proof", func(t *testing.T) {
+		proof, err := issuer.Proof(ctx, *issuerDID, claim)
+		require.NoError(t, err)
+		assert.Equal(t, verifiable.Iden3SparseMerkleTreeProofType, proof.Type)
+		assert.Equal(t, issuerDID.String(), proof.IssuerData.ID)
+		hex := func(i int64) string {
+			h, err := merkletree.NewHashFromBigInt(big.NewInt(i))
+			require.NoError(t, err)
+			return h.Hex()
+		}
+		assert.Equal(t, hex(100), *proof.IssuerData.State.Value)
+		assert.Equal(t, hex(101), *proof.IssuerData.State.ClaimsTreeRoot)
+		assert.Equal(t, hex(0), *proof.IssuerData.State.RevocationTreeRoot)
+		assert.Equal(t, hex(103), *proof.IssuerData.State.RootOfRoots)
+		coreClaimHex, err := claim.Hex()
+		require.NoError(t, err)
+		assert.Equal(t, coreClaimHex, proof.CoreClaim)
+		assert.True(t, proof.MTP.Existence)
+		assert.Equal(t, hex(7), proof.MTP.AllSiblings()[1].Hex())
+	})
+
+	t.Run("not published yet", func(t *testing.T) {
+		_, err := issuer.Proof(ctx, *pendingDID, claim)
+		assert.ErrorIs(t, err, domain.ErrOnchainClaimNotPublished)
+	})
+}
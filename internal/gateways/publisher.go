@@ -63,10 +63,11 @@ type publisher struct {
 	publisherGateway      PublisherGateway
 	pendingTransactions   *syncttlmap.TTLMap
 	notificationPublisher pubsub.Publisher
+	signingPolicy         ports.SigningPolicyService
 }
 
 // NewPublisher - Constructor
-func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimService, mtService ports.MtService, kms kms.KMSType, transactionService ports.TransactionService, zkService ports.ZKGenerator, publisherGateway PublisherGateway, networkResolver *network.Resolver, notificationPublisher pubsub.Publisher) *publisher {
+func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimService, mtService ports.MtService, kms kms.KMSType, transactionService ports.TransactionService, zkService ports.ZKGenerator, publisherGateway PublisherGateway, networkResolver *network.Resolver, notificationPublisher pubsub.Publisher, signingPolicy ports.SigningPolicyService) *publisher {
 	pendingTransactions := syncttlmap.New(ttl)
 	pendingTransactions.CleaningBackground(transactionCleanup)
 
@@ -82,10 +83,15 @@ func NewPublisher(storage *db.Storage, identityService ports.IdentityService, cl
 		networkResolver:       networkResolver,
 		pendingTransactions:   pendingTransactions,
 		notificationPublisher: notificationPublisher,
+		signingPolicy:         signingPolicy,
 	}
 }
 
 func (p *publisher) PublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error) {
+	if err := p.signingPolicy.CheckStatePublish(ctx); err != nil {
+		return nil, err
+	}
+
 	idStr := identifier.String()
 	processingEntity := p.pendingTransactions.Load(idStr)
 	if processingEntity != nil {
@@ -102,6 +108,10 @@ func (p *publisher) PublishState(ctx context.Context, identifier *w3c.DID) (*dom
 }
 
 func (p *publisher) RetryPublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error) {
+	if err := p.signingPolicy.CheckStatePublish(ctx); err != nil {
+		return nil, err
+	}
+
 	idStr := identifier.String()
 	processingEntity := p.pendingTransactions.Load(idStr)
 	if processingEntity != nil {
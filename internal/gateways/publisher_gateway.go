@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethCore "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/iden3/contracts-abi/state/go/abi"
 	core "github.com/iden3/go-iden3-core/v2"
@@ -16,10 +20,12 @@ import (
 
 	"github.com/wakeup-labs/issuer-node/internal/common"
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/event"
 	"github.com/wakeup-labs/issuer-node/internal/eth"
 	"github.com/wakeup-labs/issuer-node/internal/kms"
 	"github.com/wakeup-labs/issuer-node/internal/log"
 	"github.com/wakeup-labs/issuer-node/internal/network"
+	"github.com/wakeup-labs/issuer-node/internal/pubsub"
 )
 
 // PublisherEthGateway interact with blockchain
@@ -29,27 +35,46 @@ type PublisherEthGateway struct {
 	publishingKeyID       kms.KeyID
 	ethRPCResponseTimeout time.Duration
 	networkResolver       network.Resolver
+	publisher             pubsub.Publisher
+	lowBalanceAlerts      map[ethCommon.Address]time.Time
+	publishingSettings    func(resolverPrefix string) network.PublishingSettings
 }
 
-const rpcTimeout = 10 * time.Second
+// PublishingAccountsBackend is the part of the ethereum client used to check the funds of the publishing accounts
+type PublishingAccountsBackend interface {
+	Address(keyID kms.KeyID) (ethCommon.Address, error)
+	BalanceAt(ctx context.Context, address ethCommon.Address) (*big.Int, error)
+}
+
+const (
+	rpcTimeout              = 10 * time.Second
+	lowBalanceAlertInterval = time.Hour
+)
+
+// ErrNoFundedPublishingAccount means none of the publishing accounts of the network has enough funds
+var ErrNoFundedPublishingAccount = errors.New("there is no publishing account with enough funds")
 
-// NewPublisherEthGateway creates new instance of publishing service
-func NewPublisherEthGateway(resolver network.Resolver, keyStore *kms.KMS, publishingKeyPath string) (*PublisherEthGateway, error) {
+// NewPublisherEthGateway creates new instance of publishing service.
+// publishingKeyPath is the default publishing key, used for the networks without publishing accounts in the resolver settings.
+func NewPublisherEthGateway(resolver network.Resolver, keyStore *kms.KMS, publishingKeyPath string, ps pubsub.Publisher) (*PublisherEthGateway, error) {
 	// TODO: make timeout configurable
 
 	return newStateService(resolver, rpcTimeout, keyStore, kms.KeyID{
 		Type: kms.KeyTypeEthereum,
 		ID:   publishingKeyPath,
-	})
+	}, ps)
 }
 
-func newStateService(resolver network.Resolver, to time.Duration, kServ *kms.KMS, kPath kms.KeyID) (*PublisherEthGateway, error) {
+func newStateService(resolver network.Resolver, to time.Duration, kServ *kms.KMS, kPath kms.KeyID, ps pubsub.Publisher) (*PublisherEthGateway, error) {
 	return &PublisherEthGateway{
 		networkResolver:       resolver,
 		rw:                    &sync.RWMutex{},
 		kms:                   kServ,
 		publishingKeyID:       kPath,
 		ethRPCResponseTimeout: to,
+		publisher:             ps,
+		lowBalanceAlerts:      make(map[ethCommon.Address]time.Time),
+		publishingSettings:    resolver.GetPublishingSettings,
 	}, nil
 }
 
@@ -118,22 +143,12 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 		}
 
 	case string(kms.KeyTypeBabyJubJub):
-		ctxWT, cancel := context.WithTimeout(ctx, pb.ethRPCResponseTimeout)
-		defer cancel()
-
 		client, err := getEthClient(ctx, identity, pb.networkResolver)
 		if err != nil {
 			log.Error(ctx, "failed to get client", "err", err)
 			return nil, err
 		}
 
-		opts, err := client.CreateTxOpts(ctxWT, pb.publishingKeyID)
-		if err != nil {
-			log.Error(ctx, "failed to create tx opts", "err", err)
-			return nil, err
-		}
-		log.Info(ctx, "Transaction metadata", "opts.GasPrice:", opts.GasPrice, "opts.GasLimit:", opts.GasLimit, "opts.GasTipCap:", opts.GasTipCap)
-
 		a, b, c, err := pb.adaptProofToAbi(proof)
 		if err != nil {
 			return nil, err
@@ -150,10 +165,24 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 			log.Error(ctx, "failed to get contract binding", "err", err)
 			return nil, err
 		}
-		tx, err = contractBinding.TransitState(opts, id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, a, b, c)
+
+		accounts, err := pb.publishingAccounts(ctx, client, resolverPrefix, identifier)
 		if err != nil {
 			return nil, err
 		}
+
+		for i, keyID := range accounts {
+			tx, err = pb.transitState(ctx, client, keyID, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return contractBinding.TransitState(opts, id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, a, b, c)
+			})
+			if err == nil {
+				break
+			}
+			if !isInsufficientFunds(err) || i == len(accounts)-1 {
+				return nil, err
+			}
+			log.Warn(ctx, "publishing account without funds, trying the next one", "err", err, "keyID", keyID.ID)
+		}
 	default:
 		return nil, errors.New("unsupported key type for publishing")
 	}
@@ -163,6 +192,90 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 	return &txID, nil
 }
 
+func (pb *PublisherEthGateway) transitState(ctx context.Context, client *eth.Client, keyID kms.KeyID, transit func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, pb.ethRPCResponseTimeout)
+	defer cancel()
+
+	opts, err := client.CreateTxOpts(ctxWT, keyID)
+	if err != nil {
+		log.Error(ctx, "failed to create tx opts", "err", err)
+		return nil, err
+	}
+	log.Info(ctx, "Transaction metadata", "opts.GasPrice:", opts.GasPrice, "opts.GasLimit:", opts.GasLimit, "opts.GasTipCap:", opts.GasTipCap, "from", opts.From.Hex())
+	return transit(opts)
+}
+
+// publishingAccounts returns the keys that can be used to publish the state of the identity, ordered by priority.
+// When the network has balance thresholds or more than one account, the accounts without enough funds are
+// discarded and a low balance alert is sent for the accounts under the alert threshold.
+func (pb *PublisherEthGateway) publishingAccounts(ctx context.Context, client PublishingAccountsBackend, resolverPrefix string, identifier *w3c.DID) ([]kms.KeyID, error) {
+	settings := pb.publishingSettings(resolverPrefix)
+	keys := settings.KeysFor(identifier.String())
+	if len(keys) == 0 {
+		keys = []string{pb.publishingKeyID.ID}
+	}
+
+	accounts := make([]kms.KeyID, 0, len(keys))
+	for _, key := range keys {
+		accounts = append(accounts, kms.KeyID{Type: kms.KeyTypeEthereum, ID: key})
+	}
+	if len(accounts) == 1 && !settings.ChecksBalance() {
+		return accounts, nil
+	}
+
+	funded := make([]kms.KeyID, 0, len(accounts))
+	for _, keyID := range accounts {
+		address, err := client.Address(keyID)
+		if err != nil {
+			log.Error(ctx, "failed to get publishing account address", "err", err, "keyID", keyID.ID)
+			continue
+		}
+		balance, err := client.BalanceAt(ctx, address)
+		if err != nil {
+			log.Error(ctx, "failed to get publishing account balance", "err", err, "address", address.Hex())
+			continue
+		}
+		if settings.IsLowBalance(balance) {
+			pb.alertLowBalance(ctx, resolverPrefix, keyID, address, balance, settings.LowBalanceWei)
+		}
+		if !settings.IsFunded(balance) {
+			log.Warn(ctx, "publishing account without enough funds", "address", address.Hex(), "balance", balance.String())
+			continue
+		}
+		funded = append(funded, keyID)
+	}
+
+	if len(funded) == 0 {
+		log.Error(ctx, "there is no publishing account with enough funds", "network", resolverPrefix)
+		return nil, ErrNoFundedPublishingAccount
+	}
+	return funded, nil
+}
+
+// alertLowBalance publishes a low balance event. Alerts for the same address are sent at most once per lowBalanceAlertInterval
+func (pb *PublisherEthGateway) alertLowBalance(ctx context.Context, resolverPrefix string, keyID kms.KeyID, address ethCommon.Address, balance, threshold *big.Int) {
+	if last, ok := pb.lowBalanceAlerts[address]; ok && time.Since(last) < lowBalanceAlertInterval {
+		return
+	}
+	pb.lowBalanceAlerts[address] = time.Now()
+
+	log.Warn(ctx, "publishing account balance under the alert threshold", "network", resolverPrefix, "address", address.Hex(), "balance", balance.String(), "threshold", threshold.String())
+	err := pb.publisher.Publish(ctx, event.LowBalanceEvent, &event.LowBalance{
+		Network:   resolverPrefix,
+		KeyID:     keyID.ID,
+		Address:   address.Hex(),
+		Balance:   balance.String(),
+		Threshold: threshold.String(),
+	})
+	if err != nil {
+		log.Error(ctx, "publishing LowBalanceEvent", "err", err, "address", address.Hex())
+	}
+}
+
+func isInsufficientFunds(err error) bool {
+	return errors.Is(err, ethCore.ErrInsufficientFunds) || strings.Contains(err.Error(), ethCore.ErrInsufficientFunds.Error())
+}
+
 func (pb *PublisherEthGateway) adaptProofToAbi(proof *rstypes.ProofData) (proofA [2]*big.Int, proofB [2][2]*big.Int, proofC [2]*big.Int, err error) {
 	a, err := common.ArrayStringToBigInt(proof.A)
 	if err != nil {
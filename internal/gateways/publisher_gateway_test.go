@@ -0,0 +1,169 @@
+package gateways
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/event"
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+	"github.com/wakeup-labs/issuer-node/internal/network"
+	"github.com/wakeup-labs/issuer-node/internal/pubsub"
+)
+
+// simulatedAccounts checks the balances of the publishing accounts in the simulated backend
+type simulatedAccounts struct {
+	client    simulated.Client
+	addresses map[string]common.Address
+}
+
+func (a *simulatedAccounts) Address(keyID kms.KeyID) (common.Address, error) {
+	address, ok := a.addresses[keyID.ID]
+	if !ok {
+		return common.Address{}, fmt.Errorf("unknown key %s", keyID.ID)
+	}
+	return address, nil
+}
+
+func (a *simulatedAccounts) BalanceAt(ctx context.Context, address common.Address) (*big.Int, error) {
+	return a.client.BalanceAt(ctx, address, nil)
+}
+
+type lowBalancePublisher struct {
+	events []*event.LowBalance
+}
+
+func (p *lowBalancePublisher) Publish(_ context.Context, topic string, payload pubsub.Event) error {
+	if topic == event.LowBalanceEvent {
+		p.events = append(p.events, payload.(*event.LowBalance))
+	}
+	return nil
+}
+
+func TestPublisherEthGateway_PublishingAccounts(t *testing.T) {
+	const network1 = "polygon:amoy"
+	ctx := context.Background()
+	identifier, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+
+	funded := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	low := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	empty := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		funded: {Balance: big.NewInt(1e18)},
+		low:    {Balance: big.NewInt(5e15)},
+	})
+	defer func() { require.NoError(t, backend.Close()) }()
+	accounts := &simulatedAccounts{
+		client:    backend.Client(),
+		addresses: map[string]common.Address{"funded": funded, "low": low, "empty": empty},
+	}
+
+	publisher := &lowBalancePublisher{}
+	var settings network.PublishingSettings
+	pb := &PublisherEthGateway{
+		publishingKeyID:    kms.KeyID{Type: kms.KeyTypeEthereum, ID: "default"},
+		publisher:          publisher,
+		lowBalanceAlerts:   make(map[common.Address]time.Time),
+		publishingSettings: func(string) network.PublishingSettings { return settings },
+	}
+	ids := func(keys []kms.KeyID) []string {
+		var ids []string
+		for _, key := range keys {
+			ids = append(ids, key.ID)
+		}
+		return ids
+	}
+
+	t.Run("default key without balance checks", func(t *testing.T) {
+		settings = network.PublishingSettings{}
+		keys, err := pb.publishingAccounts(ctx, accounts, network1, identifier)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"default"}, ids(keys))
+	})
+
+	t.Run("accounts without funds are discarded", func(t *testing.T) {
+		settings = network.PublishingSettings{Keys: []string{"empty", "low", "unknown", "funded"}}
+		keys, err := pb.publishingAccounts(ctx, accounts, network1, identifier)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"low", "funded"}, ids(keys))
+		assert.Empty(t, publisher.events)
+	})
+
+	t.Run("minimum balance and identity keys", func(t *testing.T) {
+		settings = network.PublishingSettings{
+			Keys:          []string{"low"},
+			Identities:    map[string][]string{identifier.String(): {"low", "funded"}},
+			MinBalanceWei: big.NewInt(1e16),
+		}
+		keys, err := pb.publishingAccounts(ctx, accounts, network1, identifier)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"funded"}, ids(keys))
+	})
+
+	t.Run("no funded account", func(t *testing.T) {
+		settings = network.PublishingSettings{Keys: []string{"low"}, MinBalanceWei: big.NewInt(1e16)}
+		_, err := pb.publishingAccounts(ctx, accounts, network1, identifier)
+		assert.ErrorIs(t, err, ErrNoFundedPublishingAccount)
+	})
+
+	t.Run("low balance alerts", func(t *testing.T) {
+		settings = network.PublishingSettings{Keys: []string{"low", "funded"}, LowBalanceWei: big.NewInt(1e16)}
+		keys, err := pb.publishingAccounts(ctx, accounts, network1, identifier)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"low", "funded"}, ids(keys))
+		require.Len(t, publisher.events, 1)
+		assert.Equal(t, event.LowBalance{Network: network1, KeyID: "low", Address: low.Hex(), Balance: "5000000000000000", Threshold: "10000000000000000"}, *publisher.events[0])
+
+		// alerts for the same account are sent once per interval
+		_, err = pb.publishingAccounts(ctx, accounts, network1, identifier)
+		require.NoError(t, err)
+		assert.Len(t, publisher.events, 1)
+
+		pb.lowBalanceAlerts[low] = time.Now().Add(-lowBalanceAlertInterval)
+		_, err = pb.publishingAccounts(ctx, accounts, network1, identifier)
+		require.NoError(t, err)
+		assert.Len(t, publisher.events, 2)
+	})
+}
+
+func TestIsInsufficientFunds(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	backend := simulated.NewBackend(types.GenesisAlloc{})
+	defer func() { require.NoError(t, backend.Close()) }()
+	client := backend.Client()
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	head, err := client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+
+	// the account of the key has no funds in the simulated backend
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Gas:       21000,
+		GasFeeCap: new(big.Int).Mul(head.BaseFee, big.NewInt(2)),
+		GasTipCap: big.NewInt(1),
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	require.NoError(t, err)
+	sendErr := client.SendTransaction(ctx, tx)
+	require.Error(t, sendErr)
+
+	assert.True(t, isInsufficientFunds(sendErr))
+	assert.True(t, isInsufficientFunds(fmt.Errorf("publishing state: %w", sendErr)))
+	assert.False(t, isInsufficientFunds(fmt.Errorf("nonce too low")))
+}
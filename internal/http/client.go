@@ -34,6 +34,11 @@ func NewClient(c http.Client) *Client {
 
 // Post send posts request to url with additional headers
 func (c *Client) Post(ctx context.Context, url string, req []byte) ([]byte, error) {
+	return c.PostWithHeaders(ctx, url, req, nil)
+}
+
+// PostWithHeaders send posts request to url with the given headers besides the additional ones
+func (c *Client) PostWithHeaders(ctx context.Context, url string, req []byte, headers map[string]string) ([]byte, error) {
 	reqBody := bytes.NewBuffer(req)
 
 	request, err := http.NewRequest(http.MethodPost, url, reqBody)
@@ -42,6 +47,9 @@ func (c *Client) Post(ctx context.Context, url string, req []byte) ([]byte, erro
 	}
 
 	addRequestIDToHeader(ctx, request)
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
 
 	return executeRequest(ctx, c, request)
 }
@@ -2,7 +2,12 @@ package kms
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -191,6 +196,68 @@ func (awsKeyProv *awsEthKeyProvider) ListByIdentity(ctx context.Context, identit
 	return []KeyID{keyID}, nil
 }
 
+// Import creates a new AWS KMS key with external origin and imports the private key material.
+// AWS KMS keys cannot be exported, so this provider does not implement KeyExporter.
+func (awsKeyProv *awsEthKeyProvider) Import(ctx context.Context, identity *w3c.DID, privKey []byte) (KeyID, error) {
+	keyMaterial, err := encodeETHPrivateKeyPKCS8(privKey)
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to encode private key: %v", err)
+	}
+
+	key, err := awsKeyProv.kmsClient.CreateKey(ctx, &kms.CreateKeyInput{
+		KeySpec:     types.KeySpecEccSecgP256k1,
+		KeyUsage:    types.KeyUsageTypeSignVerify,
+		Origin:      types.OriginTypeExternal,
+		Description: aws.String("Key imported to issuer node"),
+	})
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to create key: %v", err)
+	}
+
+	params, err := awsKeyProv.kmsClient.GetParametersForImport(ctx, &kms.GetParametersForImportInput{
+		KeyId:             key.KeyMetadata.KeyId,
+		WrappingAlgorithm: types.AlgorithmSpecRsaesOaepSha256,
+		WrappingKeySpec:   types.WrappingKeySpecRsa2048,
+	})
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to get parameters for import: %v", err)
+	}
+
+	wrappingKey, err := x509.ParsePKIXPublicKey(params.PublicKey)
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to parse wrapping key: %v", err)
+	}
+	rsaWrappingKey, ok := wrappingKey.(*rsa.PublicKey)
+	if !ok {
+		return KeyID{}, errors.New("unexpected wrapping key type")
+	}
+
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaWrappingKey, keyMaterial, nil)
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to wrap key material: %v", err)
+	}
+
+	_, err = awsKeyProv.kmsClient.ImportKeyMaterial(ctx, &kms.ImportKeyMaterialInput{
+		KeyId:                key.KeyMetadata.KeyId,
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ImportToken:          params.ImportToken,
+		ExpirationModel:      types.ExpirationModelTypeKeyMaterialDoesNotExpire,
+	})
+	if err != nil {
+		return KeyID{}, fmt.Errorf("failed to import key material: %v", err)
+	}
+
+	if identity != nil {
+		aliasName := aliasPrefix + strings.Replace(identity.String(), ":", "", -1)
+		if err := awsKeyProv.createAlias(ctx, aliasName, *key.KeyMetadata.Arn); err != nil {
+			return KeyID{}, err
+		}
+	}
+
+	log.Info(ctx, "key material imported", "keyArn", *key.KeyMetadata.Arn)
+	return KeyID{Type: awsKeyProv.keyType, ID: *key.KeyMetadata.Arn}, nil
+}
+
 // createAlias creates alias for key
 func (awsKeyProv *awsEthKeyProvider) createAlias(ctx context.Context, aliasName, targetKeyId string) error {
 	input := &kms.CreateAliasInput{
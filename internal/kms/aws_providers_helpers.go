@@ -162,3 +162,47 @@ func adjustSignatureLength(buffer []byte) []byte {
 //	}
 //	return signature, nil
 //}
+
+var (
+	oidECPublicKey = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+type asn1ECPrivateKey struct {
+	Version    int
+	PrivateKey []byte
+	PublicKey  asn1.BitString `asn1:"optional,explicit,tag:1"`
+}
+
+type asn1PKCS8PrivateKey struct {
+	Version    int
+	Algorithm  asn1EcPublicKeyInfo
+	PrivateKey []byte
+}
+
+// encodeETHPrivateKeyPKCS8 encodes the secp256k1 private key in the PKCS#8 DER format
+// expected by AWS KMS when importing key material.
+func encodeETHPrivateKeyPKCS8(key []byte) ([]byte, error) {
+	const ecPrivKeyVersion = 1
+	privKey, err := decodeETHPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	ecPrivKey, err := asn1.Marshal(asn1ECPrivateKey{
+		Version:    ecPrivKeyVersion,
+		PrivateKey: crypto.FromECDSA(privKey),
+		PublicKey:  asn1.BitString{Bytes: crypto.FromECDSAPub(&privKey.PublicKey)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(asn1PKCS8PrivateKey{
+		Algorithm: asn1EcPublicKeyInfo{
+			Algorithm:  oidECPublicKey,
+			Parameters: oidSecp256k1,
+		},
+		PrivateKey: ecPrivKey,
+	})
+}
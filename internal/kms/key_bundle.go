@@ -0,0 +1,121 @@
+package kms
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keyBundleVersion = 1
+	keyBundleKDF     = "scrypt"
+	keyBundleCipher  = "aes-256-gcm"
+	scryptN          = 1 << 15
+	scryptR          = 8
+	scryptP          = 1
+	scryptKeyLength  = 32
+	scryptSaltLength = 16
+)
+
+// ErrInvalidKeyBundle is returned when the key bundle can not be decrypted
+var ErrInvalidKeyBundle = stderr.New("invalid key bundle or wrong passphrase")
+
+// KeyBundle is the encrypted transport format for exported keys.
+// The keys are serialized as json, encrypted with AES-256-GCM and the
+// encryption key is derived from a passphrase with scrypt.
+type KeyBundle struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Cipher     string `json:"cipher"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptKeyBundle encrypts the exported keys with the given passphrase
+func EncryptKeyBundle(keys []ExportedKey, passphrase string) (*KeyBundle, error) {
+	if passphrase == "" {
+		return nil, stderr.New("passphrase cannot be empty")
+	}
+
+	plaintext, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &KeyBundle{
+		Version: keyBundleVersion,
+		KDF:     keyBundleKDF,
+		Cipher:  keyBundleCipher,
+		N:       scryptN,
+		R:       scryptR,
+		P:       scryptP,
+		Salt:    make([]byte, scryptSaltLength),
+	}
+	if _, err := rand.Read(bundle.Salt); err != nil {
+		return nil, err
+	}
+
+	aead, err := bundle.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(bundle.Nonce); err != nil {
+		return nil, err
+	}
+	bundle.Ciphertext = aead.Seal(nil, bundle.Nonce, plaintext, bundle.additionalData())
+	return bundle, nil
+}
+
+// Decrypt returns the keys stored in the bundle
+func (b *KeyBundle) Decrypt(passphrase string) ([]ExportedKey, error) {
+	if b.Version != keyBundleVersion || b.KDF != keyBundleKDF || b.Cipher != keyBundleCipher {
+		return nil, fmt.Errorf("unsupported key bundle format: version %d, kdf %s, cipher %s", b.Version, b.KDF, b.Cipher)
+	}
+
+	aead, err := b.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.Nonce) != aead.NonceSize() {
+		return nil, ErrInvalidKeyBundle
+	}
+
+	plaintext, err := aead.Open(nil, b.Nonce, b.Ciphertext, b.additionalData())
+	if err != nil {
+		return nil, ErrInvalidKeyBundle
+	}
+
+	var keys []ExportedKey
+	if err := json.Unmarshal(plaintext, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (b *KeyBundle) aead(passphrase string) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), b.Salt, b.N, b.R, b.P, scryptKeyLength)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds the bundle header to the ciphertext so it can not be tampered with
+func (b *KeyBundle) additionalData() []byte {
+	return []byte(fmt.Sprintf("%d|%s|%s|%d|%d|%d", b.Version, b.KDF, b.Cipher, b.N, b.R, b.P))
+}
@@ -0,0 +1,208 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	stderr "errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+const (
+	ethCompressedPubKeyLength   = 33
+	ethUncompressedPubKeyLength = 65
+)
+
+// ErrExportNotSupported is returned when the key provider does not allow to read private keys
+var ErrExportNotSupported = stderr.New("key provider does not support key export")
+
+// ErrImportNotSupported is returned when the key provider can not store externally generated keys
+var ErrImportNotSupported = stderr.New("key provider does not support key import")
+
+// ErrPublicKeyMismatch is returned when the imported key public key differs from the exported one
+var ErrPublicKeyMismatch = stderr.New("public key mismatch after import")
+
+// KeyExporter is implemented by key providers that are able to return raw private keys.
+type KeyExporter interface {
+	// Export returns the raw private key for the given key ID.
+	Export(ctx context.Context, keyID KeyID) ([]byte, error)
+}
+
+// KeyImporter is implemented by key providers that are able to store externally generated private keys.
+type KeyImporter interface {
+	// Import stores the raw private key bound to the identity (unbound if identity is nil)
+	// and returns the new KeyID.
+	Import(ctx context.Context, identity *w3c.DID, privKey []byte) (KeyID, error)
+}
+
+// ExportedKey is a private key extracted from a key provider together with the identity it belongs to
+type ExportedKey struct {
+	Identity   string  `json:"identity"`
+	KeyType    KeyType `json:"key_type"`
+	KeyID      string  `json:"key_id"`
+	PublicKey  string  `json:"public_key"`
+	PrivateKey string  `json:"private_key"`
+}
+
+// ExportIdentityKeys returns all the private keys the key provider holds for the identity.
+func ExportIdentityKeys(ctx context.Context, kp KeyProvider, identity w3c.DID) ([]ExportedKey, error) {
+	exporter, ok := kp.(KeyExporter)
+	if !ok {
+		return nil, ErrExportNotSupported
+	}
+
+	keyIDs, err := kp.ListByIdentity(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list keys for identity %s: %w", identity.String(), err)
+	}
+
+	exported := make([]ExportedKey, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		privKey, err := exporter.Export(ctx, keyID)
+		if err != nil {
+			log.Error(ctx, "cannot export key", "err", err, "keyID", keyID.ID)
+			return nil, fmt.Errorf("cannot export key %s: %w", keyID.ID, err)
+		}
+		pubKey, err := kp.PublicKey(keyID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get public key for %s: %w", keyID.ID, err)
+		}
+		pubKey, err = NormalizePublicKey(keyID.Type, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		exported = append(exported, ExportedKey{
+			Identity:   identity.String(),
+			KeyType:    keyID.Type,
+			KeyID:      keyID.ID,
+			PublicKey:  hex.EncodeToString(pubKey),
+			PrivateKey: hex.EncodeToString(privKey),
+		})
+	}
+	return exported, nil
+}
+
+// FindImportedKey looks for a key with the same public key as the exported one in the key provider.
+// It returns nil if the key provider does not hold it yet.
+func FindImportedKey(ctx context.Context, kp KeyProvider, key ExportedKey) (*KeyID, error) {
+	identity, err := w3c.ParseDID(key.Identity)
+	if err != nil {
+		return nil, err
+	}
+	keyIDs, err := kp.ListByIdentity(ctx, *identity)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyID := range keyIDs {
+		if keyID.Type != key.KeyType {
+			continue
+		}
+		if err := VerifyPublicKey(kp, keyID, key.PublicKey); err == nil {
+			return &keyID, nil
+		}
+	}
+	return nil, nil
+}
+
+// ImportKey stores the exported key in the key provider and verifies that the public key
+// returned by the key provider matches the exported one.
+func ImportKey(ctx context.Context, kp KeyProvider, key ExportedKey) (KeyID, error) {
+	importer, ok := kp.(KeyImporter)
+	if !ok {
+		return KeyID{}, ErrImportNotSupported
+	}
+
+	identity, err := w3c.ParseDID(key.Identity)
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	privKey, err := hex.DecodeString(key.PrivateKey)
+	if err != nil {
+		return KeyID{}, fmt.Errorf("cannot decode private key %s: %w", key.KeyID, err)
+	}
+
+	keyID, err := importer.Import(ctx, identity, privKey)
+	if err != nil {
+		return KeyID{}, fmt.Errorf("cannot import key %s: %w", key.KeyID, err)
+	}
+
+	if err := VerifyPublicKey(kp, keyID, key.PublicKey); err != nil {
+		log.Error(ctx, "imported key verification failed", "err", err, "keyID", keyID.ID)
+		return keyID, err
+	}
+	return keyID, nil
+}
+
+// VerifyPublicKey checks that the public key of keyID matches the hex encoded public key.
+func VerifyPublicKey(kp KeyProvider, keyID KeyID, expectedPubKey string) error {
+	expected, err := hex.DecodeString(expectedPubKey)
+	if err != nil {
+		return err
+	}
+	pubKey, err := kp.PublicKey(keyID)
+	if err != nil {
+		return err
+	}
+	pubKey, err = NormalizePublicKey(keyID.Type, pubKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pubKey, expected) {
+		return ErrPublicKeyMismatch
+	}
+	return nil
+}
+
+// NormalizePublicKey converts the public key representation returned by any key provider
+// to a common one: compressed BabyJubJub key for BJJ and compressed secp256k1 key for ETH.
+func NormalizePublicKey(keyType KeyType, pubKey []byte) ([]byte, error) {
+	switch keyType {
+	case KeyTypeBabyJubJub:
+		if _, err := DecodeBJJPubKey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid BabyJubJub public key: %w", err)
+		}
+		return pubKey, nil
+	case KeyTypeEthereum:
+		var pk *ecdsa.PublicKey
+		var err error
+		switch len(pubKey) {
+		case ethCompressedPubKeyLength:
+			pk, err = crypto.DecompressPubkey(pubKey)
+		case ethUncompressedPubKeyLength:
+			pk, err = crypto.UnmarshalPubkey(pubKey)
+		default:
+			pk, err = DecodeAWSETHPubKey(context.Background(), pubKey)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid Ethereum public key: %w", err)
+		}
+		return crypto.CompressPubkey(pk), nil
+	default:
+		return nil, ErrUnknownKeyType
+	}
+}
+
+// ethPublicKeyHex returns the compressed hex public key for the raw Ethereum private key
+func ethPublicKeyHex(privKey []byte) (string, error) {
+	pk, err := decodeETHPrivateKey(privKey)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(crypto.CompressPubkey(&pk.PublicKey)), nil
+}
+
+// bjjPublicKeyHex returns the compressed hex public key for the raw BabyJubJub private key
+func bjjPublicKeyHex(privKey []byte) (string, error) {
+	pk, err := decodeBJJPrivateKey(privKey)
+	if err != nil {
+		return "", err
+	}
+	return pk.Public().String(), nil
+}
@@ -0,0 +1,116 @@
+package kms
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLocalStorageTestKeyStore(t *testing.T) map[KeyType]KeyProvider {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), LocalStorageFileName)
+	require.NoError(t, os.WriteFile(file, []byte("[]"), 0o600))
+	fileManager := NewLocalStorageFileManager(file)
+	return map[KeyType]KeyProvider{
+		KeyTypeBabyJubJub: NewLocalStorageBJJKeyProvider(KeyTypeBabyJubJub, fileManager),
+		KeyTypeEthereum:   NewLocalStorageEthKeyProvider(KeyTypeEthereum, fileManager),
+	}
+}
+
+func TestMigrateKeys_LocalStorage(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:opid:optimism:sepolia:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV")
+	require.NoError(t, err)
+
+	src := newLocalStorageTestKeyStore(t)
+	dst := newLocalStorageTestKeyStore(t)
+
+	bjjKeyID, err := src[KeyTypeBabyJubJub].New(did)
+	require.NoError(t, err)
+	ethKeyID, err := src[KeyTypeEthereum].New(did)
+	require.NoError(t, err)
+
+	var keys []ExportedKey
+	for _, kp := range src {
+		exported, err := ExportIdentityKeys(ctx, kp, *did)
+		require.NoError(t, err)
+		keys = append(keys, exported...)
+	}
+	require.Len(t, keys, 2)
+
+	for _, key := range keys {
+		existing, err := FindImportedKey(ctx, dst[key.KeyType], key)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		keyID, err := ImportKey(ctx, dst[key.KeyType], key)
+		require.NoError(t, err)
+
+		existing, err = FindImportedKey(ctx, dst[key.KeyType], key)
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		assert.Equal(t, keyID, *existing)
+	}
+
+	digest := make([]byte, 32)
+	digest[0] = 1
+	for _, keyID := range []KeyID{bjjKeyID, ethKeyID} {
+		srcSig, err := src[keyID.Type].Sign(ctx, keyID, digest)
+		require.NoError(t, err)
+		dstSig, err := dst[keyID.Type].Sign(ctx, keyID, digest)
+		require.NoError(t, err)
+		assert.Equal(t, srcSig, dstSig)
+	}
+}
+
+func TestImportKey_PublicKeyMismatch(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:opid:optimism:sepolia:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV")
+	require.NoError(t, err)
+
+	src := newLocalStorageTestKeyStore(t)
+	_, err = src[KeyTypeBabyJubJub].New(did)
+	require.NoError(t, err)
+	_, err = src[KeyTypeBabyJubJub].New(did)
+	require.NoError(t, err)
+
+	keys, err := ExportIdentityKeys(ctx, src[KeyTypeBabyJubJub], *did)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	key := keys[0]
+	key.PublicKey = keys[1].PublicKey
+	_, err = ImportKey(ctx, newLocalStorageTestKeyStore(t)[KeyTypeBabyJubJub], key)
+	assert.ErrorIs(t, err, ErrPublicKeyMismatch)
+}
+
+func TestKeyBundle(t *testing.T) {
+	keys := []ExportedKey{
+		{
+			Identity:   "did:opid:optimism:sepolia:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV",
+			KeyType:    KeyTypeBabyJubJub,
+			KeyID:      "keys/did:opid:optimism:sepolia:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV/BJJ:cecf34ed27074e121f1e8a8cc75954ab2b28506258b87b3c9a20e33461f4b12a",
+			PublicKey:  "cecf34ed27074e121f1e8a8cc75954ab2b28506258b87b3c9a20e33461f4b12a",
+			PrivateKey: "9a3b6c5e6d3f8f1b2d2a4e1c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a",
+		},
+	}
+
+	bundle, err := EncryptKeyBundle(keys, "a long passphrase")
+	require.NoError(t, err)
+	assert.NotContains(t, string(bundle.Ciphertext), keys[0].PrivateKey)
+
+	decrypted, err := bundle.Decrypt("a long passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, keys, decrypted)
+
+	_, err = bundle.Decrypt("wrong passphrase")
+	assert.ErrorIs(t, err, ErrInvalidKeyBundle)
+
+	_, err = EncryptKeyBundle(keys, "")
+	assert.Error(t, err)
+}
@@ -40,6 +40,8 @@ const (
 	ETHLocalStorageKeyProvider ConfigProvider = "localstorage"
 	// ETHAwsKmsKeyProvider is a key provider for Ethereum keys in AWS KMS
 	ETHAwsKmsKeyProvider ConfigProvider = "aws"
+	// RemoteSignerKeyProvider is a key provider that forwards BabyJubJub and Ethereum key operations to a remote signer
+	RemoteSignerKeyProvider ConfigProvider = "remote"
 )
 
 // Config is a configuration for KMS
@@ -53,6 +55,7 @@ type Config struct {
 	Vault                    *api.Client
 	PluginIden3MountPath     string
 	IssuerETHTransferKeyPath string
+	RemoteSigner             RemoteKeyProviderConfig
 }
 
 // KeyProvider describes the interface that key providers should match.
@@ -270,6 +273,14 @@ func OpenWithConfig(ctx context.Context, config Config) (*KMS, error) {
 		log.Info(ctx, "BabyJubJub key provider created", "provider:", BJJLocalStorageKeyProvider)
 	}
 
+	if config.BJJKeyProvider == RemoteSignerKeyProvider {
+		bjjKeyProvider, err = NewRemoteKeyProvider(KeyTypeBabyJubJub, config.RemoteSigner)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create BabyJubJub key provider: %+v", err)
+		}
+		log.Info(ctx, "BabyJubJub key provider created", "provider:", RemoteSignerKeyProvider)
+	}
+
 	if config.ETHKeyProvider == ETHVaultKeyProvider {
 		ethKeyProvider, err = NewVaultPluginIden3KeyProvider(config.Vault, config.PluginIden3MountPath, KeyTypeEthereum)
 		if err != nil {
@@ -305,6 +316,14 @@ func OpenWithConfig(ctx context.Context, config Config) (*KMS, error) {
 		log.Info(ctx, "Ethereum key provider created", "provider:", ETHAwsKmsKeyProvider)
 	}
 
+	if config.ETHKeyProvider == RemoteSignerKeyProvider {
+		ethKeyProvider, err = NewRemoteKeyProvider(KeyTypeEthereum, config.RemoteSigner)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create Ethereum key provider: %+v", err)
+		}
+		log.Info(ctx, "Ethereum key provider created", "provider:", RemoteSignerKeyProvider)
+	}
+
 	keyStore := NewKMS()
 	err = keyStore.RegisterKeyProvider(KeyTypeBabyJubJub, bjjKeyProvider)
 	if err != nil {
@@ -151,3 +151,28 @@ func (ls *localStorageBJJKeyProvider) privateKey(ctx context.Context, keyID KeyI
 
 	return val, nil
 }
+
+// Export returns the raw private key for the given key ID
+func (ls *localStorageBJJKeyProvider) Export(ctx context.Context, keyID KeyID) ([]byte, error) {
+	return ls.privateKey(ctx, keyID)
+}
+
+// Import stores an existing BabyJubJub private key
+func (ls *localStorageBJJKeyProvider) Import(ctx context.Context, identity *w3c.DID, privKey []byte) (KeyID, error) {
+	pubKeyHex, err := bjjPublicKeyHex(privKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{
+		Type: ls.keyType,
+		ID:   keyPath(identity, ls.keyType, pubKeyHex),
+	}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(keyID.Type),
+		jsonKeyData: hex.EncodeToString(privKey),
+	}
+	if err := ls.localStorageFileManager.saveKeyMaterialToFile(ctx, keyMaterial, keyID.ID); err != nil {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
@@ -152,3 +152,28 @@ func (ls *localStorageEthKeyProvider) privateKey(ctx context.Context, keyID KeyI
 
 	return val, nil
 }
+
+// Export returns the raw private key for the given key ID
+func (ls *localStorageEthKeyProvider) Export(ctx context.Context, keyID KeyID) ([]byte, error) {
+	return ls.privateKey(ctx, keyID)
+}
+
+// Import stores an existing Ethereum private key
+func (ls *localStorageEthKeyProvider) Import(ctx context.Context, identity *w3c.DID, privKey []byte) (KeyID, error) {
+	pubKeyHex, err := ethPublicKeyHex(privKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{
+		Type: ls.keyType,
+		ID:   keyPath(identity, ls.keyType, pubKeyHex),
+	}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeEthereum),
+		jsonKeyData: hex.EncodeToString(privKey),
+	}
+	if err := ls.localStorageFileManager.saveKeyMaterialToFile(ctx, keyMaterial, keyID.ID); err != nil {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
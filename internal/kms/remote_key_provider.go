@@ -0,0 +1,231 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+const remoteSignerTimeout = 30 * time.Second
+
+// Remote signer endpoints
+const (
+	RemoteSignerNewKeyPath    = "/v1/keys/new"
+	RemoteSignerPublicKeyPath = "/v1/keys/public-key"
+	RemoteSignerSignPath      = "/v1/keys/sign"
+	RemoteSignerListPath      = "/v1/keys/list"
+	RemoteSignerLinkPath      = "/v1/keys/link"
+)
+
+// RemoteKeyProviderConfig - configuration for the remote signer key provider.
+// The connection is established with mutual TLS, so the certificate paths are required unless InsecureHTTP
+// is set to talk to a signer that listens without TLS.
+type RemoteKeyProviderConfig struct {
+	URL          string
+	TLSCertPath  string
+	TLSKeyPath   string
+	CACertPath   string
+	InsecureHTTP bool
+}
+
+// RemoteSignerRequest is the body of the requests sent to the remote signer
+type RemoteSignerRequest struct {
+	KeyType  KeyType `json:"key_type,omitempty"`
+	KeyID    *KeyID  `json:"key_id,omitempty"`
+	Identity string  `json:"identity,omitempty"`
+	Data     []byte  `json:"data,omitempty"`
+}
+
+// RemoteSignerResponse is the body of the responses returned by the remote signer
+type RemoteSignerResponse struct {
+	KeyID     *KeyID  `json:"key_id,omitempty"`
+	KeyIDs    []KeyID `json:"key_ids,omitempty"`
+	PublicKey []byte  `json:"public_key,omitempty"`
+	Signature []byte  `json:"signature,omitempty"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type remoteKeyProvider struct {
+	keyType    KeyType
+	url        string
+	httpClient *http.Client
+}
+
+// NewRemoteKeyProvider - creates a key provider that forwards every operation to a remote signer service,
+// so the private keys and the key store credentials never live in the issuer process.
+func NewRemoteKeyProvider(keyType KeyType, cfg RemoteKeyProviderConfig) (KeyProvider, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("remote signer url is not provided")
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if cfg.InsecureHTTP {
+		log.Warn(context.Background(), "remote signer insecure http is enabled, mutual TLS is disabled")
+	} else {
+		tlsConfig, err := remoteSignerClientTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &remoteKeyProvider{
+		keyType: keyType,
+		url:     strings.TrimSuffix(cfg.URL, "/"),
+		httpClient: &http.Client{
+			Timeout:   remoteSignerTimeout,
+			Transport: transport,
+		},
+	}, nil
+}
+
+func remoteSignerClientTLSConfig(cfg RemoteKeyProviderConfig) (*tls.Config, error) {
+	if cfg.TLSCertPath == "" || cfg.TLSKeyPath == "" || cfg.CACertPath == "" {
+		return nil, errors.New("remote signer client certificate, key and CA certificate have to be provided")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load remote signer client certificate: %w", err)
+	}
+	caPool, err := LoadCertPool(cfg.CACertPath)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// LoadCertPool returns a certificate pool with the PEM certificates stored in the file
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("cannot parse CA certificate")
+	}
+	return pool, nil
+}
+
+func (r *remoteKeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	req := RemoteSignerRequest{KeyType: r.keyType}
+	if identity != nil {
+		req.Identity = identity.String()
+	}
+	resp, err := r.call(context.Background(), RemoteSignerNewKeyPath, req)
+	if err != nil {
+		return KeyID{}, err
+	}
+	if resp.KeyID == nil {
+		return KeyID{}, errors.New("remote signer returned an empty key id")
+	}
+	return *resp.KeyID, nil
+}
+
+func (r *remoteKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != r.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	resp, err := r.call(context.Background(), RemoteSignerPublicKeyPath, RemoteSignerRequest{KeyID: &keyID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PublicKey, nil
+}
+
+func (r *remoteKeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if keyID.Type != r.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	resp, err := r.call(ctx, RemoteSignerSignPath, RemoteSignerRequest{KeyID: &keyID, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
+
+func (r *remoteKeyProvider) ListByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error) {
+	resp, err := r.call(ctx, RemoteSignerListPath, RemoteSignerRequest{KeyType: r.keyType, Identity: identity.String()})
+	if err != nil {
+		return nil, err
+	}
+	if resp.KeyIDs == nil {
+		return []KeyID{}, nil
+	}
+	return resp.KeyIDs, nil
+}
+
+func (r *remoteKeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error) {
+	if keyID.Type != r.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+	resp, err := r.call(ctx, RemoteSignerLinkPath, RemoteSignerRequest{KeyID: &keyID, Identity: identity.String()})
+	if err != nil {
+		return keyID, err
+	}
+	if resp.KeyID == nil {
+		return keyID, errors.New("remote signer returned an empty key id")
+	}
+	return *resp.KeyID, nil
+}
+
+func (r *remoteKeyProvider) call(ctx context.Context, path string, req RemoteSignerRequest) (*RemoteSignerResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.httpClient.Do(httpReq)
+	if err != nil {
+		log.Error(ctx, "remote signer request failed", "err", err, "path", path)
+		return nil, fmt.Errorf("remote signer request failed: %w", err)
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.Error(ctx, "cannot close remote signer response body", "err", err)
+		}
+	}()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp RemoteSignerResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("remote signer returned an invalid response, status %d: %w", httpResp.StatusCode, err)
+	}
+
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+		return &resp, nil
+	case http.StatusForbidden:
+		return nil, ErrPermissionDenied
+	default:
+		log.Error(ctx, "remote signer returned an error", "status", httpResp.StatusCode, "error", resp.Error, "path", path)
+		return nil, fmt.Errorf("remote signer error: %s", resp.Error)
+	}
+}
@@ -0,0 +1,79 @@
+package kms
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	did, err := w3c.ParseDID("did:opid:optimism:sepolia:2qQ68JkRcf3ybQNvgRV9BP6qLgBrXmUezqBi4wsEuV")
+	require.NoError(t, err)
+
+	signerKMS := NewKMS()
+	for keyType, kp := range newLocalStorageTestKeyStore(t) {
+		require.NoError(t, signerKMS.RegisterKeyProvider(keyType, kp))
+	}
+	server := httptest.NewServer(NewRemoteSignerHandler(signerKMS))
+	t.Cleanup(server.Close)
+
+	bjjProvider, err := NewRemoteKeyProvider(KeyTypeBabyJubJub, RemoteKeyProviderConfig{URL: server.URL, InsecureHTTP: true})
+	require.NoError(t, err)
+	ethProvider, err := NewRemoteKeyProvider(KeyTypeEthereum, RemoteKeyProviderConfig{URL: server.URL, InsecureHTTP: true})
+	require.NoError(t, err)
+
+	t.Run("new, public key and sign", func(t *testing.T) {
+		keyID, err := bjjProvider.New(did)
+		require.NoError(t, err)
+		assert.Equal(t, KeyTypeBabyJubJub, keyID.Type)
+
+		pubKey, err := bjjProvider.PublicKey(keyID)
+		require.NoError(t, err)
+		expectedPubKey, err := signerKMS.PublicKey(keyID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPubKey, pubKey)
+
+		digest := make([]byte, 32)
+		digest[0] = 1
+		sig, err := bjjProvider.Sign(ctx, keyID, digest)
+		require.NoError(t, err)
+		expectedSig, err := signerKMS.Sign(ctx, keyID, digest)
+		require.NoError(t, err)
+		assert.Equal(t, expectedSig, sig)
+	})
+
+	t.Run("list by identity filters key type", func(t *testing.T) {
+		ethKeyID, err := ethProvider.New(did)
+		require.NoError(t, err)
+
+		ethKeys, err := ethProvider.ListByIdentity(ctx, *did)
+		require.NoError(t, err)
+		assert.Equal(t, []KeyID{ethKeyID}, ethKeys)
+
+		bjjKeys, err := bjjProvider.ListByIdentity(ctx, *did)
+		require.NoError(t, err)
+		require.Len(t, bjjKeys, 1)
+		assert.Equal(t, KeyTypeBabyJubJub, bjjKeys[0].Type)
+	})
+
+	t.Run("errors are forwarded", func(t *testing.T) {
+		_, err := bjjProvider.Sign(ctx, KeyID{Type: KeyTypeBabyJubJub, ID: "BJJ:unknown"}, []byte{1})
+		assert.Error(t, err)
+
+		_, err = bjjProvider.PublicKey(KeyID{Type: KeyTypeEthereum, ID: "ETH:unknown"})
+		assert.ErrorIs(t, err, ErrIncorrectKeyType)
+	})
+
+	t.Run("mutual TLS is required unless insecure http is enabled", func(t *testing.T) {
+		_, err := NewRemoteKeyProvider(KeyTypeBabyJubJub, RemoteKeyProviderConfig{URL: server.URL})
+		assert.EqualError(t, err, "remote signer client certificate, key and CA certificate have to be provided")
+
+		_, err = NewRemoteKeyProvider(KeyTypeBabyJubJub, RemoteKeyProviderConfig{URL: server.URL, CACertPath: "ca.pem"})
+		assert.EqualError(t, err, "remote signer client certificate, key and CA certificate have to be provided")
+	})
+}
@@ -0,0 +1,160 @@
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/wakeup-labs/issuer-node/internal/log"
+)
+
+const remoteSignerMaxBodySize = 1 << 20
+
+type remoteSigner struct {
+	kms KMSType
+}
+
+// NewRemoteSignerHandler returns the http handler of the remote signer service.
+// It exposes the KMS operations used by the remote key provider.
+// The handler does not authenticate the caller, it must be served behind mutual TLS.
+func NewRemoteSignerHandler(kms KMSType) http.Handler {
+	s := &remoteSigner{kms: kms}
+	mux := http.NewServeMux()
+	mux.HandleFunc(RemoteSignerNewKeyPath, s.handle(s.newKey))
+	mux.HandleFunc(RemoteSignerPublicKeyPath, s.handle(s.publicKey))
+	mux.HandleFunc(RemoteSignerSignPath, s.handle(s.sign))
+	mux.HandleFunc(RemoteSignerListPath, s.handle(s.listByIdentity))
+	mux.HandleFunc(RemoteSignerLinkPath, s.handle(s.linkToIdentity))
+	return mux
+}
+
+type remoteSignerOperation func(ctx context.Context, req RemoteSignerRequest) (*RemoteSignerResponse, error)
+
+func (s *remoteSigner) handle(op remoteSignerOperation) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if r.Method != http.MethodPost {
+			s.write(ctx, w, http.StatusMethodNotAllowed, &RemoteSignerResponse{Error: "method not allowed"})
+			return
+		}
+
+		var req RemoteSignerRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, remoteSignerMaxBodySize)).Decode(&req); err != nil {
+			s.write(ctx, w, http.StatusBadRequest, &RemoteSignerResponse{Error: "invalid request body"})
+			return
+		}
+
+		resp, err := op(ctx, req)
+		if err != nil {
+			log.Error(ctx, "remote signer operation failed", "err", err, "path", r.URL.Path)
+			s.write(ctx, w, remoteSignerErrorStatus(err), &RemoteSignerResponse{Error: err.Error()})
+			return
+		}
+		s.write(ctx, w, http.StatusOK, resp)
+	}
+}
+
+func (s *remoteSigner) write(ctx context.Context, w http.ResponseWriter, status int, resp *RemoteSignerResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Error(ctx, "cannot write remote signer response", "err", err)
+	}
+}
+
+func (s *remoteSigner) newKey(_ context.Context, req RemoteSignerRequest) (*RemoteSignerResponse, error) {
+	identity, err := remoteSignerIdentity(req, false)
+	if err != nil {
+		return nil, err
+	}
+	keyID, err := s.kms.CreateKey(req.KeyType, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSignerResponse{KeyID: &keyID}, nil
+}
+
+func (s *remoteSigner) publicKey(_ context.Context, req RemoteSignerRequest) (*RemoteSignerResponse, error) {
+	if req.KeyID == nil {
+		return nil, errRemoteSignerKeyIDRequired
+	}
+	pubKey, err := s.kms.PublicKey(*req.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSignerResponse{PublicKey: pubKey}, nil
+}
+
+func (s *remoteSigner) sign(ctx context.Context, req RemoteSignerRequest) (*RemoteSignerResponse, error) {
+	if req.KeyID == nil {
+		return nil, errRemoteSignerKeyIDRequired
+	}
+	signature, err := s.kms.Sign(ctx, *req.KeyID, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSignerResponse{Signature: signature}, nil
+}
+
+func (s *remoteSigner) listByIdentity(ctx context.Context, req RemoteSignerRequest) (*RemoteSignerResponse, error) {
+	identity, err := remoteSignerIdentity(req, true)
+	if err != nil {
+		return nil, err
+	}
+	keyIDs, err := s.kms.KeysByIdentity(ctx, *identity)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]KeyID, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		if req.KeyType == "" || keyID.Type == req.KeyType {
+			result = append(result, keyID)
+		}
+	}
+	return &RemoteSignerResponse{KeyIDs: result}, nil
+}
+
+func (s *remoteSigner) linkToIdentity(ctx context.Context, req RemoteSignerRequest) (*RemoteSignerResponse, error) {
+	if req.KeyID == nil {
+		return nil, errRemoteSignerKeyIDRequired
+	}
+	identity, err := remoteSignerIdentity(req, true)
+	if err != nil {
+		return nil, err
+	}
+	keyID, err := s.kms.LinkToIdentity(ctx, *req.KeyID, *identity)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSignerResponse{KeyID: &keyID}, nil
+}
+
+var (
+	errRemoteSignerKeyIDRequired    = errors.New("key_id is required")
+	errRemoteSignerIdentityRequired = errors.New("identity is required")
+)
+
+func remoteSignerIdentity(req RemoteSignerRequest, required bool) (*w3c.DID, error) {
+	if req.Identity == "" {
+		if required {
+			return nil, errRemoteSignerIdentityRequired
+		}
+		return nil, nil
+	}
+	return w3c.ParseDID(req.Identity)
+}
+
+func remoteSignerErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrPermissionDenied):
+		return http.StatusForbidden
+	case errors.Is(err, ErrUnknownKeyType), errors.Is(err, ErrIncorrectKeyType),
+		errors.Is(err, errRemoteSignerKeyIDRequired), errors.Is(err, errRemoteSignerIdentityRequired):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
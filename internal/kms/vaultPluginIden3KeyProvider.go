@@ -19,10 +19,11 @@ import (
 )
 
 const (
-	keyDest      = "dest"
-	keyData      = "data"
-	keySignature = "signature"
-	keyPublicKey = "public_key"
+	keyDest       = "dest"
+	keyData       = "data"
+	keySignature  = "signature"
+	keyPublicKey  = "public_key"
+	keyPrivateKey = "private_key"
 )
 
 type pluginIden3KeyTp string
@@ -161,6 +162,63 @@ func (v *vaultPluginIden3KeyProvider) New(identity *w3c.DID) (KeyID, error) {
 	return keyID, nil
 }
 
+// Export returns the raw private key for the given key ID
+func (v *vaultPluginIden3KeyProvider) Export(_ context.Context, keyID KeyID) ([]byte, error) {
+	if keyID.Type != v.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	secret, err := v.vaultCli.Logical().Read(v.keyPathFromID(keyID).private())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := getSecretData(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	privKeyStr, ok := data[keyPrivateKey].(string)
+	if !ok {
+		return nil, errors.New("unable to get private key from secret")
+	}
+
+	return hex.DecodeString(privKeyStr)
+}
+
+// Import stores an existing private key in the plugin
+func (v *vaultPluginIden3KeyProvider) Import(_ context.Context, identity *w3c.DID, privKey []byte) (KeyID, error) {
+	var pubKeyStr string
+	var err error
+	switch v.keyType {
+	case KeyTypeBabyJubJub:
+		pubKeyStr, err = bjjPublicKeyHex(privKey)
+	case KeyTypeEthereum:
+		pubKeyStr, err = ethPublicKeyHex(privKey)
+	default:
+		return KeyID{}, errors.New("unsupported key type")
+	}
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	pluginKeyType, err := toPluginKeyType(v.keyType)
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	keyPath := v.keyPathFromPublic(identity, pubKeyStr)
+	_, err = v.vaultCli.Logical().Write(keyPath.importKey(), map[string]interface{}{
+		jsonKeyType:   pluginKeyType,
+		keyPrivateKey: hex.EncodeToString(privKey),
+	})
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	return KeyID{Type: v.keyType, ID: keyPath.keyID}, nil
+}
+
 func (v *vaultPluginIden3KeyProvider) randomKeyPath() (keyPathT, error) {
 	var rnd [16]byte
 	_, err := rand.Read(rnd[:])
@@ -367,6 +425,14 @@ func (p keyPathT) new() string {
 	return p.join("new")
 }
 
+func (p keyPathT) private() string {
+	return p.join("private")
+}
+
+func (p keyPathT) importKey() string {
+	return p.join("import")
+}
+
 func toPluginKeyType(keyType KeyType) (pluginIden3KeyTp, error) {
 	switch keyType {
 	case KeyTypeBabyJubJub:
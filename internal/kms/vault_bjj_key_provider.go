@@ -228,3 +228,25 @@ func DecodeBJJSignature(sigBytes []byte) (*babyjub.Signature, error) {
 	sig, err := sigComp.Decompress()
 	return sig, err
 }
+
+// Export returns the raw private key for the given key ID
+func (v *vaultBJJKeyProvider) Export(_ context.Context, keyID KeyID) ([]byte, error) {
+	return v.privateKey(keyID)
+}
+
+// Import stores an existing BabyJubJub private key
+func (v *vaultBJJKeyProvider) Import(_ context.Context, identity *w3c.DID, privKey []byte) (KeyID, error) {
+	pubKeyHex, err := bjjPublicKeyHex(privKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{
+		Type: v.keyType,
+		ID:   keyPath(identity, v.keyType, pubKeyHex),
+	}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(keyID.Type),
+		jsonKeyData: hex.EncodeToString(privKey),
+	}
+	return keyID, saveKeyMaterial(v.vaultCli, keyID.ID, keyMaterial)
+}
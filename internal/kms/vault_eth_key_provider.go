@@ -203,3 +203,28 @@ func DecodeETHPubKey(key []byte) (*ecdsa.PublicKey, error) {
 	pubKey, err := crypto.DecompressPubkey(key)
 	return pubKey, errors.WithStack(err)
 }
+
+// Export returns the raw private key for the given key ID
+func (v *vaultETHKeyProvider) Export(_ context.Context, keyID KeyID) ([]byte, error) {
+	return v.privateKey(keyID)
+}
+
+// Import stores an existing Ethereum private key
+func (v *vaultETHKeyProvider) Import(_ context.Context, identity *w3c.DID, privKey []byte) (KeyID, error) {
+	keyID := KeyID{Type: v.keyType}
+	if identity == nil {
+		return keyID, errors.New(
+			"Ethereum keys can be imported only for non-nil identities")
+	}
+
+	pubKeyHex, err := ethPublicKeyHex(privKey)
+	if err != nil {
+		return keyID, err
+	}
+	keyID.ID = keyPath(identity, v.keyType, pubKeyHex)
+	keyMaterial := map[string]string{
+		jsonKeyType: string(KeyTypeEthereum),
+		jsonKeyData: hex.EncodeToString(privKey),
+	}
+	return keyID, saveKeyMaterial(v.vaultCli, keyID.ID, keyMaterial)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/iden3/go-iden3-auth/v2/pubsignals"
 	"github.com/iden3/go-iden3-auth/v2/state"
 	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"gopkg.in/yaml.v3"
 
@@ -51,6 +53,8 @@ type ResolverClientConfig struct {
 type Resolver struct {
 	ethereumClients    map[resolverPrefix]ResolverClientConfig
 	rhsSettings        map[resolverPrefix]RhsSettings
+	publishingSettings map[resolverPrefix]PublishingSettings
+	onchainIssuance    map[resolverPrefix]OnchainIssuanceSettings
 	supportedContracts map[string]*abi.State
 	stateResolvers     map[string]pubsignals.StateResolver
 	supportedNetworks  []SupportedNetworks
@@ -73,25 +77,103 @@ type RhsSettings struct {
 	SingleIssuer         bool
 }
 
+// PublishingSettings holds the accounts used to publish the states of the BJJ identities of a network.
+// Keys are ordered by priority: the first funded one is used and the next ones are the failover accounts.
+// Identities can have their own list of keys, indexed by DID. If there are no keys the default publishing key is used.
+type PublishingSettings struct {
+	Keys          []string            `yaml:"keys"`
+	Identities    map[string][]string `yaml:"identities"`
+	MinBalanceWei *big.Int            `yaml:"minBalanceWei"`
+	LowBalanceWei *big.Int            `yaml:"lowBalanceWei"`
+}
+
+// KeysFor returns the publishing keys for the identity
+func (s PublishingSettings) KeysFor(did string) []string {
+	if keys, ok := s.Identities[did]; ok && len(keys) > 0 {
+		return keys
+	}
+	return s.Keys
+}
+
+// ChecksBalance returns true if the balance of the accounts must be checked before publishing
+func (s PublishingSettings) ChecksBalance() bool {
+	return s.MinBalanceWei != nil || s.LowBalanceWei != nil
+}
+
+// IsFunded returns true if the balance is enough to publish a state
+func (s PublishingSettings) IsFunded(balance *big.Int) bool {
+	if balance.Sign() <= 0 {
+		return false
+	}
+	return s.MinBalanceWei == nil || balance.Cmp(s.MinBalanceWei) >= 0
+}
+
+// IsLowBalance returns true if the balance is under the alert threshold
+func (s PublishingSettings) IsLowBalance(balance *big.Int) bool {
+	return s.LowBalanceWei != nil && balance.Cmp(s.LowBalanceWei) < 0
+}
+
+// OnchainIssuanceSettings holds the on-chain identity contracts of the identities that issue credentials on chain,
+// indexed by DID. The claims are published to the contracts with the publishing key, so it must be allowed to add
+// claims to them. If there is no publishing key the default publishing key is used.
+type OnchainIssuanceSettings struct {
+	PublishingKey string            `yaml:"publishingKey"`
+	Identities    map[string]string `yaml:"identities"`
+}
+
+// ContractFor returns the address of the on-chain identity contract of the identity
+func (s OnchainIssuanceSettings) ContractFor(did string) (common.Address, bool) {
+	address, ok := s.Identities[did]
+	if !ok {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(address), true
+}
+
+// Validate returns an error if a contract is not the on-chain identity of its DID. The ID of an on-chain identity is
+// derived from the address of its contract, so the state of the contract is only resolved under that DID.
+func (s OnchainIssuanceSettings) Validate() error {
+	for did, address := range s.Identities {
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("invalid on-chain identity contract address for identity %s", did)
+		}
+		parsed, err := w3c.ParseDID(did)
+		if err != nil {
+			return fmt.Errorf("invalid on-chain identity %s: %w", did, err)
+		}
+		id, err := core.IDFromDID(*parsed)
+		if err != nil {
+			return fmt.Errorf("invalid on-chain identity %s: %w", did, err)
+		}
+		idAddress, err := core.EthAddressFromID(id)
+		if err != nil || common.Address(idAddress) != common.HexToAddress(address) {
+			return fmt.Errorf("the contract %s is not the on-chain identity of %s", address, did)
+		}
+	}
+	return nil
+}
+
 // ResolverSettings holds the resolver settings
 type ResolverSettings map[string]map[string]struct {
-	ContractAddress        string        `yaml:"contractAddress"`
-	NetworkURL             string        `yaml:"networkURL"`
-	DefaultGasLimit        int           `yaml:"defaultGasLimit"`
-	ConfirmationTimeout    time.Duration `yaml:"confirmationTimeout"`
-	ConfirmationBlockCount int64         `yaml:"confirmationBlockCount"`
-	ReceiptTimeout         time.Duration `yaml:"receiptTimeout"`
-	MinGasPrice            int           `yaml:"minGasPrice"`
-	MaxGasPrice            int           `yaml:"maxGasPrice"`
-	RPCResponseTimeout     time.Duration `yaml:"rpcResponseTimeout"`
-	WaitReceiptCycleTime   time.Duration `yaml:"waitReceiptCycleTime"`
-	WaitBlockCycleTime     time.Duration `yaml:"waitBlockCycleTime"`
-	GasLess                bool          `yaml:"gasLess"`
-	TransferAmountWei      *big.Int      `yaml:"transferAmountWei"`
-	RhsSettings            RhsSettings   `yaml:"rhsSettings"`
-	NetworkFlag            byte          `yaml:"networkFlag"`
-	ChainID                string        `yaml:"chainID"`
-	Method                 string        `yaml:"method"`
+	ContractAddress        string                  `yaml:"contractAddress"`
+	NetworkURL             string                  `yaml:"networkURL"`
+	DefaultGasLimit        int                     `yaml:"defaultGasLimit"`
+	ConfirmationTimeout    time.Duration           `yaml:"confirmationTimeout"`
+	ConfirmationBlockCount int64                   `yaml:"confirmationBlockCount"`
+	ReceiptTimeout         time.Duration           `yaml:"receiptTimeout"`
+	MinGasPrice            int                     `yaml:"minGasPrice"`
+	MaxGasPrice            int                     `yaml:"maxGasPrice"`
+	RPCResponseTimeout     time.Duration           `yaml:"rpcResponseTimeout"`
+	WaitReceiptCycleTime   time.Duration           `yaml:"waitReceiptCycleTime"`
+	WaitBlockCycleTime     time.Duration           `yaml:"waitBlockCycleTime"`
+	GasLess                bool                    `yaml:"gasLess"`
+	TransferAmountWei      *big.Int                `yaml:"transferAmountWei"`
+	RhsSettings            RhsSettings             `yaml:"rhsSettings"`
+	PublishingSettings     PublishingSettings      `yaml:"publishingSettings"`
+	OnchainIssuance        OnchainIssuanceSettings `yaml:"onchainIssuanceSettings"`
+	NetworkFlag            byte                    `yaml:"networkFlag"`
+	ChainID                string                  `yaml:"chainID"`
+	Method                 string                  `yaml:"method"`
 }
 
 // NewResolver returns a new Network Resolver
@@ -104,6 +186,8 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 
 	ethereumClients := make(map[resolverPrefix]ResolverClientConfig)
 	rhsSettings := make(map[resolverPrefix]RhsSettings)
+	publishingSettings := make(map[resolverPrefix]PublishingSettings)
+	onchainIssuance := make(map[resolverPrefix]OnchainIssuanceSettings)
 	supportedContracts := make(map[string]*abi.State)
 	stateResolvers := make(map[string]pubsignals.StateResolver)
 
@@ -164,6 +248,22 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 			}
 
 			rhsSettings[resolverPrefix(resolverPrefixKey)] = settings
+
+			for _, key := range networkSettings.PublishingSettings.Keys {
+				if key == "" {
+					return nil, fmt.Errorf("empty publishing key for %s", resolverPrefixKey)
+				}
+			}
+			for did, keys := range networkSettings.PublishingSettings.Identities {
+				if slices.Contains(keys, "") {
+					return nil, fmt.Errorf("empty publishing key for identity %s in %s", did, resolverPrefixKey)
+				}
+			}
+			publishingSettings[resolverPrefix(resolverPrefixKey)] = networkSettings.PublishingSettings
+			if err := networkSettings.OnchainIssuance.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid on-chain issuance settings in %s: %w", resolverPrefixKey, err)
+			}
+			onchainIssuance[resolverPrefix(resolverPrefixKey)] = networkSettings.OnchainIssuance
 			stateContract, err := abi.NewState(common.HexToAddress(networkSettings.ContractAddress), ethClient)
 			if err != nil {
 				return nil, fmt.Errorf("error failed create state contract client: %s", err.Error())
@@ -184,6 +284,8 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 	return &Resolver{
 		ethereumClients:    ethereumClients,
 		rhsSettings:        rhsSettings,
+		publishingSettings: publishingSettings,
+		onchainIssuance:    onchainIssuance,
 		supportedContracts: supportedContracts,
 		stateResolvers:     stateResolvers,
 		supportedNetworks:  supportedNetworks,
@@ -235,6 +337,16 @@ func (r *Resolver) GetRhsSettingsForBlockchainAndNetwork(ctx context.Context, bl
 	return rhsSettings, nil
 }
 
+// GetPublishingSettings returns the publishing accounts settings of the network
+func (r *Resolver) GetPublishingSettings(resolverPrefixKey string) PublishingSettings {
+	return r.publishingSettings[resolverPrefix(resolverPrefixKey)]
+}
+
+// GetOnchainIssuanceSettings returns the on-chain issuance settings of the network
+func (r *Resolver) GetOnchainIssuanceSettings(resolverPrefixKey string) OnchainIssuanceSettings {
+	return r.onchainIssuance[resolverPrefix(resolverPrefixKey)]
+}
+
 // GetConfirmationBlockCount returns the confirmation block count
 func (r *Resolver) GetConfirmationBlockCount(resolverPrefixKey string) (int64, error) {
 	resolverClientConfig, ok := r.ethereumClients[resolverPrefix(resolverPrefixKey)]
@@ -0,0 +1,57 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnchainIssuanceSettings_Validate(t *testing.T) {
+	contract := common.HexToAddress("0x5fbdb2315678afecb367f032d93f642f64180aa3")
+	typ, err := core.BuildDIDType(core.DIDMethodIden3, core.Polygon, core.Amoy)
+	require.NoError(t, err)
+	contractDID, err := core.ParseDIDFromID(core.NewID(typ, core.GenesisFromEthAddress(contract)))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name       string
+		identities map[string]string
+		err        string
+	}{
+		{name: "no identities"},
+		{name: "the DID of the contract", identities: map[string]string{contractDID.String(): contract.Hex()}},
+		{
+			name:       "invalid address",
+			identities: map[string]string{contractDID.String(): "0x1234"},
+			err:        "invalid on-chain identity contract address for identity " + contractDID.String(),
+		},
+		{
+			name:       "invalid DID",
+			identities: map[string]string{"did:iden3:wrong": contract.Hex()},
+			err:        "invalid on-chain identity did:iden3:wrong",
+		},
+		{
+			name:       "the DID of another identity",
+			identities: map[string]string{"did:iden3:polygon:amoy:xBdqiqz3yVT79NEAuNaqKSDZ6a5V6q8Ph66i5d2tT": contract.Hex()},
+			err:        "the contract " + contract.Hex() + " is not the on-chain identity of did:iden3:polygon:amoy:xBdqiqz3yVT79NEAuNaqKSDZ6a5V6q8Ph66i5d2tT",
+		},
+		{
+			name:       "another contract",
+			identities: map[string]string{contractDID.String(): "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"},
+			err:        "the contract 0xe7f1725e7734ce288f8367e1bb143e90bb3f0512 is not the on-chain identity of " + contractDID.String(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := OnchainIssuanceSettings{Identities: tc.identities}.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package packagemanager
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/iden3/go-iden3-crypto/utils"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jws"
+
+	"github.com/wakeup-labs/issuer-node/internal/kms"
+)
+
+// ES256KR is the recoverable secp256k1 signature algorithm accepted by the iden3comm JWS packer.
+// The signature is the 65 bytes [R || S || V] ethereum signature, so it can be verified with the
+// blockchainAccountId of the DID document.
+const ES256KR jwa.SignatureAlgorithm = "ES256K-R"
+
+var registerES256KR sync.Once
+
+// KMSSigner is a crypto.Signer that signs the JWS messages with a key of the kms
+type KMSSigner struct {
+	ctx   context.Context
+	kms   kms.KMSType
+	keyID kms.KeyID
+}
+
+// NewKMSSigner returns a crypto.Signer for the kms key.
+// BJJ keys produce the signatures expected by the iden3comm BJJ provider and ethereum keys the ES256K-R ones.
+func NewKMSSigner(ctx context.Context, keyStore kms.KMSType, keyID kms.KeyID) *KMSSigner {
+	registerES256KR.Do(func() {
+		jws.RegisterSigner(ES256KR, jws.SignerFactoryFn(func() (jws.Signer, error) {
+			return &es256krSigner{}, nil
+		}))
+	})
+	return &KMSSigner{ctx: ctx, kms: keyStore, keyID: keyID}
+}
+
+// Public returns nil because the public key is taken from the DID document
+func (s *KMSSigner) Public() crypto.PublicKey {
+	return nil
+}
+
+// Sign signs the digest with the kms key
+func (s *KMSSigner) Sign(_ io.Reader, digest []byte, _ crypto.SignerOpts) ([]byte, error) {
+	switch s.keyID.Type {
+	case kms.KeyTypeBabyJubJub:
+		// the BJJ provider gives a big endian digest and expects the hex encoded compressed signature
+		sig, err := s.kms.Sign(s.ctx, s.keyID, utils.SwapEndianness(digest))
+		if err != nil {
+			return nil, err
+		}
+		return []byte(hex.EncodeToString(sig)), nil
+	case kms.KeyTypeEthereum:
+		return s.kms.Sign(s.ctx, s.keyID, digest)
+	default:
+		return nil, errors.New("unsupported key type for JWS signatures")
+	}
+}
+
+// es256krSigner is the jws.Signer for ES256KR
+type es256krSigner struct{}
+
+// Algorithm implements jws.Signer
+func (s *es256krSigner) Algorithm() jwa.SignatureAlgorithm {
+	return ES256KR
+}
+
+// Sign implements jws.Signer
+func (s *es256krSigner) Sign(payload []byte, key interface{}) ([]byte, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("ES256K-R signer supports only the crypto.Signer interface")
+	}
+	digest := sha256.Sum256(payload)
+	return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
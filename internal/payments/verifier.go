@@ -0,0 +1,118 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/log"
+	"github.com/wakeup-labs/issuer-node/internal/network"
+)
+
+var (
+	// ErrTransactionNotFound the transaction does not exist on chain
+	ErrTransactionNotFound = errors.New("payment transaction not found")
+	// ErrTransactionPending the transaction has not been mined yet
+	ErrTransactionPending = errors.New("payment transaction is pending")
+	// ErrTransactionFailed the transaction was reverted
+	ErrTransactionFailed = errors.New("payment transaction failed")
+	// ErrPaymentMismatch the transaction does not pay the payment request
+	ErrPaymentMismatch = errors.New("the transaction does not pay the payment request")
+)
+
+// blockTimeSkew is the time that the timestamp of a block can be behind the clock of the issuer
+const blockTimeSkew = time.Minute
+
+// ChainReader is the part of the ethereum client used to verify the payments
+type ChainReader interface {
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Verifier checks the payment transactions on the network of the payment request
+type Verifier struct {
+	clients func(resolverPrefix string) (ChainReader, error)
+}
+
+// NewVerifier returns a verifier that reads the transactions with the ethereum clients of the network resolver
+func NewVerifier(networkResolver network.Resolver) *Verifier {
+	return &Verifier{
+		clients: func(resolverPrefix string) (ChainReader, error) {
+			client, err := networkResolver.GetEthClient(resolverPrefix)
+			if err != nil {
+				return nil, err
+			}
+			return client.GetEthereumClient(), nil
+		},
+	}
+}
+
+// Verify checks that the transaction was mined successfully in the chain of the request after the request was created
+// and that it transfers at least the requested amount to the recipient. Wallets cannot add data to the payments,
+// so the callers must not accept a transaction that already paid another request.
+func (v *Verifier) Verify(ctx context.Context, request *domain.PaymentRequest, txID string) error {
+	client, err := v.clients(request.ResolverPrefix())
+	if err != nil {
+		log.Error(ctx, "getting the payment network client", "err", err, "network", request.ResolverPrefix())
+		return err
+	}
+
+	hash := common.HexToHash(txID)
+	tx, pending, err := client.TransactionByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return ErrTransactionNotFound
+		}
+		return err
+	}
+	if pending {
+		return ErrTransactionPending
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return ErrTransactionPending
+		}
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return ErrTransactionFailed
+	}
+
+	if tx.ChainId().String() != request.ChainID {
+		return fmt.Errorf("%w: chain id %s", ErrPaymentMismatch, tx.ChainId())
+	}
+	if tx.To() == nil || *tx.To() != common.HexToAddress(request.Recipient) {
+		return fmt.Errorf("%w: wrong recipient", ErrPaymentMismatch)
+	}
+	if tx.Value().Cmp(request.Amount) < 0 {
+		return fmt.Errorf("%w: %s is less than %s", ErrPaymentMismatch, tx.Value(), request.Amount)
+	}
+
+	header, err := client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return err
+	}
+	if minedAt := time.Unix(int64(header.Time), 0); minedAt.Before(request.CreatedAt.Add(-blockTimeSkew)) {
+		return fmt.Errorf("%w: the transaction was mined before the payment request", ErrPaymentMismatch)
+	}
+	return nil
+}
+
+// Amount parses a decimal amount of wei. It must be positive.
+func Amount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || value.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount %s", amount)
+	}
+	return value, nil
+}
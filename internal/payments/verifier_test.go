@@ -0,0 +1,102 @@
+package payments
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	payer := crypto.PubkeyToAddress(key.PublicKey)
+	recipient := common.HexToAddress("0x8aF6A3F6c9B4bB8B9f1Fe3E0a6c3B4d4A4fD4c21")
+
+	backend := simulated.NewBackend(types.GenesisAlloc{payer: {Balance: big.NewInt(1e18)}})
+	defer func() { require.NoError(t, backend.Close()) }()
+	client := backend.Client()
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+
+	verifier := &Verifier{clients: func(string) (ChainReader, error) { return client, nil }}
+	newRequest := func(createdAt time.Time) *domain.PaymentRequest {
+		return &domain.PaymentRequest{
+			ID:        uuid.New(),
+			Amount:    big.NewInt(1000),
+			ChainID:   chainID.String(),
+			Recipient: recipient.Hex(),
+			CreatedAt: createdAt,
+		}
+	}
+	pay := func(to common.Address, value int64) string {
+		nonce, err := client.PendingNonceAt(ctx, payer)
+		require.NoError(t, err)
+		gasPrice, err := client.SuggestGasPrice(ctx)
+		require.NoError(t, err)
+		tx, err := types.SignTx(types.NewTransaction(nonce, to, big.NewInt(value), 100000, gasPrice, nil), types.LatestSignerForChainID(chainID), key)
+		require.NoError(t, err)
+		require.NoError(t, client.SendTransaction(ctx, tx))
+		backend.Commit()
+		return tx.Hash().Hex()
+	}
+
+	request := newRequest(time.Now())
+	type testConfig struct {
+		name     string
+		request  *domain.PaymentRequest
+		txID     string
+		expected error
+	}
+	for _, tc := range []testConfig{
+		{
+			name:    "paid",
+			request: request,
+			txID:    pay(recipient, 1000),
+		},
+		{
+			name:     "unknown transaction",
+			request:  request,
+			txID:     common.HexToHash("0x01").Hex(),
+			expected: ErrTransactionNotFound,
+		},
+		{
+			name:     "mined before the payment request",
+			request:  newRequest(time.Now().Add(time.Hour)),
+			txID:     pay(recipient, 1000),
+			expected: ErrPaymentMismatch,
+		},
+		{
+			name:     "not enough",
+			request:  request,
+			txID:     pay(recipient, 999),
+			expected: ErrPaymentMismatch,
+		},
+		{
+			name:     "wrong recipient",
+			request:  request,
+			txID:     pay(payer, 1000),
+			expected: ErrPaymentMismatch,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifier.Verify(ctx, tc.request, tc.txID)
+			if tc.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.expected)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	vault "github.com/hashicorp/vault/api"
-	auth2 "github.com/hashicorp/vault/api/auth/userpass"
 
 	"github.com/wakeup-labs/issuer-node/internal/log"
 )
@@ -15,62 +14,113 @@ import (
 const (
 	increment = 1440
 	user      = "issuernode"
+
+	// loginRetryDelay is the time to wait before trying to log in again after a failed login
+	loginRetryDelay = 10 * time.Second
+	// reloginTTLDivisor makes non renewable tokens to be replaced once half of their ttl has passed
+	reloginTTLDivisor = 2
 )
 
 // HTTPClientTimeout http client timeout TODO: move to config
 const HTTPClientTimeout = 10 * time.Second
 
+// Supported vault auth methods
+const (
+	AuthMethodToken      = "token"
+	AuthMethodUserPass   = "userpass"
+	AuthMethodAppRole    = "approle"
+	AuthMethodKubernetes = "kubernetes"
+)
+
 // Config vault configuration
-// If UserPassAuthEnabled is true, then vault client will be created with userpass auth and Pass must be provided
+// AuthMethod selects how the client gets its token. If it is empty, the userpass method is used
+// when UserPassAuthEnabled is true (Pass must be provided) and the static Token otherwise.
 type Config struct {
 	Address             string
+	AuthMethod          string
 	UserPassAuthEnabled bool
 	Token               string
 	Pass                string
+	AppRoleID           string
+	AppRoleSecretID     string
+	AppRoleMountPath    string
+	KubernetesRole      string
+	KubernetesTokenPath string
+	KubernetesMountPath string
 	TLSEnabled          bool
 	CertPath            string
 	MountPath           string
 }
 
-// VaultClient checks vault configuration and creates new vault client
-func VaultClient(ctx context.Context, cfg Config) (*vault.Client, error) {
-	var vaultCli *vault.Client
-	var err error
+// Method returns the auth method used by the configuration
+func (cfg Config) Method() string {
+	if cfg.AuthMethod != "" {
+		return cfg.AuthMethod
+	}
 	if cfg.UserPassAuthEnabled {
-		log.Info(ctx, "Vault userpass auth enabled")
-		if cfg.Pass == "" {
-			log.Error(ctx, "Vault userpass auth enabled but password not provided")
-			return nil, errors.New("Vault userpass auth enabled but password not provided")
+		return AuthMethodUserPass
+	}
+	return AuthMethodToken
+}
+
+// Validate checks that the configuration has the parameters required by the auth method
+func (cfg Config) Validate() error {
+	switch cfg.Method() {
+	case AuthMethodToken:
+		if cfg.Token == "" {
+			return errors.New("vault userpass auth not enabled but token not provided")
 		}
-		vaultCli, _, err = newVaultClientWithUserPassAuth(ctx, cfg)
-		if err != nil {
-			log.Error(ctx, "cannot init vault client with userpass auth: ", "err", err)
-			return nil, err
+	case AuthMethodUserPass:
+		if cfg.Pass == "" {
+			return errors.New("Vault userpass auth enabled but password not provided")
 		}
-	} else {
-		log.Info(ctx, "Vault userpass auth not enabled")
-		if cfg.Token == "" {
-			log.Error(ctx, "Vault userpass auth not enabled but token not provided")
-			return nil, errors.New("vault userpass auth not enabled but token not provided")
+	case AuthMethodAppRole:
+		if cfg.AppRoleID == "" || cfg.AppRoleSecretID == "" {
+			return errors.New("vault approle auth enabled but role id or secret id not provided")
 		}
-		vaultCli, err = newVaultClientWithToken(cfg)
-		if err != nil {
-			log.Error(ctx, "cannot init vault client: ", "err", err)
-			return nil, err
+	case AuthMethodKubernetes:
+		if cfg.KubernetesRole == "" {
+			return errors.New("vault kubernetes auth enabled but role not provided")
 		}
+	default:
+		return fmt.Errorf("unsupported vault auth method: %s", cfg.AuthMethod)
 	}
+	return nil
+}
 
+// VaultClient checks vault configuration and creates new vault client
+func VaultClient(ctx context.Context, cfg Config) (*vault.Client, error) {
+	method := cfg.Method()
+	log.Info(ctx, "Vault auth method", "method", method)
+	if err := cfg.Validate(); err != nil {
+		log.Error(ctx, "invalid vault configuration", "err", err)
+		return nil, err
+	}
+
+	vaultCli, err := newVaultClient(cfg)
+	if err != nil {
+		log.Error(ctx, "cannot init vault client: ", "err", err)
+		return nil, err
+	}
+
+	if method == AuthMethodToken {
+		vaultCli.SetToken(cfg.Token)
+		return vaultCli, nil
+	}
+
+	if _, err := login(ctx, vaultCli, cfg); err != nil {
+		log.Error(ctx, "cannot init vault client", "err", err, "method", method)
+		return nil, err
+	}
+	log.Info(ctx, "successfully logged in to vault", "method", method)
 	return vaultCli, nil
 }
 
-// newVaultClientWithToken checks vault configuration and creates new vault client
-func newVaultClientWithToken(cfg Config) (*vault.Client, error) {
+// newVaultClient creates a vault client without token
+func newVaultClient(cfg Config) (*vault.Client, error) {
 	if cfg.Address == "" {
 		return nil, errors.New("vault address is not specified")
 	}
-	if cfg.Token == "" {
-		return nil, errors.New("vault access token is not specified")
-	}
 
 	config := vault.DefaultConfig()
 	if cfg.TLSEnabled {
@@ -83,82 +133,117 @@ func newVaultClientWithToken(cfg Config) (*vault.Client, error) {
 	}
 	config.Address = cfg.Address
 	config.HttpClient.Timeout = HTTPClientTimeout
-	client, err := vault.NewClient(config)
+	return vault.NewClient(config)
+}
+
+// login authenticates with the configured auth method and sets the new token in the client
+func login(ctx context.Context, client *vault.Client, cfg Config) (*vault.Secret, error) {
+	auth, err := authMethod(cfg)
 	if err != nil {
+		log.Error(ctx, "error creating vault auth method", "error", err)
 		return nil, err
 	}
 
-	client.SetToken(cfg.Token)
-	return client, nil
+	secret, err := client.Auth().Login(ctx, auth)
+	if err != nil {
+		log.Error(ctx, "error logging in to vault", "error", err, "method", cfg.Method())
+		return nil, err
+	}
+	return secret, nil
 }
 
-// newVaultClientWithUserPassAuth checks vault configuration and creates new vault client with userpass auth
-func newVaultClientWithUserPassAuth(ctx context.Context, cfg Config) (*vault.Client, *vault.Secret, error) {
-	config := vault.DefaultConfig()
-	config.Address = cfg.Address
-	config.HttpClient.Timeout = HTTPClientTimeout
-
-	if cfg.TLSEnabled {
-		err := config.ConfigureTLS(&vault.TLSConfig{
-			CACert: cfg.CertPath,
-		})
-		if err != nil {
-			return nil, nil, err
-		}
+// RenewToken keeps the vault token of the client alive until the context is done.
+// Renewable tokens are renewed before they expire. With the userpass, approle and kubernetes
+// auth methods a new login is done when the token cannot be renewed anymore. The client is
+// expected to be logged in already (see VaultClient), so its current token is managed first.
+// Static tokens cannot be recovered once they expire, so they are only renewed while possible.
+func RenewToken(ctx context.Context, client *vault.Client, cfg Config) {
+	if cfg.Method() == AuthMethodToken {
+		renewStaticToken(ctx, client)
+		return
 	}
 
-	client, err := vault.NewClient(config)
+	secret, err := currentToken(ctx, client)
 	if err != nil {
-		log.Error(ctx, "error creating vault client with userpass auth", "error", err)
-		return nil, nil, err
+		log.Warn(ctx, "unable to look up the current vault token, logging in again", "err", err)
 	}
-
-	secret, err := login(ctx, client, user, cfg.Pass)
-	if err != nil {
-		log.Error(ctx, "error logging in to vault with userpass auth", "error", err)
-		return nil, nil, err
+	for ctx.Err() == nil {
+		if secret == nil {
+			secret, err = login(ctx, client, cfg)
+			if err != nil {
+				log.Error(ctx, "unable to authenticate to Vault", "err", err, "method", cfg.Method())
+				if !wait(ctx, loginRetryDelay) {
+					return
+				}
+				continue
+			}
+		}
+		if err := manageTokenLifecycle(ctx, client, secret); err != nil {
+			log.Error(ctx, "unable to start managing token lifecycle", "err", err)
+			if !wait(ctx, loginRetryDelay) {
+				return
+			}
+		}
+		secret = nil
 	}
-
-	log.Info(ctx, "successfully logged in to vault with userpass auth", "token", secret.Auth.ClientToken)
-	return client, secret, nil
 }
 
-func login(ctx context.Context, client *vault.Client, user string, pass string) (*vault.Secret, error) {
-	userPass, err := auth2.NewUserpassAuth(user, &auth2.Password{
-		FromString: pass,
-	})
+// currentToken returns the auth information of the token of the client
+func currentToken(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	if client.Token() == "" {
+		return nil, errors.New("the client has no token")
+	}
+	lookup, err := client.Auth().Token().LookupSelfWithContext(ctx)
 	if err != nil {
-		log.Error(ctx, "error creating userpass auth", "error", err)
 		return nil, err
 	}
-
-	secret, err := client.Auth().Login(ctx, userPass)
+	ttl, err := lookup.TokenTTL()
 	if err != nil {
-		log.Error(ctx, "error logging in to vault with userpass auth", "error", err)
 		return nil, err
 	}
-
-	return secret, nil
+	renewable, err := lookup.TokenIsRenewable()
+	if err != nil {
+		return nil, err
+	}
+	return &vault.Secret{Auth: &vault.SecretAuth{
+		ClientToken:   client.Token(),
+		Renewable:     renewable,
+		LeaseDuration: int(ttl.Seconds()),
+	}}, nil
 }
 
-// RenewToken renews token
-func RenewToken(ctx context.Context, client *vault.Client, cfg Config) {
-	for {
-		vaultLoginResp, err := login(ctx, client, user, cfg.Pass)
-		if err != nil {
-			log.Error(ctx, "unable to authenticate to Vault: %v", "err", err)
-		}
-		tokenErr := manageTokenLifecycle(ctx, client, vaultLoginResp)
-		if tokenErr != nil {
-			log.Error(ctx, "unable to start managing token lifecycle: %v", "err", tokenErr)
-		}
+// renewStaticToken renews the token given in the configuration while it is renewable
+func renewStaticToken(ctx context.Context, client *vault.Client) {
+	secret, err := client.Auth().Token().RenewSelfWithContext(ctx, increment)
+	if err != nil {
+		log.Warn(ctx, "vault token cannot be renewed, it will not be refreshed", "err", err)
+		return
+	}
+	if secret == nil || secret.Auth == nil || !secret.Auth.Renewable || secret.Auth.LeaseDuration == 0 {
+		log.Info(ctx, "vault token is not renewable or does not expire")
+		return
+	}
+	if err := manageTokenLifecycle(ctx, client, secret); err != nil {
+		log.Error(ctx, "unable to start managing token lifecycle", "err", err)
+		return
+	}
+	if ctx.Err() == nil {
+		log.Error(ctx, "vault token can no longer be renewed and will expire. Provide a new token or use a login based auth method")
 	}
 }
 
+// manageTokenLifecycle returns when the token must be replaced by a new login or the context is done
 func manageTokenLifecycle(ctx context.Context, client *vault.Client, token *vault.Secret) error {
 	renew := token.Auth.Renewable // You may notice a different top-level field called Renewable. That one is used for dynamic secrets renewal, not token renewal.
 	if !renew {
-		log.Info(ctx, "Token is not configured to be renewable. Re-attempting login.")
+		// Log in again before the token expires
+		ttl := time.Duration(token.Auth.LeaseDuration) * time.Second
+		log.Info(ctx, "Token is not configured to be renewable. Re-attempting login before it expires.", "ttl", ttl)
+		if ttl == 0 {
+			<-ctx.Done()
+			return nil
+		}
+		wait(ctx, ttl/reloginTTLDivisor)
 		return nil
 	}
 
@@ -175,13 +260,15 @@ func manageTokenLifecycle(ctx context.Context, client *vault.Client, token *vaul
 
 	for {
 		select {
+		case <-ctx.Done():
+			return nil
 		// `DoneCh` will return if renewal fails, or if the remaining lease
 		// duration is under a built-in threshold and either renewing is not
 		// extending it or renewing is disabled. In any case, the caller
 		// needs to attempt to log in again.
 		case err := <-watcher.DoneCh():
 			if err != nil {
-				log.Error(ctx, "Failed to renew token: %v. Re-attempting login.", "error", err)
+				log.Error(ctx, "Failed to renew token. Re-attempting login.", "error", err)
 				return nil
 			}
 			// This occurs once the token has reached max TTL.
@@ -194,3 +281,15 @@ func manageTokenLifecycle(ctx context.Context, client *vault.Client, token *vaul
 		}
 	}
 }
+
+// wait blocks for the given duration. It returns false if the context is done before.
+func wait(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
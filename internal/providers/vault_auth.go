@@ -0,0 +1,88 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+	auth2 "github.com/hashicorp/vault/api/auth/userpass"
+)
+
+const (
+	defaultAppRoleMountPath    = "approle"
+	defaultKubernetesMountPath = "kubernetes"
+	// DefaultKubernetesTokenPath is where kubernetes mounts the service account token of the pod
+	DefaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token" // #nosec G101
+)
+
+// authMethod returns the vault login method for the configuration
+func authMethod(cfg Config) (vault.AuthMethod, error) {
+	switch cfg.Method() {
+	case AuthMethodUserPass:
+		return auth2.NewUserpassAuth(user, &auth2.Password{
+			FromString: cfg.Pass,
+		})
+	case AuthMethodAppRole:
+		return &appRoleAuth{
+			mountPath: valueOrDefault(cfg.AppRoleMountPath, defaultAppRoleMountPath),
+			roleID:    cfg.AppRoleID,
+			secretID:  cfg.AppRoleSecretID,
+		}, nil
+	case AuthMethodKubernetes:
+		return &kubernetesAuth{
+			mountPath: valueOrDefault(cfg.KubernetesMountPath, defaultKubernetesMountPath),
+			role:      cfg.KubernetesRole,
+			tokenPath: valueOrDefault(cfg.KubernetesTokenPath, DefaultKubernetesTokenPath),
+		}, nil
+	default:
+		return nil, fmt.Errorf("vault auth method %s does not support login", cfg.Method())
+	}
+}
+
+// appRoleAuth logs in with the AppRole auth method
+type appRoleAuth struct {
+	mountPath string
+	roleID    string
+	secretID  string
+}
+
+// Login implements vault.AuthMethod
+func (a *appRoleAuth) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	return client.Logical().WriteWithContext(ctx, fmt.Sprintf("auth/%s/login", a.mountPath), map[string]any{
+		"role_id":   a.roleID,
+		"secret_id": a.secretID,
+	})
+}
+
+// kubernetesAuth logs in with the Kubernetes auth method using the service account token of the pod.
+// The token is read on every login because kubernetes rotates it.
+type kubernetesAuth struct {
+	mountPath string
+	role      string
+	tokenPath string
+}
+
+// Login implements vault.AuthMethod
+func (k *kubernetesAuth) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	jwt, err := os.ReadFile(k.tokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read kubernetes service account token: %w", err)
+	}
+	if len(jwt) == 0 {
+		return nil, errors.New("kubernetes service account token is empty")
+	}
+	return client.Logical().WriteWithContext(ctx, fmt.Sprintf("auth/%s/login", k.mountPath), map[string]any{
+		"role": k.role,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	})
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,125 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault answers the userpass login and the token self endpoints, counting the calls
+type fakeVault struct {
+	mu            sync.Mutex
+	leaseDuration int
+	renewFails    bool
+	lookupFails   bool
+	logins        int
+	renewals      int
+	lookups       []string
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	auth := func(token string) map[string]any {
+		return map[string]any{"auth": map[string]any{"client_token": token, "renewable": true, "lease_duration": v.leaseDuration}}
+	}
+	var response any
+	switch r.URL.Path {
+	case "/v1/auth/userpass/login/" + user:
+		v.logins++
+		response = auth(fmt.Sprintf("token-%d", v.logins))
+	case "/v1/auth/token/lookup-self":
+		token := r.Header.Get("X-Vault-Token")
+		v.lookups = append(v.lookups, token)
+		if v.lookupFails {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		response = map[string]any{"data": map[string]any{"id": token, "ttl": v.leaseDuration, "renewable": true}}
+	case "/v1/auth/token/renew-self":
+		if v.renewFails {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		v.renewals++
+		response = auth(r.Header.Get("X-Vault-Token"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (v *fakeVault) calls() (logins int, renewals int) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.logins, v.renewals
+}
+
+func TestRenewToken(t *testing.T) {
+	type testConfig struct {
+		name     string
+		vault    *fakeVault
+		renewals int
+		logins   int
+	}
+	for _, tc := range []testConfig{
+		{
+			name:     "renews the token of the login",
+			vault:    &fakeVault{leaseDuration: 2},
+			renewals: 1,
+			logins:   1,
+		},
+		{
+			name:   "logs in again when the token cannot be renewed",
+			vault:  &fakeVault{leaseDuration: 2, renewFails: true},
+			logins: 2,
+		},
+		{
+			name:   "logs in again when the token cannot be looked up",
+			vault:  &fakeVault{leaseDuration: 60, lookupFails: true},
+			logins: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			server := httptest.NewServer(tc.vault)
+			defer server.Close()
+
+			cfg := Config{Address: server.URL, UserPassAuthEnabled: true, Pass: "password"}
+			client, err := VaultClient(ctx, cfg)
+			require.NoError(t, err)
+			assert.Equal(t, "token-1", client.Token())
+
+			done := make(chan struct{})
+			go func() {
+				RenewToken(ctx, client, cfg)
+				close(done)
+			}()
+
+			require.Eventually(t, func() bool {
+				logins, renewals := tc.vault.calls()
+				return logins >= tc.logins && renewals >= tc.renewals
+			}, 10*time.Second, 50*time.Millisecond)
+			cancel()
+			<-done
+
+			logins, _ := tc.vault.calls()
+			assert.Equal(t, tc.logins, logins)
+			require.NotEmpty(t, tc.vault.lookups)
+			// the token of the first login is managed instead of logging in again
+			assert.Equal(t, "token-1", tc.vault.lookups[0])
+		})
+	}
+}
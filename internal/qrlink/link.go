@@ -11,6 +11,7 @@ import (
 const (
 	requestURI           = "%s/v2/qr-store?id=%s"
 	requestURIWithIssuer = "%s/v2/qr-store?id=%s&issuer=%s"
+	requestURIWithInvite = "%s/v2/qr-store?id=%s&issuer=%s&invite=%s"
 )
 
 // NewDeepLink creates a deep link
@@ -31,3 +32,15 @@ func NewUniversal(uLinkBaseUrl string, hostURL string, id uuid.UUID, issuerDID *
 	}
 	return fmt.Sprintf("%s#request_uri=%s", uLinkBaseUrl, url.QueryEscape(fmt.Sprintf(requestURI, hostURL, id.String())))
 }
+
+// NewInviteDeepLink creates the deep link of a link for the holder of one of its invite codes
+func NewInviteDeepLink(hostURL string, id uuid.UUID, issuerDID w3c.DID, inviteCode string) string {
+	requestUri := fmt.Sprintf(requestURIWithInvite, hostURL, id.String(), issuerDID.String(), url.QueryEscape(inviteCode))
+	return fmt.Sprintf("iden3comm://?request_uri=%s", url.QueryEscape(requestUri))
+}
+
+// NewInviteUniversal creates the universal link of a link for the holder of one of its invite codes
+func NewInviteUniversal(uLinkBaseUrl string, hostURL string, id uuid.UUID, issuerDID w3c.DID, inviteCode string) string {
+	requestUri := fmt.Sprintf(requestURIWithInvite, hostURL, id.String(), issuerDID.String(), url.QueryEscape(inviteCode))
+	return fmt.Sprintf("%s#request_uri=%s", uLinkBaseUrl, url.QueryEscape(requestUri))
+}
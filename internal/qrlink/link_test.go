@@ -31,3 +31,26 @@ func TestDeepLink(t *testing.T) {
 	got := NewDeepLink(hostURL, id, issuerDID)
 	assert.Equal(t, expected, got)
 }
+
+func TestNewInviteUniversal(t *testing.T) {
+	baseURL := "https://wallet-dev.privado.id/"
+	hostURL := "https://issuer-node-core-api-testing.privado.id"
+	id, err := uuid.Parse("1f209581-ab1d-426d-88d9-2b545bdb851d")
+	require.NoError(t, err)
+	issuerDID, err := w3c.ParseDID("did:opid:optimism:sepolia:x7xjFDkoCW7MSQUZQwrXhyU5HqQ8npzEdAvHmBjqx")
+	require.NoError(t, err)
+	expected := "https://wallet-dev.privado.id/#request_uri=https%3A%2F%2Fissuer-node-core-api-testing.privado.id%2Fv2%2Fqr-store%3Fid%3D1f209581-ab1d-426d-88d9-2b545bdb851d%26issuer%3Ddid%3Aopid%3Aoptimism%3Asepolia%3Ax7xjFDkoCW7MSQUZQwrXhyU5HqQ8npzEdAvHmBjqx%26invite%3DMFRGGZDFMZTWQ2LK"
+	got := NewInviteUniversal(baseURL, hostURL, id, *issuerDID, "MFRGGZDFMZTWQ2LK")
+	assert.Equal(t, expected, got)
+}
+
+func TestInviteDeepLink(t *testing.T) {
+	hostURL := "https://issuer-node-core-api-testing.privado.id"
+	id, err := uuid.Parse("1f209581-ab1d-426d-88d9-2b545bdb851d")
+	require.NoError(t, err)
+	issuerDID, err := w3c.ParseDID("did:opid:optimism:sepolia:x7xjFDkoCW7MSQUZQwrXhyU5HqQ8npzEdAvHmBjqx")
+	require.NoError(t, err)
+	expected := "iden3comm://?request_uri=https%3A%2F%2Fissuer-node-core-api-testing.privado.id%2Fv2%2Fqr-store%3Fid%3D1f209581-ab1d-426d-88d9-2b545bdb851d%26issuer%3Ddid%3Aopid%3Aoptimism%3Asepolia%3Ax7xjFDkoCW7MSQUZQwrXhyU5HqQ8npzEdAvHmBjqx%26invite%3DMFRGGZDFMZTWQ2LK"
+	got := NewInviteDeepLink(hostURL, id, *issuerDID, "MFRGGZDFMZTWQ2LK")
+	assert.Equal(t, expected, got)
+}
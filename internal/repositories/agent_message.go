@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// ErrAgentMessageDuplicated the issuer already has a message with the same id and direction
+var ErrAgentMessageDuplicated = errors.New("agent message duplicated")
+
+type agentMessage struct{}
+
+// NewAgentMessage returns a new agent messages repository
+func NewAgentMessage() ports.AgentMessageRepository {
+	return &agentMessage{}
+}
+
+func (a *agentMessage) Save(ctx context.Context, conn db.Querier, message *domain.AgentMessage) error {
+	sql := `INSERT INTO agent_messages (id, message_id, thread_id, issuer_id, holder_id, direction, type, media_type, body,
+				created_time, expires_time, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	var body []byte
+	if len(message.Body) > 0 {
+		body = message.Body
+	}
+	_, err := conn.Exec(ctx, sql, message.ID, message.MessageID, message.ThreadID, message.IssuerDID.String(), message.HolderDID,
+		message.Direction, message.Type, message.MediaType, body, message.CreatedTime, message.ExpiresTime, message.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrAgentMessageDuplicated
+		}
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrorCode {
+			return ErrIdentityNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// GetThread returns the messages of the thread, oldest first
+func (a *agentMessage) GetThread(ctx context.Context, conn db.Querier, issuerDID w3c.DID, threadID string) ([]*domain.AgentMessage, error) {
+	sql := `SELECT id, message_id, thread_id, issuer_id, holder_id, direction, type, media_type, body, created_time, expires_time, created_at
+			FROM agent_messages
+			WHERE issuer_id = $1 AND thread_id = $2
+			ORDER BY created_at`
+	rows, err := conn.Query(ctx, sql, issuerDID.String(), threadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.AgentMessage, 0)
+	for rows.Next() {
+		var (
+			message   domain.AgentMessage
+			issuerDID string
+			body      []byte
+		)
+		if err := rows.Scan(&message.ID, &message.MessageID, &message.ThreadID, &issuerDID, &message.HolderDID, &message.Direction,
+			&message.Type, &message.MediaType, &body, &message.CreatedTime, &message.ExpiresTime, &message.CreatedAt); err != nil {
+			return nil, err
+		}
+		did, err := w3c.ParseDID(issuerDID)
+		if err != nil {
+			return nil, err
+		}
+		message.IssuerDID = *did
+		message.Body = body
+		messages = append(messages, &message)
+	}
+	return messages, rows.Err()
+}
+
+// Delete removes the message of the issuer with the given direction and id
+func (a *agentMessage) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, direction domain.AgentMessageDirection, messageID string) error {
+	sql := `DELETE FROM agent_messages WHERE issuer_id = $1 AND direction = $2 AND message_id = $3`
+	_, err := conn.Exec(ctx, sql, issuerDID.String(), direction, messageID)
+	return err
+}
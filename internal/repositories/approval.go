@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+var (
+	// ErrApprovalRequestNotFound approval request does not exist
+	ErrApprovalRequestNotFound = errors.New("approval request not found")
+	// ErrApprovalRequestNotPending approval request has already been reviewed
+	ErrApprovalRequestNotPending = errors.New("approval request has already been reviewed")
+)
+
+const approvalRequestFields = `id, issuer_id, operation, payload, status, requested_by, reviewed_by, reason, result_id, created_at, reviewed_at`
+
+type approval struct{}
+
+// NewApproval returns a new approval requests repository
+func NewApproval() ports.ApprovalRepository {
+	return &approval{}
+}
+
+func (a *approval) Save(ctx context.Context, conn db.Querier, request *domain.ApprovalRequest) error {
+	sql := `INSERT INTO approval_requests (id, issuer_id, operation, payload, status, requested_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := conn.Exec(ctx, sql, request.ID, request.IssuerDID.String(), request.Operation, request.Payload, request.Status, request.RequestedBy, request.CreatedAt)
+	return err
+}
+
+func (a *approval) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ApprovalRequest, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM approval_requests WHERE id = $1 AND issuer_id = $2`, approvalRequestFields)
+	request, err := scanApprovalRequest(conn.QueryRow(ctx, sql, id, issuerDID.String()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrApprovalRequestNotFound
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+func (a *approval) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, status *domain.ApprovalStatus) ([]*domain.ApprovalRequest, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM approval_requests WHERE issuer_id = $1`, approvalRequestFields)
+	args := []interface{}{issuerDID.String()}
+	if status != nil {
+		args = append(args, *status)
+		sql = fmt.Sprintf("%s AND status = $%d", sql, len(args))
+	}
+	sql += " ORDER BY created_at DESC"
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := make([]*domain.ApprovalRequest, 0)
+	for rows.Next() {
+		request, err := scanApprovalRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, rows.Err()
+}
+
+// Review stores the decision of the reviewer. It only succeeds if the request is still pending, so two
+// approvers cannot execute the same request.
+func (a *approval) Review(ctx context.Context, conn db.Querier, request *domain.ApprovalRequest) error {
+	sql := `UPDATE approval_requests SET status = $1, reviewed_by = $2, reason = $3, reviewed_at = $4
+			WHERE id = $5 AND issuer_id = $6 AND status = $7`
+	cmd, err := conn.Exec(ctx, sql, request.Status, request.ReviewedBy, request.Reason, request.ReviewedAt, request.ID, request.IssuerDID.String(), domain.ApprovalStatusPending)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrApprovalRequestNotPending
+	}
+	return nil
+}
+
+func (a *approval) UpdateResult(ctx context.Context, conn db.Querier, request *domain.ApprovalRequest) error {
+	sql := `UPDATE approval_requests SET status = $1, reason = $2, result_id = $3 WHERE id = $4 AND issuer_id = $5`
+	_, err := conn.Exec(ctx, sql, request.Status, request.Reason, request.ResultID, request.ID, request.IssuerDID.String())
+	return err
+}
+
+func scanApprovalRequest(row pgx.Row) (*domain.ApprovalRequest, error) {
+	var (
+		request   domain.ApprovalRequest
+		issuerDID string
+	)
+	if err := row.Scan(&request.ID, &issuerDID, &request.Operation, &request.Payload, &request.Status, &request.RequestedBy,
+		&request.ReviewedBy, &request.Reason, &request.ResultID, &request.CreatedAt, &request.ReviewedAt); err != nil {
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	request.IssuerDID = *did
+	return &request, nil
+}
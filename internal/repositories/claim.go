@@ -22,7 +22,10 @@ import (
 	"github.com/wakeup-labs/issuer-node/internal/db"
 )
 
-const duplicateViolationErrorCode = "23505"
+const (
+	duplicateViolationErrorCode  = "23505"
+	foreignKeyViolationErrorCode = "23503"
+)
 
 // ErrClaimDuplication claim duplication error
 var (
@@ -86,7 +89,11 @@ func (c *claim) GetRevoked(ctx context.Context, conn db.Querier, currentState st
 		core_claim,
 		revoked,
 		mtp,
-		claims.created_at
+		claims.created_at,
+		delivery_status,
+		delivery_updated_at,
+		issuance_mode,
+		onchain_tx_id
 	FROM claims
 	INNER JOIN revocation ON claims.rev_nonce = revocation.nonce AND claims.issuer = revocation.identifier
 	WHERE claims.identity_state = $1`
@@ -120,6 +127,9 @@ func (c *claim) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 	if claim.CredentialStatus.Status == pgtype.Undefined {
 		claim.CredentialStatus.Status = pgtype.Null
 	}
+	if claim.IssuanceMode == "" {
+		claim.IssuanceMode = domain.CredentialIssuanceOffchain
+	}
 
 	if id == uuid.Nil {
 		s := `INSERT INTO claims (identifier,
@@ -142,8 +152,10 @@ func (c *claim) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
                     index_hash,
 					mtp, 
 					link_id,
-                    created_at)
-		VALUES ($1,  $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+                    created_at,
+                    issuance_mode,
+                    onchain_tx_id)
+		VALUES ($1,  $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
 		RETURNING id`
 
 		err = conn.QueryRow(ctx, s,
@@ -167,7 +179,9 @@ func (c *claim) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.HIndex,
 			claim.MtProof,
 			claim.LinkID,
-			claim.CreatedAt).Scan(&id)
+			claim.CreatedAt,
+			claim.IssuanceMode,
+			claim.OnchainTxID).Scan(&id)
 	} else {
 		s := `INSERT INTO claims (
 					id,
@@ -191,10 +205,12 @@ func (c *claim) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
                     index_hash,
 					mtp,
 					link_id,
-                    created_at
+                    created_at,
+                    issuance_mode,
+                    onchain_tx_id
 		)
 		VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24
 		)
 		ON CONFLICT ON CONSTRAINT claims_pkey 
 		DO UPDATE SET 
@@ -226,7 +242,9 @@ func (c *claim) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.HIndex,
 			claim.MtProof,
 			claim.LinkID,
-			claim.CreatedAt).Scan(&id)
+			claim.CreatedAt,
+			claim.IssuanceMode,
+			claim.OnchainTxID).Scan(&id)
 	}
 
 	if err == nil {
@@ -272,6 +290,14 @@ func (c *claim) Delete(ctx context.Context, conn db.Querier, id uuid.UUID) error
 	return nil
 }
 
+// CountIssuedSince returns the number of credentials, excluding auth credentials, issued by the identity since the given time
+func (c *claim) CountIssuedSince(ctx context.Context, conn db.Querier, identifier w3c.DID, since time.Time) (int, error) {
+	sql := `SELECT count(*) FROM claims WHERE identifier = $1 AND schema_type <> $2 AND created_at >= $3`
+	var count int
+	err := conn.QueryRow(ctx, sql, identifier.String(), domain.AuthBJJCredentialSchemaType, since).Scan(&count)
+	return count, err
+}
+
 func (c *claim) GetByRevocationNonce(ctx context.Context, conn db.Querier, identifier *w3c.DID, revocationNonce domain.RevNonceUint64) ([]*domain.Claim, error) {
 	rows, err := conn.Query(
 		ctx,
@@ -292,7 +318,8 @@ func (c *claim) GetByRevocationNonce(ctx context.Context, conn db.Querier, ident
 				   identity_state,
 				   credential_status,
 				   core_claim,
-				   mtp
+				   mtp,
+				   issuance_mode
 			FROM claims
 			LEFT JOIN identity_states ON claims.identity_state = identity_states.state
 			WHERE claims.identifier = $1
@@ -332,7 +359,8 @@ func (c *claim) GetByRevocationNonce(ctx context.Context, conn db.Querier, ident
 			&claim.IdentityState,
 			&claim.CredentialStatus,
 			&claim.CoreClaim,
-			&claim.MtProof)
+			&claim.MtProof,
+			&claim.IssuanceMode)
 		if err != nil {
 			return nil, err
 		}
@@ -434,7 +462,11 @@ func (c *claim) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifie
        				core_claim,
 					mtp,
 					revoked,
-					link_id
+					link_id,
+					delivery_status,
+					delivery_updated_at,
+					issuance_mode,
+					onchain_tx_id
         FROM claims
         WHERE claims.identifier = $1 AND claims.id = $2`, identifier.String(), claimID).Scan(
 		&claim.ID,
@@ -456,7 +488,11 @@ func (c *claim) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifie
 		&claim.CoreClaim,
 		&claim.MtProof,
 		&claim.Revoked,
-		&claim.LinkID)
+		&claim.LinkID,
+		&claim.DeliveryStatus,
+		&claim.DeliveryUpdatedAt,
+		&claim.IssuanceMode,
+		&claim.OnchainTxID)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil, ErrClaimDoesNotExist
@@ -516,7 +552,11 @@ func (c *claim) GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn d
 				   core_claim,
 				   revoked,
 				   mtp,
-				   claims.created_at
+				   claims.created_at,
+				   delivery_status,
+				   delivery_updated_at,
+				   issuance_mode,
+				   onchain_tx_id
 			FROM claims
 			JOIN connections ON connections.issuer_id = claims.issuer AND connections.user_id = claims.other_identifier
 			LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
@@ -559,7 +599,7 @@ func (c *claim) GetAllByState(ctx context.Context, conn db.Querier, did *w3c.DID
 			credential_status,
 			core_claim 
 		FROM claims
-		WHERE issuer = $1 AND identity_state IS NULL AND identifier = issuer AND mtp = true
+		WHERE issuer = $1 AND identity_state IS NULL AND identifier = issuer AND mtp = true AND issuance_mode = 'offchain'
 		`, did.String())
 	} else {
 		rows, err = conn.Query(ctx, `
@@ -585,6 +625,7 @@ func (c *claim) GetAllByState(ctx context.Context, conn db.Querier, did *w3c.DID
 		FROM claims
 		  LEFT OUTER JOIN identity_states ON claims.identity_state = identity_states.state
 		WHERE issuer = $1 AND ((identity_state IS NULL AND (mtp = true OR revoked = true) OR (identity_state = $2 AND mtp = true)))
+		AND issuance_mode = 'offchain'
 		AND claims.identifier = issuer 
 		`, did.String(), state.Hex())
 	}
@@ -649,7 +690,7 @@ func (c *claim) GetAllByStateWithMTProof(ctx context.Context, conn db.Querier, d
 			credential_status,
 			core_claim 
 		FROM claims
-		WHERE issuer = $1 AND identity_state IS NULL AND identifier = issuer AND mtp = true
+		WHERE issuer = $1 AND identity_state IS NULL AND identifier = issuer AND mtp = true AND issuance_mode = 'offchain'
 		`, did.String())
 	} else {
 		rows, err = conn.Query(ctx, `
@@ -722,6 +763,49 @@ func (c *claim) UpdateState(ctx context.Context, conn db.Querier, claim *domain.
 	return res.RowsAffected(), nil
 }
 
+// UpdateOnchainTxID stores the transaction that published the claim of a credential issued on chain
+func (c *claim) UpdateOnchainTxID(ctx context.Context, conn db.Querier, identifier w3c.DID, claimID uuid.UUID, txID string) (int64, error) {
+	res, err := conn.Exec(ctx, `UPDATE claims SET onchain_tx_id = $3 WHERE identifier = $1 AND id = $2`, identifier.String(), claimID, txID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// UpdateDeliveryStatus moves the delivery status of the claim forward and keeps the thread it was delivered on, if any.
+// Claims that already reached the status, or a later one, are not updated.
+func (c *claim) UpdateDeliveryStatus(ctx context.Context, conn db.Querier, identifier w3c.DID, claimID uuid.UUID, status domain.CredentialDeliveryStatus, threadID *string) (int64, error) {
+	query := `UPDATE claims
+			SET delivery_status = $3, delivery_thread_id = COALESCE($4, delivery_thread_id), delivery_updated_at = now()
+			WHERE identifier = $1 AND id = $2 AND (delivery_status IS NULL OR delivery_status = ANY($5))`
+	res, err := conn.Exec(ctx, query, identifier.String(), claimID, status, threadID, previousDeliveryStatuses(status))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// UpdateDeliveryStatusByThread moves forward the delivery status of the claims of the holder delivered on the thread
+func (c *claim) UpdateDeliveryStatusByThread(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID, threadID string, status domain.CredentialDeliveryStatus) (int64, error) {
+	query := `UPDATE claims
+			SET delivery_status = $4, delivery_updated_at = now()
+			WHERE identifier = $1 AND other_identifier = $2 AND delivery_thread_id = $3 AND delivery_status = ANY($5)`
+	res, err := conn.Exec(ctx, query, identifier.String(), userDID.String(), threadID, status, previousDeliveryStatuses(status))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+func previousDeliveryStatuses(status domain.CredentialDeliveryStatus) []string {
+	previous := status.Previous()
+	statuses := make([]string, len(previous))
+	for i, s := range previous {
+		statuses[i] = string(s)
+	}
+	return statuses
+}
+
 func processClaims(rows pgx.Rows) ([]*domain.Claim, error) {
 	claims := make([]*domain.Claim, 0)
 
@@ -748,6 +832,10 @@ func processClaims(rows pgx.Rows) ([]*domain.Claim, error) {
 			&claim.Revoked,
 			&claim.MtProof,
 			&claim.CreatedAt,
+			&claim.DeliveryStatus,
+			&claim.DeliveryUpdatedAt,
+			&claim.IssuanceMode,
+			&claim.OnchainTxID,
 		)
 		if err != nil {
 			return nil, err
@@ -781,6 +869,10 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		"revoked",
 		"mtp",
 		"claims.created_at",
+		"delivery_status",
+		"delivery_updated_at",
+		"issuance_mode",
+		"onchain_tx_id",
 	}
 	query = `SELECT ##QUERYFIELDS## FROM claims
 			LEFT JOIN identity_states ON claims.identity_state = identity_states.state 
@@ -817,6 +909,10 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		filters = append(filters, filter.QueryField, filter.QueryFieldValue)
 		query = fmt.Sprintf("%s and data -> 'credentialSubject'  ->>$%d = $%d ", query, len(filters)-1, len(filters))
 	}
+	if filter.DeliveryStatus != nil {
+		filters = append(filters, *filter.DeliveryStatus)
+		query = fmt.Sprintf("%s AND claims.delivery_status = $%d", query, len(filters))
+	}
 	if filter.ExpiredOn != nil {
 		t := *filter.ExpiredOn
 		filters = append(filters, t.Unix())
@@ -895,7 +991,11 @@ func (c *claim) GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier,
        	core_claim,
        	revoked,
 		mtp,
-		claims.created_at
+		claims.created_at,
+		delivery_status,
+		delivery_updated_at,
+		issuance_mode,
+		onchain_tx_id
 	FROM claims
 	LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
 	LEFT JOIN revocation  ON claims.rev_nonce = revocation.nonce AND claims.issuer = revocation.identifier
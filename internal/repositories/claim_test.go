@@ -679,7 +679,7 @@ func TestGetClaimsIssuedForUserID(t *testing.T) {
 	tomorrow := time.Now().Add(24 * time.Hour)
 	nextWeek := time.Now().Add(7 * 24 * time.Hour)
 
-	link := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil)
+	link := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil, nil)
 	link.MaxIssuance = common.ToPointer(100)
 
 	linkID, err := linkStore.Save(ctx, storage.Pgx, link)
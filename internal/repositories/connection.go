@@ -26,6 +26,8 @@ type dbConnection struct {
 	UserDID    string
 	IssuerDoc  pgtype.JSONB
 	UserDoc    pgtype.JSONB
+	Metadata   pgtype.JSONB
+	Tags       []string
 	CreatedAt  time.Time
 	ModifiedAt time.Time
 }
@@ -42,18 +44,34 @@ func NewConnection() ports.ConnectionRepository {
 	return &connection{}
 }
 
-// Save stores in the database the given connection and updates the modified at in case already exists
+// Save stores in the database the given connection and updates the modified at in case already exists.
+// The metadata and tags of an existing connection are merged with the given ones.
 func (c *connection) Save(ctx context.Context, conn db.Querier, connection *domain.Connection) (uuid.UUID, error) {
 	var id uuid.UUID
-	sql := `INSERT INTO connections (id,issuer_id, user_id, issuer_doc, user_doc,created_at,modified_at)
-			VALUES($1, $2, $3, $4,$5,$6,$7) ON CONFLICT ON CONSTRAINT connections_issuer_user_key DO
-			UPDATE SET issuer_id=$2, user_id=$3, issuer_doc=$4, user_doc=$5, modified_at = $7
+	sql := `INSERT INTO connections (id,issuer_id, user_id, issuer_doc, user_doc,created_at,modified_at, metadata, tags)
+			VALUES($1, $2, $3, $4,$5,$6,$7,$8,$9) ON CONFLICT ON CONSTRAINT connections_issuer_user_key DO
+			UPDATE SET issuer_id=$2, user_id=$3, issuer_doc=$4, user_doc=$5, modified_at = $7,
+				metadata = connections.metadata || EXCLUDED.metadata,
+				tags = COALESCE((SELECT array_agg(DISTINCT tag ORDER BY tag) FROM unnest(connections.tags || EXCLUDED.tags) AS tag), '{}')
 			RETURNING id`
-	err := conn.QueryRow(ctx, sql, connection.ID, connection.IssuerDID.String(), connection.UserDID.String(), connection.IssuerDoc, connection.UserDoc, connection.CreatedAt, connection.ModifiedAt).Scan(&id)
+	err := conn.QueryRow(ctx, sql, connection.ID, connection.IssuerDID.String(), connection.UserDID.String(), connection.IssuerDoc, connection.UserDoc, connection.CreatedAt, connection.ModifiedAt, connectionMetadata(connection), connectionTags(connection)).Scan(&id)
 
 	return id, err
 }
 
+// UpdateMetadata replaces the metadata and tags of the connection
+func (c *connection) UpdateMetadata(ctx context.Context, conn db.Querier, connection *domain.Connection) error {
+	sql := `UPDATE connections SET metadata = $3, tags = $4, modified_at = $5 WHERE id = $1 AND issuer_id = $2`
+	cmd, err := conn.Exec(ctx, sql, connection.ID, connection.IssuerDID.String(), connectionMetadata(connection), connectionTags(connection), connection.ModifiedAt)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionDoesNotExist
+	}
+	return nil
+}
+
 // SaveUserAuthentication creates a new entry in the user_authentications table
 func (c *connection) SaveUserAuthentication(ctx context.Context, conn db.Querier, connID uuid.UUID, sessID uuid.UUID, mTime time.Time) error {
 	sql := `INSERT INTO user_authentications (connection_id,session_id,created_at) VALUES($1, $2, $3) ON CONFLICT ON CONSTRAINT user_authentications_session_connection_key DO
@@ -62,6 +80,66 @@ func (c *connection) SaveUserAuthentication(ctx context.Context, conn db.Querier
 	return err
 }
 
+// SaveEvents adds the events to the history of their connections
+func (c *connection) SaveEvents(ctx context.Context, conn db.Querier, events []*domain.ConnectionEvent) error {
+	sql := `INSERT INTO connection_events (id, connection_id, type, session_id, user_doc, push_service, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	for _, event := range events {
+		_, err := conn.Exec(ctx, sql, event.ID, event.ConnectionID, event.Type, event.SessionID, eventJSON(event.UserDoc), eventJSON(event.PushService), event.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetEvents returns the history of the connection, newest first, and the total number of events matching the request
+func (c *connection) GetEvents(ctx context.Context, conn db.Querier, connID uuid.UUID, request *ports.GetConnectionHistoryRequest) ([]*domain.ConnectionEvent, uint, error) {
+	where := ` FROM connection_events WHERE connection_id = $1`
+	sqlArgs := []interface{}{connID}
+	if len(request.Types) > 0 {
+		types := make([]string, len(request.Types))
+		for i, t := range request.Types {
+			types[i] = string(t)
+		}
+		sqlArgs = append(sqlArgs, types)
+		where += fmt.Sprintf(" AND type = ANY($%d)", len(sqlArgs))
+	}
+
+	var total uint
+	if err := conn.QueryRow(ctx, "SELECT COUNT(*)"+where, sqlArgs...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	sql := `SELECT id, connection_id, type, session_id, user_doc, push_service, created_at` + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id OFFSET %d LIMIT %d", request.Pagination.GetOffset(), request.Pagination.GetLimit())
+	rows, err := conn.Query(ctx, sql, sqlArgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	events := make([]*domain.ConnectionEvent, 0)
+	for rows.Next() {
+		var (
+			event       domain.ConnectionEvent
+			userDoc     pgtype.JSONB
+			pushService pgtype.JSONB
+		)
+		if err := rows.Scan(&event.ID, &event.ConnectionID, &event.Type, &event.SessionID, &userDoc, &pushService, &event.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		if userDoc.Status == pgtype.Present {
+			event.UserDoc = userDoc.Bytes
+		}
+		if pushService.Status == pgtype.Present {
+			event.PushService = pushService.Bytes
+		}
+		events = append(events, &event)
+	}
+	return events, total, rows.Err()
+}
+
 func (c *connection) Delete(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID) error {
 	sqlAuthentications := `DELETE FROM user_authentications WHERE connection_id = $1`
 	_, err := conn.Exec(ctx, sqlAuthentications, id.String())
@@ -92,7 +170,7 @@ func (c *connection) DeleteCredentials(ctx context.Context, conn db.Querier, id
 func (c *connection) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id uuid.UUID, issuerID w3c.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
+		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,metadata,tags,created_at,modified_at 
 				FROM connections 
 				WHERE connections.id = $1 AND connections.issuer_id = $2`, id.String(), issuerID.String()).Scan(
 		&connection.ID,
@@ -100,6 +178,8 @@ func (c *connection) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id
 		&connection.UserDID,
 		&connection.IssuerDoc,
 		&connection.UserDoc,
+		&connection.Metadata,
+		&connection.Tags,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
 	)
@@ -116,7 +196,7 @@ func (c *connection) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id
 func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT connections.id, connections.issuer_id,connections.user_id,connections.issuer_doc,connections.user_doc,connections.created_at,connections.modified_at 
+		`SELECT connections.id, connections.issuer_id,connections.user_id,connections.issuer_doc,connections.user_doc,connections.metadata,connections.tags,connections.created_at,connections.modified_at 
 				FROM connections 
 				JOIN user_authentications ON connections.id = user_authentications.connection_id
 				WHERE user_authentications.session_id = $1`, sessionID.String()).Scan(
@@ -125,6 +205,8 @@ func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, se
 		&connection.UserDID,
 		&connection.IssuerDoc,
 		&connection.UserDoc,
+		&connection.Metadata,
+		&connection.Tags,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
 	)
@@ -141,7 +223,7 @@ func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, se
 func (c *connection) GetByUserID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
+		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,metadata,tags,created_at,modified_at 
 				FROM connections 
 				WHERE   connections.issuer_id = $1 AND  connections.user_id = $2`, issuerDID.String(), userDID.String()).Scan(
 		&connection.ID,
@@ -149,6 +231,8 @@ func (c *connection) GetByUserID(ctx context.Context, conn db.Querier, issuerDID
 		&connection.UserDID,
 		&connection.IssuerDoc,
 		&connection.UserDoc,
+		&connection.Metadata,
+		&connection.Tags,
 		&connection.CreatedAt,
 		&connection.ModifiedAt,
 	)
@@ -192,6 +276,8 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, filter *ports.
 		"connections.user_id",
 		"connections.issuer_doc",
 		"connections.user_doc",
+		"connections.metadata",
+		"connections.tags",
 		"connections.created_at",
 		"connections.modified_at",
 	}
@@ -204,11 +290,27 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID w3c.DID, filter *ports.
 	if filter.Query != "" {
 		terms := tokenizeQuery(filter.Query)
 		if len(terms) > 0 {
-			ftsConds := buildPartialQueryDidLikes("connections.user_id", terms, "OR")
-			sqlQuery += fmt.Sprintf(" AND (%s) ", ftsConds)
+			ftsConds := []string{buildPartialQueryDidLikes("connections.user_id", terms, "OR")}
+			for _, term := range terms {
+				sqlArgs = append(sqlArgs, term)
+				ftsConds = append(ftsConds,
+					fmt.Sprintf("array_to_string(connections.tags, ' ') ILIKE '%%' || $%d || '%%'", len(sqlArgs)),
+					fmt.Sprintf("EXISTS (SELECT 1 FROM jsonb_each_text(connections.metadata) AS m WHERE m.value ILIKE '%%' || $%d || '%%')", len(sqlArgs)))
+			}
+			sqlQuery += fmt.Sprintf(" AND (%s) ", strings.Join(ftsConds, " OR "))
 		}
 	}
 
+	if len(filter.Tags) > 0 {
+		sqlArgs = append(sqlArgs, filter.Tags)
+		sqlQuery += fmt.Sprintf(" AND connections.tags @> $%d", len(sqlArgs))
+	}
+
+	if len(filter.Metadata) > 0 {
+		sqlArgs = append(sqlArgs, filter.Metadata)
+		sqlQuery += fmt.Sprintf(" AND connections.metadata @> $%d", len(sqlArgs))
+	}
+
 	countQuery := strings.Replace(sqlQuery, "##QUERYFIELDS##", "COUNT(*)", 1)
 	sqlQuery = strings.Replace(sqlQuery, "##QUERYFIELDS##", strings.Join(fields, ","), 1)
 
@@ -230,6 +332,8 @@ func toConnectionsWithCredentials(rows pgx.Rows) ([]domain.Connection, error) {
 			&dbConn.UserDID,
 			&dbConn.IssuerDoc,
 			&dbConn.UserDoc,
+			&dbConn.Metadata,
+			&dbConn.Tags,
 			&dbConn.dbConnection.CreatedAt,
 			&dbConn.ModifiedAt)
 		if err != nil {
@@ -271,5 +375,39 @@ func toConnectionDomain(c *dbConnection) (*domain.Connection, error) {
 		return nil, fmt.Errorf("parsing user IssuerDoc from connection: %w", err)
 	}
 
+	conn.Metadata = make(map[string]string)
+	if c.Metadata.Status == pgtype.Present {
+		if err := c.Metadata.AssignTo(&conn.Metadata); err != nil {
+			return nil, fmt.Errorf("parsing metadata from connection: %w", err)
+		}
+	}
+
+	conn.Tags = c.Tags
+	if conn.Tags == nil {
+		conn.Tags = []string{}
+	}
+
 	return conn, nil
 }
+
+func connectionMetadata(connection *domain.Connection) map[string]string {
+	if connection.Metadata == nil {
+		return map[string]string{}
+	}
+	return connection.Metadata
+}
+
+func connectionTags(connection *domain.Connection) []string {
+	if connection.Tags == nil {
+		return []string{}
+	}
+	return connection.Tags
+}
+
+// eventJSON stores empty documents of the connection events as null
+func eventJSON(raw []byte) pgtype.JSONB {
+	if len(raw) == 0 {
+		return pgtype.JSONB{Status: pgtype.Null}
+	}
+	return pgtype.JSONB{Bytes: raw, Status: pgtype.Present}
+}
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// ErrConnectionMessageNotFound connection message does not exist
+var ErrConnectionMessageNotFound = errors.New("connection message not found")
+
+const connectionMessageFields = `id, connection_id, issuer_id, thread_id, type, status, reason, created_at`
+
+type connectionMessage struct{}
+
+// NewConnectionMessage returns a new repository of the messages sent to the connections
+func NewConnectionMessage() ports.ConnectionMessageRepository {
+	return &connectionMessage{}
+}
+
+// Save stores the message
+func (c *connectionMessage) Save(ctx context.Context, conn db.Querier, message *domain.ConnectionMessage) error {
+	sql := `INSERT INTO connection_messages (id, connection_id, issuer_id, thread_id, type, status, reason, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := conn.Exec(ctx, sql, message.ID, message.ConnectionID, message.IssuerDID.String(), message.ThreadID,
+		message.Type, message.Status, message.Reason, message.CreatedAt)
+	return err
+}
+
+// GetAll returns the messages of the connection, newest first
+func (c *connectionMessage) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID, connID uuid.UUID) ([]*domain.ConnectionMessage, error) {
+	sql := `SELECT ` + connectionMessageFields + `
+			FROM connection_messages
+			WHERE issuer_id = $1 AND connection_id = $2
+			ORDER BY created_at DESC`
+	rows, err := conn.Query(ctx, sql, issuerDID.String(), connID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.ConnectionMessage, 0)
+	for rows.Next() {
+		message, err := scanConnectionMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, rows.Err()
+}
+
+// GetByID returns the message of the issuer
+func (c *connectionMessage) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.ConnectionMessage, error) {
+	sql := `SELECT ` + connectionMessageFields + ` FROM connection_messages WHERE issuer_id = $1 AND id = $2`
+	message, err := scanConnectionMessage(conn.QueryRow(ctx, sql, issuerDID.String(), id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrConnectionMessageNotFound
+	}
+	return message, err
+}
+
+func scanConnectionMessage(row pgx.Row) (*domain.ConnectionMessage, error) {
+	var (
+		message   domain.ConnectionMessage
+		issuerDID string
+	)
+	if err := row.Scan(&message.ID, &message.ConnectionID, &issuerDID, &message.ThreadID, &message.Type,
+		&message.Status, &message.Reason, &message.CreatedAt); err != nil {
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	message.IssuerDID = *did
+	return &message, nil
+}
@@ -208,6 +208,101 @@ func TestConnectionsGetAllByIssuerID(t *testing.T) {
 	})
 }
 
+func TestConnectionsMetadataAndTags(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := NewConnection()
+	fixture := NewFixture(storage)
+
+	issuerDID, err := w3c.ParseDID("did:opid:optimism:sepolia:2qKc6Wj7z1ZxwtMu9x6ZTtzYkXKHxWrK3N3VFgq7u7")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:opid:optimism:sepolia:2qPv5hSPVBi6SFfT4Mo5QyvkJuNfRhEAt1Zyf3NeZh")
+	require.NoError(t, err)
+	userDID2, err := w3c.ParseDID("did:opid:optimism:sepolia:2qQ1hXTHLbEQGMEhWoVKHzjbK9TYW8yGYUpRYcUk5F")
+	require.NoError(t, err)
+
+	conn := &domain.Connection{
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	}
+	require.NoError(t, conn.SetMetadata(map[string]string{"customerId": "crm-1234", "department": "sales"}))
+	require.NoError(t, conn.SetTags([]string{"vip"}))
+	connID := fixture.CreateConnection(t, conn)
+
+	_ = fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID2,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	t.Run("should keep the metadata and tags when the connection is saved again", func(t *testing.T) {
+		again := &domain.Connection{
+			ID:         uuid.New(),
+			IssuerDID:  *issuerDID,
+			UserDID:    *userDID,
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		}
+		require.NoError(t, again.SetTags([]string{"beta"}))
+		_, err := connectionsRepo.Save(ctx, storage.Pgx, again)
+		require.NoError(t, err)
+
+		connDB, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, *issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"customerId": "crm-1234", "department": "sales"}, connDB.Metadata)
+		assert.Equal(t, []string{"beta", "vip"}, connDB.Tags)
+	})
+
+	t.Run("should return empty metadata and tags for a connection without them", func(t *testing.T) {
+		connDB, err := connectionsRepo.GetByUserID(ctx, storage.Pgx, *issuerDID, *userDID2)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{}, connDB.Metadata)
+		assert.Equal(t, []string{}, connDB.Tags)
+	})
+
+	t.Run("should search and filter by metadata and tags", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			filter   *ports.NewGetAllConnectionsRequest
+			expected int
+		}{
+			{name: "query by metadata value", filter: &ports.NewGetAllConnectionsRequest{Query: "crm-12"}, expected: 1},
+			{name: "query by tag", filter: &ports.NewGetAllConnectionsRequest{Query: "vip"}, expected: 1},
+			{name: "query not matching metadata keys", filter: &ports.NewGetAllConnectionsRequest{Query: "customerId"}, expected: 0},
+			{name: "all the tags", filter: &ports.NewGetAllConnectionsRequest{Tags: []string{"vip", "beta"}}, expected: 1},
+			{name: "missing tag", filter: &ports.NewGetAllConnectionsRequest{Tags: []string{"vip", "other"}}, expected: 0},
+			{name: "metadata entry", filter: &ports.NewGetAllConnectionsRequest{Metadata: map[string]string{"department": "sales"}}, expected: 1},
+			{name: "wrong metadata value", filter: &ports.NewGetAllConnectionsRequest{Metadata: map[string]string{"department": "support"}}, expected: 0},
+			{name: "no filters", filter: &ports.NewGetAllConnectionsRequest{}, expected: 2},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				conns, total, err := connectionsRepo.GetAllWithCredentialsByIssuerID(ctx, storage.Pgx, *issuerDID, tc.filter)
+				require.NoError(t, err)
+				assert.Len(t, conns, tc.expected)
+				assert.Equal(t, uint(tc.expected), total)
+			})
+		}
+	})
+
+	t.Run("should replace the metadata and tags", func(t *testing.T) {
+		connDB, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, *issuerDID)
+		require.NoError(t, err)
+		require.NoError(t, connDB.SetMetadata(map[string]string{"customerId": "crm-5678"}))
+		require.NoError(t, connDB.SetTags(nil))
+		require.NoError(t, connectionsRepo.UpdateMetadata(ctx, storage.Pgx, connDB))
+
+		connDB, err = connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, connID, *issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"customerId": "crm-5678"}, connDB.Metadata)
+		assert.Equal(t, []string{}, connDB.Tags)
+
+		connDB.ID = uuid.New()
+		assert.ErrorIs(t, connectionsRepo.UpdateMetadata(ctx, storage.Pgx, connDB), ErrConnectionDoesNotExist)
+	})
+}
+
 func TestGetAllWithCredentialsByIssuerID(t *testing.T) {
 	ctx := context.Background()
 	connectionsRepo := NewConnection()
@@ -406,3 +501,56 @@ func TestGetByUserID(t *testing.T) {
 		assert.NotNil(t, conn)
 	})
 }
+
+func TestConnectionEvents(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := NewConnection()
+	fixture := NewFixture(storage)
+
+	issuerDID, err := w3c.ParseDID("did:opid:optimism:sepolia:2qKc6Wj7z1ZxwtMu9x6ZTtzYkXKHxWrK3N3VFgq7u7")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:opid:optimism:sepolia:2qSfPqG4zdsGWMYXVNTxVXVEkt4wqmGaNXT5XEQrwX")
+	require.NoError(t, err)
+
+	conn := &domain.Connection{
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		UserDoc:    json.RawMessage(`{"id": "did:opid:optimism:sepolia:2qSfPqG4zdsGWMYXVNTxVXVEkt4wqmGaNXT5XEQrwX"}`),
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	}
+	connID := fixture.CreateConnection(t, conn)
+
+	sessionID := uuid.New()
+	created := domain.NewConnectionEvents(nil, conn, connID, &sessionID)
+	require.NoError(t, connectionsRepo.SaveEvents(ctx, storage.Pgx, created))
+
+	t.Run("should get the history", func(t *testing.T) {
+		events, total, err := connectionsRepo.GetEvents(ctx, storage.Pgx, connID, ports.NewGetConnectionHistoryRequest(nil, nil, nil))
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), total)
+		require.Len(t, events, 2)
+		for _, event := range events {
+			assert.Equal(t, connID, event.ConnectionID)
+			assert.Equal(t, &sessionID, event.SessionID)
+			assert.Nil(t, event.PushService)
+		}
+	})
+
+	t.Run("should filter the history by type", func(t *testing.T) {
+		events, total, err := connectionsRepo.GetEvents(ctx, storage.Pgx, connID, ports.NewGetConnectionHistoryRequest([]domain.ConnectionEventType{domain.ConnectionEventCreated}, nil, nil))
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), total)
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.ConnectionEventCreated, events[0].Type)
+		assert.JSONEq(t, string(conn.UserDoc), string(events[0].UserDoc))
+	})
+
+	t.Run("should delete the history with the connection", func(t *testing.T) {
+		require.NoError(t, connectionsRepo.Delete(ctx, storage.Pgx, connID, *issuerDID))
+		events, total, err := connectionsRepo.GetEvents(ctx, storage.Pgx, connID, ports.NewGetConnectionHistoryRequest(nil, nil, nil))
+		require.NoError(t, err)
+		assert.Equal(t, uint(0), total)
+		assert.Empty(t, events)
+	})
+}
@@ -129,7 +129,7 @@ func (i *identity) GetUnprocessedIssuersIDs(ctx context.Context, conn db.Querier
 (
     SELECT  issuer 
 		FROM claims
-		WHERE identity_state ISNULL AND identifier = issuer
+		WHERE identity_state ISNULL AND identifier = issuer AND issuance_mode = 'offchain'
 			UNION
 		SELECT identifier FROM revocation where status = 0
 ), transacted_issuers AS
@@ -175,7 +175,7 @@ func (i *identity) HasUnprocessedStatesByID(ctx context.Context, conn db.Querier
 				(
 					SELECT  issuer 
 						FROM claims
-						WHERE identity_state ISNULL AND identifier = issuer
+						WHERE identity_state ISNULL AND identifier = issuer AND issuance_mode = 'offchain'
 							UNION
 						SELECT identifier FROM revocation where status = 0
 				), transacted_issuers AS
@@ -199,7 +199,7 @@ func (i *identity) HasUnprocessedAndFailedStatesByID(ctx context.Context, conn d
          (
              SELECT  issuer
              FROM claims
-             WHERE identity_state ISNULL AND identifier = issuer AND (mtp = true OR revoked = true)
+             WHERE identity_state ISNULL AND identifier = issuer AND issuance_mode = 'offchain' AND (mtp = true OR revoked = true)
              UNION
              SELECT identifier FROM revocation where status = 0
          ), transacted_issuers AS
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 
@@ -28,8 +29,24 @@ var (
 
 	// ErrorLinkWithClaims cannot delete link with associated claims
 	ErrorLinkWithClaims = errors.New("cannot delete link with associated claims")
+
+	// ErrLinkMaxIssuanceReached the link has issued its maximum number of credentials
+	ErrLinkMaxIssuanceReached = errors.New("the link has issued its maximum number of credentials")
+
+	// ErrLinkInviteNotFound link invite does not exist, is revoked or has no uses left
+	ErrLinkInviteNotFound = errors.New("link invite not found")
+
+	// ErrLinkAllowlistEntryNotFound link allowlist entry does not exist
+	ErrLinkAllowlistEntryNotFound = errors.New("link allowlist entry not found")
+
+	// ErrLinkAllowlistEntryDuplicated the key or the holder is already on the link allowlist
+	ErrLinkAllowlistEntryDuplicated = errors.New("the key or the holder is already on the link allowlist")
 )
 
+const linkInviteFields = `id, link_id, code, max_uses, uses, revoked_at, created_at`
+
+const linkAllowlistEntryFields = `id, link_id, key_type, key, credential_attributes, holder_id, claim_id, claimed_at, created_at`
+
 type link struct {
 	conn db.Storage
 }
@@ -48,12 +65,12 @@ func (l link) Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uu
 	}
 
 	var id uuid.UUID
-	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method)
-			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (id) DO
-			UPDATE SET issuer_id=$2, max_issuance=$3, valid_until=$4, schema_id=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, credential_attributes=$9, active=$10 
+	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method, derivation, invite_only, allowlist)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (id) DO
+			UPDATE SET issuer_id=$2, max_issuance=$3, valid_until=$4, schema_id=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, credential_attributes=$9, active=$10, refresh_service=$11, display_method=$12, invite_only=$14, allowlist=$15
 			RETURNING id`
 	err := conn.QueryRow(ctx, sql, link.ID, link.IssuerCoreDID().String(), link.MaxIssuance, link.ValidUntil, link.SchemaID, link.CredentialExpiration, link.CredentialSignatureProof,
-		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod).Scan(&id)
+		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod, link.Derivation, link.InviteOnly, link.Allowlist).Scan(&id)
 
 	if err != nil && strings.Contains(err.Error(), `table "links" violates foreign key constraint "links_schemas_id_key"`) {
 		return nil, errorShemaNotFound
@@ -76,8 +93,11 @@ SELECT links.id,
        links.active,
 	   links.refresh_service,
 	   links.display_method,
-       count(claims.id) as issued_claims,
+	   links.derivation,
+       links.issued_claims,
        links.authorization_request_message,
+       links.allowlist,
+       links.invite_only,
        schemas.id as schema_id,
        schemas.issuer_id as schema_issuer_id,
        schemas.url,
@@ -87,9 +107,7 @@ SELECT links.id,
        schemas.created_at
 FROM links
 LEFT JOIN schemas ON schemas.id = links.schema_id AND schemas.issuer_id = links.issuer_id
-LEFT JOIN claims ON claims.link_id = links.id AND claims.identifier = links.issuer_id
 WHERE links.id = $1 AND links.issuer_id = $2
-GROUP BY links.id, schemas.id 
 `
 	link := domain.Link{}
 	s := dbSchema{}
@@ -108,8 +126,11 @@ GROUP BY links.id, schemas.id
 		&link.Active,
 		&link.RefreshService,
 		&link.DisplayMethod,
+		&link.Derivation,
 		&link.IssuedClaims,
 		&link.AuthorizationRequestMessage,
+		&link.Allowlist,
+		&link.InviteOnly,
 		&s.ID,
 		&s.IssuerID,
 		&s.URL,
@@ -137,64 +158,90 @@ GROUP BY links.id, schemas.id
 	return &link, err
 }
 
-func (l link) GetAll(ctx context.Context, issuerDID w3c.DID, status ports.LinkStatus, query *string) ([]*domain.Link, error) {
-	sql := `
-SELECT links.id, 
-       links.issuer_id, 
-       links.created_at, 
-       links.max_issuance, 
-       links.valid_until, 
-       links.schema_id, 
-       links.credential_expiration, 
-       links.credential_signature_proof,
-       links.credential_mtp_proof, 
-       links.credential_attributes, 
-       links.active,
-	   links.refresh_service,
-	   links.display_method,
-	   links.authorization_request_message,
-       count(claims.id) as issued_claims,
-       schemas.id as schema_id,
-       schemas.issuer_id as schema_issuer_id,
-       schemas.url,
-       schemas.type,
-       schemas.hash,
-       schemas.words, 
-       schemas.created_at
-FROM links
-LEFT JOIN schemas ON schemas.id = links.schema_id
-LEFT JOIN claims ON claims.link_id = links.id AND claims.identifier = links.issuer_id
-WHERE links.issuer_id = $1
-`
-	sqlArgs := make([]interface{}, 0)
-	sqlArgs = append(sqlArgs, issuerDID.String(), time.Now())
+// GetAll returns the links of the issuer that match the filter and the number of them.
+// The links are paginated only if the filter has a page, otherwise all of them are returned.
+func (l link) GetAll(ctx context.Context, issuerDID w3c.DID, filter *ports.LinksFilter) ([]*domain.Link, uint, error) {
+	fields := []string{
+		"links.id",
+		"links.issuer_id",
+		"links.created_at",
+		"links.max_issuance",
+		"links.valid_until",
+		"links.schema_id",
+		"links.credential_expiration",
+		"links.credential_signature_proof",
+		"links.credential_mtp_proof",
+		"links.credential_attributes",
+		"links.active",
+		"links.refresh_service",
+		"links.display_method",
+		"links.derivation",
+		"links.authorization_request_message",
+		"links.issued_claims",
+		"links.allowlist",
+		"links.invite_only",
+		"schemas.id as schema_id",
+		"schemas.issuer_id as schema_issuer_id",
+		"schemas.url",
+		"schemas.type",
+		"schemas.hash",
+		"schemas.words",
+		"schemas.created_at",
+	}
+	sql := `SELECT ##QUERYFIELDS## FROM links
+			LEFT JOIN schemas ON schemas.id = links.schema_id
+			WHERE links.issuer_id = $1`
+	sqlArgs := []interface{}{issuerDID.String()}
 
-	switch status {
+	switch filter.Status {
 	case ports.LinkActive:
-		sql += " AND links.active AND coalesce(links.valid_until > $2, true) AND coalesce(links.max_issuance>(SELECT count(claims.id) FROM claims where claims.link_id = links.id), true)"
+		sqlArgs = append(sqlArgs, time.Now())
+		sql += fmt.Sprintf(" AND links.active AND coalesce(links.valid_until > $%d, true) AND coalesce(links.max_issuance > links.issued_claims, true)", len(sqlArgs))
 	case ports.LinkInactive:
 		sql += " AND NOT links.active"
 	case ports.LinkExceeded:
-		sql += " AND " +
-			"(links.valid_until IS NOT NULL AND links.valid_until<= $2) " +
-			"OR " +
-			"(links.max_issuance IS NOT NULL AND links.max_issuance <= (SELECT count(claims.id) FROM claims where claims.link_id = links.id))"
+		sqlArgs = append(sqlArgs, time.Now())
+		sql += fmt.Sprintf(" AND ((links.valid_until IS NOT NULL AND links.valid_until <= $%d) "+
+			"OR (links.max_issuance IS NOT NULL AND links.max_issuance <= links.issued_claims))", len(sqlArgs))
+	}
+	if filter.SchemaID != nil {
+		sqlArgs = append(sqlArgs, *filter.SchemaID)
+		sql += fmt.Sprintf(" AND links.schema_id = $%d", len(sqlArgs))
+	}
+	if filter.CreatedFrom != nil {
+		sqlArgs = append(sqlArgs, *filter.CreatedFrom)
+		sql += fmt.Sprintf(" AND links.created_at >= $%d", len(sqlArgs))
 	}
-	if query != nil && *query != "" {
-		terms := tokenizeQuery(*query)
+	if filter.CreatedTo != nil {
+		sqlArgs = append(sqlArgs, *filter.CreatedTo)
+		sql += fmt.Sprintf(" AND links.created_at < $%d", len(sqlArgs))
+	}
+	if filter.Query != "" {
+		terms := tokenizeQuery(filter.Query)
 		sql += " AND (" + buildPartialQueryLikes("schemas.words", "OR", 1+len(sqlArgs), len(terms)) + ")"
 		for _, term := range terms {
 			sqlArgs = append(sqlArgs, term)
 		}
 	}
-	// Dummy condition to include time in the query although not always used
-	sql += " AND (true OR $1::text IS NULL OR $2::text IS NULl)"
-	sql += " GROUP BY links.id, schemas.id"
-	sql += " ORDER BY links.created_at DESC"
+
+	var count uint
+	if filter.Pagination.Page != nil {
+		countSQL := strings.Replace(sql, "##QUERYFIELDS##", "count(*)", 1)
+		if err := l.conn.Pgx.QueryRow(ctx, countSQL, sqlArgs...).Scan(&count); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	sql = strings.Replace(sql, "##QUERYFIELDS##", strings.Join(fields, ","), 1)
+	_ = filter.OrderBy.Add(ports.LinksCreatedAt, true)
+	sql += " ORDER BY " + filter.OrderBy.String()
+	if filter.Pagination.Page != nil {
+		sql += fmt.Sprintf(" OFFSET %d LIMIT %d", filter.Pagination.GetOffset(), filter.Pagination.GetLimit())
+	}
 
 	rows, err := l.conn.Pgx.Query(ctx, sql, sqlArgs...)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -217,8 +264,11 @@ WHERE links.issuer_id = $1
 			&link.Active,
 			&link.RefreshService,
 			&link.DisplayMethod,
+			&link.Derivation,
 			&link.AuthorizationRequestMessage,
 			&link.IssuedClaims,
+			&link.Allowlist,
+			&link.InviteOnly,
 			&schema.ID,
 			&schema.IssuerID,
 			&schema.URL,
@@ -227,22 +277,28 @@ WHERE links.issuer_id = $1
 			&schema.Words,
 			&schema.CreatedAt,
 		); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		if err := credentialAttributes.AssignTo(&link.CredentialSubject); err != nil {
-			return nil, fmt.Errorf("parsing credential attributes: %w", err)
+			return nil, 0, fmt.Errorf("parsing credential attributes: %w", err)
 		}
 
 		link.Schema, err = toSchemaDomain(&schema)
 		if err != nil {
-			return nil, fmt.Errorf("parsing link schema: %w", err)
+			return nil, 0, fmt.Errorf("parsing link schema: %w", err)
 		}
 
 		links = append(links, link)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
 
-	return links, nil
+	if filter.Pagination.Page == nil {
+		count = uint(len(links))
+	}
+	return links, count, nil
 }
 
 func (l link) Delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) error {
@@ -277,3 +333,325 @@ func (l link) AddAuthorizationRequest(ctx context.Context, linkID uuid.UUID, iss
 	_, err := l.conn.Pgx.Exec(ctx, sql, authorizationRequest, linkID, issuerDID.String())
 	return err
 }
+
+// ReserveIssuance increments the issued claims of the link if it has not issued its maximum number of credentials yet.
+// The conditional update locks the link row, so concurrent issuances cannot exceed the maximum.
+func (l link) ReserveIssuance(ctx context.Context, conn db.Querier, issuerDID w3c.DID, linkID uuid.UUID) error {
+	const sql = `UPDATE links SET issued_claims = issued_claims + 1
+			WHERE id = $1 AND issuer_id = $2 AND (max_issuance IS NULL OR issued_claims < max_issuance)`
+	cmd, err := conn.Exec(ctx, sql, linkID, issuerDID.String())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrLinkMaxIssuanceReached
+	}
+	return nil
+}
+
+func (l link) SaveUsage(ctx context.Context, conn db.Querier, usage *domain.LinkUsage) error {
+	const sql = `INSERT INTO link_usages (id, link_id, holder_id, claim_id, created_at) VALUES ($1, $2, $3, $4, $5)`
+	_, err := conn.Exec(ctx, sql, usage.ID, usage.LinkID, usage.HolderDID, usage.ClaimID, usage.CreatedAt)
+	return err
+}
+
+func (l link) GetUsage(ctx context.Context, linkID uuid.UUID) ([]*domain.LinkUsage, error) {
+	const sql = `SELECT id, link_id, holder_id, claim_id, created_at FROM link_usages WHERE link_id = $1 ORDER BY created_at DESC`
+	rows, err := l.conn.Pgx.Query(ctx, sql, linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usages := make([]*domain.LinkUsage, 0)
+	for rows.Next() {
+		usage := &domain.LinkUsage{}
+		if err := rows.Scan(&usage.ID, &usage.LinkID, &usage.HolderDID, &usage.ClaimID, &usage.CreatedAt); err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	return usages, rows.Err()
+}
+
+func (l link) SaveEvent(ctx context.Context, event *domain.LinkEvent) error {
+	const sql = `INSERT INTO link_events (id, link_id, type, holder_id, created_at) VALUES ($1, $2, $3, $4, $5)`
+	_, err := l.conn.Pgx.Exec(ctx, sql, event.ID, event.LinkID, event.Type, event.HolderDID, event.CreatedAt)
+	return err
+}
+
+// CountEvents counts the events of the links of the issuer between req.From and req.To by type and by bucket of req.Interval.
+// The events are filtered by the link or the schema of the request, if any. The buckets start in UTC.
+func (l link) CountEvents(ctx context.Context, issuerDID w3c.DID, req ports.LinkStatsRequest) ([]*domain.LinkEventCount, error) {
+	sql := `SELECT date_trunc($2, link_events.created_at AT TIME ZONE 'UTC') AS bucket, link_events.type, count(*)
+			FROM link_events
+			JOIN links ON links.id = link_events.link_id
+			WHERE links.issuer_id = $1 AND link_events.created_at >= $3 AND link_events.created_at < $4`
+	args := []interface{}{issuerDID.String(), string(req.Interval), req.From, req.To}
+	if req.LinkID != nil {
+		args = append(args, *req.LinkID)
+		sql += fmt.Sprintf(" AND links.id = $%d", len(args))
+	}
+	if req.SchemaID != nil {
+		args = append(args, *req.SchemaID)
+		sql += fmt.Sprintf(" AND links.schema_id = $%d", len(args))
+	}
+	sql += " GROUP BY bucket, link_events.type ORDER BY bucket"
+
+	rows, err := l.conn.Pgx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]*domain.LinkEventCount, 0)
+	for rows.Next() {
+		count := &domain.LinkEventCount{}
+		if err := rows.Scan(&count.Time, &count.Type, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+func (l link) SaveInvites(ctx context.Context, conn db.Querier, invites []*domain.LinkInvite) error {
+	const sql = `INSERT INTO link_invites (id, link_id, code, max_uses, uses, created_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	for _, invite := range invites {
+		if _, err := conn.Exec(ctx, sql, invite.ID, invite.LinkID, invite.Code, invite.MaxUses, invite.Uses, invite.CreatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l link) GetInvites(ctx context.Context, linkID uuid.UUID) ([]*domain.LinkInvite, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM link_invites WHERE link_id = $1 ORDER BY created_at, code`, linkInviteFields)
+	rows, err := l.conn.Pgx.Query(ctx, sql, linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invites := make([]*domain.LinkInvite, 0)
+	for rows.Next() {
+		invite, err := scanLinkInvite(rows)
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, invite)
+	}
+	return invites, rows.Err()
+}
+
+func (l link) GetInviteByCode(ctx context.Context, linkID uuid.UUID, code string) (*domain.LinkInvite, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM link_invites WHERE link_id = $1 AND code = $2`, linkInviteFields)
+	invite, err := scanLinkInvite(l.conn.Pgx.QueryRow(ctx, sql, linkID, code))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrLinkInviteNotFound
+	}
+	return invite, err
+}
+
+// UseInvite takes a use of the invite for the holder if it is not revoked and has uses left.
+// It returns false if the holder already has a use of the invite, so scanning it again does not take another one.
+// The uses of the holders that did not get a credential of the link in domain.LinkInviteReservation are given back first.
+// It must run in a transaction, the invite row is locked until it ends so concurrent callbacks cannot exceed its uses.
+func (l link) UseInvite(ctx context.Context, conn db.Querier, linkID uuid.UUID, code string, holderDID w3c.DID) (bool, error) {
+	var inviteID uuid.UUID
+	var revokedAt *time.Time
+	err := conn.QueryRow(ctx, `SELECT id, revoked_at FROM link_invites WHERE link_id = $1 AND code = $2 FOR UPDATE`, linkID, code).
+		Scan(&inviteID, &revokedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, ErrLinkInviteNotFound
+		}
+		return false, err
+	}
+
+	var used bool
+	err = conn.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM link_invite_uses WHERE invite_id = $1 AND holder_id = $2)`, inviteID, holderDID.String()).
+		Scan(&used)
+	if err != nil {
+		return false, err
+	}
+	if used {
+		return false, nil
+	}
+	if revokedAt != nil {
+		return false, ErrLinkInviteNotFound
+	}
+
+	const releaseExpired = `
+WITH released AS (
+    DELETE FROM link_invite_uses
+    WHERE invite_id = $1 AND created_at < $2
+      AND NOT EXISTS (SELECT 1 FROM claims WHERE claims.link_id = $3 AND claims.other_identifier = link_invite_uses.holder_id)
+    RETURNING 1
+)
+UPDATE link_invites SET uses = uses - (SELECT count(*) FROM released) WHERE id = $1`
+	if _, err := conn.Exec(ctx, releaseExpired, inviteID, time.Now().Add(-domain.LinkInviteReservation), linkID); err != nil {
+		return false, err
+	}
+
+	cmd, err := conn.Exec(ctx, `UPDATE link_invites SET uses = uses + 1 WHERE id = $1 AND uses < max_uses`, inviteID)
+	if err != nil {
+		return false, err
+	}
+	if cmd.RowsAffected() == 0 {
+		return false, ErrLinkInviteNotFound
+	}
+	if _, err := conn.Exec(ctx, `INSERT INTO link_invite_uses (invite_id, holder_id, created_at) VALUES ($1, $2, $3)`, inviteID, holderDID.String(), time.Now()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReleaseInvite gives back the use of the invite taken by the holder
+func (l link) ReleaseInvite(ctx context.Context, conn db.Querier, linkID uuid.UUID, code string, holderDID w3c.DID) error {
+	const sql = `
+WITH released AS (
+    DELETE FROM link_invite_uses USING link_invites
+    WHERE link_invite_uses.invite_id = link_invites.id AND link_invites.link_id = $1 AND link_invites.code = $2 AND link_invite_uses.holder_id = $3
+    RETURNING link_invites.id
+)
+UPDATE link_invites SET uses = uses - 1 WHERE id IN (SELECT id FROM released) AND uses > 0`
+	_, err := conn.Exec(ctx, sql, linkID, code, holderDID.String())
+	return err
+}
+
+func (l link) RevokeInvite(ctx context.Context, linkID uuid.UUID, id uuid.UUID) error {
+	const sql = `UPDATE link_invites SET revoked_at = $3 WHERE link_id = $1 AND id = $2 AND revoked_at IS NULL`
+	cmd, err := l.conn.Pgx.Exec(ctx, sql, linkID, id, time.Now())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrLinkInviteNotFound
+	}
+	return nil
+}
+
+func (l link) SaveAllowlistEntries(ctx context.Context, conn db.Querier, entries []*domain.LinkAllowlistEntry) error {
+	const sql = `INSERT INTO link_allowlist_entries (id, link_id, key_type, key, credential_attributes, holder_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	for _, entry := range entries {
+		pgAttrs := pgtype.JSONB{}
+		if err := pgAttrs.Set(entry.CredentialSubject); err != nil {
+			return fmt.Errorf("cannot set credential subject values: %w", err)
+		}
+		_, err := conn.Exec(ctx, sql, entry.ID, entry.LinkID, entry.KeyType, entry.Key, pgAttrs, entry.HolderDID, entry.CreatedAt)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+				return fmt.Errorf("%w: %s", ErrLinkAllowlistEntryDuplicated, entry.Key)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (l link) GetAllowlist(ctx context.Context, conn db.Querier, linkID uuid.UUID) ([]*domain.LinkAllowlistEntry, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM link_allowlist_entries WHERE link_id = $1 ORDER BY created_at, key`, linkAllowlistEntryFields)
+	rows, err := conn.Query(ctx, sql, linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*domain.LinkAllowlistEntry, 0)
+	for rows.Next() {
+		entry, err := scanLinkAllowlistEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// allowlistKeyCondition matches the email hash or code entries of the key, from the parameter $2 to $5 of the query.
+// The key is normalized as the entries of each key type were saved.
+const allowlistKeyCondition = `((key_type = $2 AND key = $3) OR (key_type = $4 AND key = $5))`
+
+func allowlistKeyArgs(key string) []interface{} {
+	return []interface{}{
+		domain.LinkAllowlistKeyEmailHash, domain.LinkAllowlistKeyEmailHash.NormalizeKey(key),
+		domain.LinkAllowlistKeyCode, domain.LinkAllowlistKeyCode.NormalizeKey(key),
+	}
+}
+
+func (l link) GetAllowlistEntryByKey(ctx context.Context, conn db.Querier, linkID uuid.UUID, key string) (*domain.LinkAllowlistEntry, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM link_allowlist_entries WHERE link_id = $1 AND %s`, linkAllowlistEntryFields, allowlistKeyCondition)
+	entry, err := scanLinkAllowlistEntry(conn.QueryRow(ctx, sql, append([]interface{}{linkID}, allowlistKeyArgs(key)...)...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrLinkAllowlistEntryNotFound
+	}
+	return entry, err
+}
+
+func (l link) GetAllowlistEntryByHolder(ctx context.Context, conn db.Querier, linkID uuid.UUID, holderDID w3c.DID) (*domain.LinkAllowlistEntry, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM link_allowlist_entries WHERE link_id = $1 AND holder_id = $2`, linkAllowlistEntryFields)
+	entry, err := scanLinkAllowlistEntry(conn.QueryRow(ctx, sql, linkID, holderDID.String()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrLinkAllowlistEntryNotFound
+	}
+	return entry, err
+}
+
+// BindAllowlistEntry binds the entry of an email hash or code to the holder that presents it.
+// Entries already bound to other holders are not found.
+func (l link) BindAllowlistEntry(ctx context.Context, conn db.Querier, linkID uuid.UUID, key string, holderDID w3c.DID) error {
+	const sql = `UPDATE link_allowlist_entries SET holder_id = $6
+			WHERE link_id = $1 AND ` + allowlistKeyCondition + ` AND (holder_id IS NULL OR holder_id = $6)`
+	args := append([]interface{}{linkID}, allowlistKeyArgs(key)...)
+	cmd, err := conn.Exec(ctx, sql, append(args, holderDID.String())...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrLinkAllowlistEntryDuplicated
+		}
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrLinkAllowlistEntryNotFound
+	}
+	return nil
+}
+
+func (l link) ClaimAllowlistEntry(ctx context.Context, conn db.Querier, id uuid.UUID, claimID uuid.UUID) error {
+	const sql = `UPDATE link_allowlist_entries SET claim_id = $2, claimed_at = $3 WHERE id = $1 AND claimed_at IS NULL`
+	cmd, err := conn.Exec(ctx, sql, id, claimID, time.Now())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrLinkAllowlistEntryNotFound
+	}
+	return nil
+}
+
+func scanLinkInvite(row pgx.Row) (*domain.LinkInvite, error) {
+	invite := &domain.LinkInvite{}
+	if err := row.Scan(&invite.ID, &invite.LinkID, &invite.Code, &invite.MaxUses, &invite.Uses, &invite.RevokedAt, &invite.CreatedAt); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+func scanLinkAllowlistEntry(row pgx.Row) (*domain.LinkAllowlistEntry, error) {
+	entry := &domain.LinkAllowlistEntry{}
+	var credentialAttributes pgtype.JSONB
+	if err := row.Scan(&entry.ID, &entry.LinkID, &entry.KeyType, &entry.Key, &credentialAttributes, &entry.HolderDID,
+		&entry.ClaimID, &entry.ClaimedAt, &entry.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(credentialAttributes.Bytes))
+	d.UseNumber()
+	if err := d.Decode(&entry.CredentialSubject); err != nil {
+		return nil, fmt.Errorf("parsing credential attributes: %w", err)
+	}
+	return entry, nil
+}
@@ -3,18 +3,23 @@ package repositories
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/wakeup-labs/issuer-node/internal/common"
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/sqltools"
 )
 
 func TestSaveLink(t *testing.T) {
@@ -43,6 +48,7 @@ func TestSaveLink(t *testing.T) {
 			ID:   "https://display.xyz",
 			Type: verifiable.Iden3BasicDisplayMethodV1,
 		},
+		nil,
 	)
 
 	linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
@@ -139,7 +145,7 @@ func TestGetLinkById(t *testing.T) {
 
 	validUntil := time.Date(2050, 8, 15, 14, 30, 45, 100, time.Local)
 	credentialExpiration := time.Date(2050, 8, 15, 14, 30, 45, 100, time.Local)
-	linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &validUntil, schemaID, &credentialExpiration, true, false, domain.CredentialSubject{}, nil, nil)
+	linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &validUntil, schemaID, &credentialExpiration, true, false, domain.CredentialSubject{}, nil, nil, nil)
 	linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
 	assert.NoError(t, err)
 	assert.NotNil(t, linkID)
@@ -155,7 +161,6 @@ func TestGetLinkById(t *testing.T) {
 
 func TestGetAll(t *testing.T) {
 	ctx := context.Background()
-	fixture := NewFixture(storage)
 	didStr := "did:opid:tLZ7NJdCek9j79a1Pmxci3seELHctfGibcrnjjftQ"
 	schemaStore := NewSchema(*storage)
 	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
@@ -171,58 +176,41 @@ func TestGetAll(t *testing.T) {
 	past := time.Now().Add(-100 * 24 * time.Hour)
 	// 10  not expired links and no max issuance
 	for i := 0; i < 10; i++ {
-		linkToSave := domain.NewLink(*did, nil, &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil)
+		linkToSave := domain.NewLink(*did, nil, &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil, nil)
 		linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
 		require.NoError(t, err)
 		assert.NotNil(t, linkID)
 	}
 	// 10  not expired links
 	for i := 0; i < 10; i++ {
-		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil)
+		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil, nil)
 		linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
 		require.NoError(t, err)
 		assert.NotNil(t, linkID)
 	}
 	// 10 expired ones
 	for i := 0; i < 10; i++ {
-		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &past, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil)
+		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &past, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil, nil)
 		linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
 		require.NoError(t, err)
 		assert.NotNil(t, linkID)
 	}
 	// 10 valid but over used
 	for i := 0; i < 10; i++ {
-		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil)
+		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil, nil)
 		linkToSave.MaxIssuance = common.ToPointer(100)
 
 		linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
 		require.NoError(t, err)
 		assert.NotNil(t, linkID)
 
-		for j := 0; j <= 200; j++ {
-			idClaim, _ := uuid.NewUUID()
-			HIndex := uuid.New().String()
-			fixture.CreateClaim(t, &domain.Claim{
-				ID:              idClaim,
-				Identifier:      &didStr,
-				Issuer:          didStr,
-				SchemaHash:      "ca938857241db9451ea329256b9c06e5",
-				SchemaURL:       "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/auth.json-ld",
-				SchemaType:      "AuthBJJCredential",
-				OtherIdentifier: "did:opid:optimism:sepolia:2qP8KN3KRwBi37jB2ENXrWxhTo3pefaU5u5BFPbjYo",
-				Expiration:      0,
-				Version:         0,
-				RevNonce:        0,
-				CoreClaim:       domain.CoreClaim{},
-				Status:          nil,
-				HIndex:          HIndex,
-				LinkID:          linkID,
-			})
+		for j := 0; j < 100; j++ {
+			require.NoError(t, linkStore.ReserveIssuance(ctx, storage.Pgx, *did, *linkID))
 		}
 	}
 	// 10 inactive
 	for i := 0; i < 10; i++ {
-		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil)
+		linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &tomorrow, schemaID, &nextWeek, true, false, domain.CredentialSubject{}, nil, nil, nil)
 		linkToSave.Active = false
 		linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
 		require.NoError(t, err)
@@ -326,9 +314,10 @@ func TestGetAll(t *testing.T) {
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			all, err := linkStore.GetAll(ctx, *did, tc.filter, tc.query)
+			all, total, err := linkStore.GetAll(ctx, *did, ports.NewLinksFilter(&tc.filter, tc.query, nil, nil, nil, nil, nil, nil))
 			require.NoError(t, err)
 			require.Len(t, all, tc.expected.count)
+			assert.Equal(t, uint(tc.expected.count), total)
 			for _, one := range all {
 				if tc.expected.active != nil {
 					assert.Equal(t, one.Status(), *tc.expected.active)
@@ -336,6 +325,47 @@ func TestGetAll(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("paginated and sorted by issued claims", func(t *testing.T) {
+		orderBy := sqltools.OrderByFilters{}
+		require.NoError(t, orderBy.Add(ports.LinksIssuedClaims, true))
+		filter := ports.NewLinksFilter(nil, nil, nil, nil, nil, common.ToPointer(uint(1)), common.ToPointer(uint(15)), orderBy)
+		page, total, err := linkStore.GetAll(ctx, *did, filter)
+		require.NoError(t, err)
+		assert.Equal(t, uint(50), total)
+		require.Len(t, page, 15)
+		for i, one := range page {
+			if i < 10 {
+				assert.Equal(t, 100, one.IssuedClaims)
+			} else {
+				assert.Equal(t, 0, one.IssuedClaims)
+			}
+		}
+
+		filter = ports.NewLinksFilter(nil, nil, nil, nil, nil, common.ToPointer(uint(4)), common.ToPointer(uint(15)), nil)
+		page, total, err = linkStore.GetAll(ctx, *did, filter)
+		require.NoError(t, err)
+		assert.Equal(t, uint(50), total)
+		assert.Len(t, page, 5)
+	})
+
+	t.Run("filtered by schema and creation time", func(t *testing.T) {
+		all, _, err := linkStore.GetAll(ctx, *did, ports.NewLinksFilter(nil, nil, &schemaID, nil, nil, nil, nil, nil))
+		require.NoError(t, err)
+		assert.Len(t, all, 50)
+
+		all, _, err = linkStore.GetAll(ctx, *did, ports.NewLinksFilter(nil, nil, common.ToPointer(uuid.New()), nil, nil, nil, nil, nil))
+		require.NoError(t, err)
+		assert.Empty(t, all)
+
+		all, _, err = linkStore.GetAll(ctx, *did, ports.NewLinksFilter(nil, nil, nil, &tomorrow, nil, nil, nil, nil))
+		require.NoError(t, err)
+		assert.Empty(t, all)
+
+		all, _, err = linkStore.GetAll(ctx, *did, ports.NewLinksFilter(nil, nil, nil, &past, &tomorrow, nil, nil, nil))
+		require.NoError(t, err)
+		assert.Len(t, all, 50)
+	})
 }
 
 func TestDeleteLink(t *testing.T) {
@@ -361,7 +391,7 @@ func TestDeleteLink(t *testing.T) {
 
 	validUntil := time.Date(2050, 8, 15, 14, 30, 45, 100, time.Local)
 	credentialExpiration := time.Date(2050, 8, 15, 14, 30, 45, 100, time.Local)
-	linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &validUntil, schemaID, &credentialExpiration, true, false, domain.CredentialSubject{}, nil, nil)
+	linkToSave := domain.NewLink(*did, common.ToPointer[int](10), &validUntil, schemaID, &credentialExpiration, true, false, domain.CredentialSubject{}, nil, nil, nil)
 
 	linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
 	assert.NoError(t, err)
@@ -401,3 +431,297 @@ func TestDeleteLink(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, ErrLinkDoesNotExist, err)
 }
+
+func TestReserveIssuance(t *testing.T) {
+	ctx := context.Background()
+	didStr := "did:polygonid:polygon:amoy:2qQ8S2VKdQv7xYgzCn7KW2xgWTFRH7RYmhNqj8Ucw4"
+	holderDID := "did:polygonid:polygon:amoy:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasebA8hbYz"
+	schemaStore := NewSchema(*storage)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+	linkStore := NewLink(*storage)
+
+	schemaID := insertSchemaForLink(ctx, didStr, schemaStore, t)
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+	holder, err := w3c.ParseDID(holderDID)
+	require.NoError(t, err)
+
+	tomorrow := time.Now().Add(24 * time.Hour)
+	linkToSave := domain.NewLink(*did, common.ToPointer(5), &tomorrow, schemaID, nil, true, false, domain.CredentialSubject{}, nil, nil, nil)
+	linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	var reserved atomic.Int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := linkStore.ReserveIssuance(ctx, storage.Pgx, *did, *linkID)
+			if err == nil {
+				reserved.Add(1)
+				return
+			}
+			assert.ErrorIs(t, err, ErrLinkMaxIssuanceReached)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(5), reserved.Load())
+
+	link, err := linkStore.GetByID(ctx, *did, *linkID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, link.IssuedClaims)
+	assert.Equal(t, domain.LinkExceeded, link.Status())
+
+	usage := domain.NewLinkUsage(*linkID, *holder, uuid.New())
+	require.NoError(t, linkStore.SaveUsage(ctx, storage.Pgx, usage))
+	usages, err := linkStore.GetUsage(ctx, *linkID)
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	assert.Equal(t, usage.ID, usages[0].ID)
+	assert.Equal(t, holderDID, usages[0].HolderDID)
+	assert.Equal(t, usage.ClaimID, usages[0].ClaimID)
+}
+
+func TestLinkInvites(t *testing.T) {
+	ctx := context.Background()
+	didStr := "did:opid:optimism:sepolia:2qMeNWv9xGFkmWjN5VrsCPTpuZ1v3M4AxGyNHXKrKv"
+	schemaStore := NewSchema(*storage)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+	linkStore := NewLink(*storage)
+
+	schemaID := insertSchemaForLink(ctx, didStr, schemaStore, t)
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+
+	linkToSave := domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{}, nil, nil, nil)
+	linkToSave.InviteOnly = true
+	linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
+	require.NoError(t, err)
+
+	invites, err := domain.NewLinkInvites(*linkID, 2, 3)
+	require.NoError(t, err)
+	require.NoError(t, linkStore.SaveInvites(ctx, storage.Pgx, invites))
+
+	link, err := linkStore.GetByID(ctx, *did, *linkID)
+	require.NoError(t, err)
+	assert.True(t, link.InviteOnly)
+
+	holder := func(i int) w3c.DID {
+		holderDID, err := w3c.ParseDID(fmt.Sprintf("did:example:holder%d", i))
+		require.NoError(t, err)
+		return *holderDID
+	}
+	useInvite := func(code string, holderDID w3c.DID) (bool, error) {
+		var taken bool
+		err := storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+			var err error
+			taken, err = linkStore.UseInvite(ctx, tx, *linkID, code, holderDID)
+			return err
+		})
+		return taken, err
+	}
+
+	code := invites[0].Code
+	var wg sync.WaitGroup
+	var used atomic.Int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			taken, err := useInvite(code, holder(i))
+			if err == nil {
+				assert.True(t, taken)
+				used.Add(1)
+				return
+			}
+			assert.ErrorIs(t, err, ErrLinkInviteNotFound)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(3), used.Load())
+
+	var holders []string
+	rows, err := storage.Pgx.Query(ctx, `SELECT holder_id FROM link_invite_uses WHERE invite_id = $1`, invites[0].ID)
+	require.NoError(t, err)
+	for rows.Next() {
+		var h string
+		require.NoError(t, rows.Scan(&h))
+		holders = append(holders, h)
+	}
+	require.NoError(t, rows.Err())
+	require.Len(t, holders, 3)
+	holderWithUse, err := w3c.ParseDID(holders[0])
+	require.NoError(t, err)
+
+	t.Run("the holders keep their use", func(t *testing.T) {
+		taken, err := useInvite(code, *holderWithUse)
+		require.NoError(t, err)
+		assert.False(t, taken)
+		invite, err := linkStore.GetInviteByCode(ctx, *linkID, code)
+		require.NoError(t, err)
+		assert.Equal(t, 3, invite.Uses)
+	})
+
+	t.Run("the released uses can be taken by other holders", func(t *testing.T) {
+		require.NoError(t, linkStore.ReleaseInvite(ctx, storage.Pgx, *linkID, code, *holderWithUse))
+		require.NoError(t, linkStore.ReleaseInvite(ctx, storage.Pgx, *linkID, code, *holderWithUse))
+		invite, err := linkStore.GetInviteByCode(ctx, *linkID, code)
+		require.NoError(t, err)
+		assert.Equal(t, 2, invite.Uses)
+		assert.True(t, invite.IsUsable())
+
+		taken, err := useInvite(code, holder(100))
+		require.NoError(t, err)
+		assert.True(t, taken)
+	})
+
+	t.Run("the expired reservations are given back", func(t *testing.T) {
+		_, err := useInvite(code, holder(101))
+		assert.ErrorIs(t, err, ErrLinkInviteNotFound)
+
+		_, err = storage.Pgx.Exec(ctx, `UPDATE link_invite_uses SET created_at = $2 WHERE invite_id = $1 AND holder_id = $3`,
+			invites[0].ID, time.Now().Add(-domain.LinkInviteReservation-time.Minute), "did:example:holder100")
+		require.NoError(t, err)
+		taken, err := useInvite(code, holder(101))
+		require.NoError(t, err)
+		assert.True(t, taken)
+		invite, err := linkStore.GetInviteByCode(ctx, *linkID, code)
+		require.NoError(t, err)
+		assert.Equal(t, 3, invite.Uses)
+	})
+
+	t.Run("revoked invites", func(t *testing.T) {
+		require.NoError(t, linkStore.RevokeInvite(ctx, *linkID, invites[0].ID))
+		assert.ErrorIs(t, linkStore.RevokeInvite(ctx, *linkID, invites[0].ID), ErrLinkInviteNotFound)
+		_, err := useInvite(code, holder(102))
+		assert.ErrorIs(t, err, ErrLinkInviteNotFound)
+		taken, err := useInvite(code, holder(101))
+		require.NoError(t, err)
+		assert.False(t, taken)
+		taken, err = useInvite(invites[1].Code, holder(102))
+		require.NoError(t, err)
+		assert.True(t, taken)
+	})
+
+	_, err = linkStore.GetInviteByCode(ctx, *linkID, "WRONGCODE")
+	assert.ErrorIs(t, err, ErrLinkInviteNotFound)
+
+	got, err := linkStore.GetInvites(ctx, *linkID)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+}
+
+func TestLinkAllowlist(t *testing.T) {
+	ctx := context.Background()
+	didStr := "did:opid:optimism:sepolia:2qGjTUuxZKqKS4Q8UmxHUPw55g15QgEVGnj6Wkq8Vk"
+	holderDID := "did:polygonid:polygon:amoy:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasebA8hbYz"
+	schemaStore := NewSchema(*storage)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+	linkStore := NewLink(*storage)
+
+	schemaID := insertSchemaForLink(ctx, didStr, schemaStore, t)
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+	holder, err := w3c.ParseDID(holderDID)
+	require.NoError(t, err)
+
+	linkToSave := domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{}, nil, nil, nil)
+	linkToSave.Allowlist = true
+	linkID, err := linkStore.Save(ctx, storage.Pgx, linkToSave)
+	require.NoError(t, err)
+	link, err := linkStore.GetByID(ctx, *did, *linkID)
+	require.NoError(t, err)
+	assert.True(t, link.Allowlist)
+
+	emailHash, err := domain.NewLinkAllowlistEntry(*linkID, domain.LinkAllowlistKeyEmailHash, "5F4DCC3B5AA765D61D8327DEB882CF99", nil)
+	require.NoError(t, err)
+	code, err := domain.NewLinkAllowlistEntry(*linkID, domain.LinkAllowlistKeyCode, "ABC123", nil)
+	require.NoError(t, err)
+	require.NoError(t, linkStore.SaveAllowlistEntries(ctx, storage.Pgx, []*domain.LinkAllowlistEntry{emailHash, code}))
+
+	entry, err := linkStore.GetAllowlistEntryByKey(ctx, storage.Pgx, *linkID, "5F4DCC3B5AA765D61D8327DEB882CF99")
+	require.NoError(t, err)
+	assert.Equal(t, emailHash.ID, entry.ID)
+	entry, err = linkStore.GetAllowlistEntryByKey(ctx, storage.Pgx, *linkID, " ABC123 ")
+	require.NoError(t, err)
+	assert.Equal(t, code.ID, entry.ID)
+	_, err = linkStore.GetAllowlistEntryByKey(ctx, storage.Pgx, *linkID, "abc123")
+	assert.ErrorIs(t, err, ErrLinkAllowlistEntryNotFound)
+
+	require.NoError(t, linkStore.BindAllowlistEntry(ctx, storage.Pgx, *linkID, " 5F4DCC3B5AA765D61D8327DEB882CF99", *holder))
+	entry, err = linkStore.GetAllowlistEntryByHolder(ctx, storage.Pgx, *linkID, *holder)
+	require.NoError(t, err)
+	assert.Equal(t, emailHash.ID, entry.ID)
+}
+
+func TestLinkEvents(t *testing.T) {
+	ctx := context.Background()
+	didStr := "did:opid:optimism:sepolia:2qD6cqGpLX2dibdFuKfrPxGiybi3wKa8RbR4onw49H"
+	holderDID := "did:polygonid:polygon:amoy:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasebA8hbYz"
+	schemaStore := NewSchema(*storage)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+	linkStore := NewLink(*storage)
+
+	schemaID := insertSchemaForLink(ctx, didStr, schemaStore, t)
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+	holder, err := w3c.ParseDID(holderDID)
+	require.NoError(t, err)
+
+	linkID, err := linkStore.Save(ctx, storage.Pgx, domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{}, nil, nil, nil))
+	require.NoError(t, err)
+	otherLinkID, err := linkStore.Save(ctx, storage.Pgx, domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{}, nil, nil, nil))
+	require.NoError(t, err)
+
+	day := time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC)
+	for _, event := range []struct {
+		linkID    uuid.UUID
+		eventType domain.LinkEventType
+		holder    *w3c.DID
+		at        time.Time
+	}{
+		{*linkID, domain.LinkEventQRDisplayed, nil, day.Add(1 * time.Hour)},
+		{*linkID, domain.LinkEventQRDisplayed, nil, day.Add(2 * time.Hour)},
+		{*linkID, domain.LinkEventCredentialIssued, holder, day.Add(2 * time.Hour)},
+		{*linkID, domain.LinkEventQRDisplayed, nil, day.Add(26 * time.Hour)},
+		{*linkID, domain.LinkEventQRDisplayed, nil, day.Add(-1 * time.Hour)},
+		{*otherLinkID, domain.LinkEventQRDisplayed, nil, day.Add(3 * time.Hour)},
+	} {
+		linkEvent := domain.NewLinkEvent(event.linkID, event.eventType, event.holder)
+		linkEvent.CreatedAt = event.at
+		require.NoError(t, linkStore.SaveEvent(ctx, linkEvent))
+	}
+
+	req := ports.LinkStatsRequest{LinkID: linkID, From: day, To: day.AddDate(0, 0, 2), Interval: domain.LinkStatsDay}
+	counts, err := linkStore.CountEvents(ctx, *did, req)
+	require.NoError(t, err)
+	require.Len(t, counts, 3)
+	assert.Equal(t, domain.LinkEventCount{Time: day, Type: domain.LinkEventCredentialIssued, Count: 1}, findLinkEventCount(counts, day, domain.LinkEventCredentialIssued))
+	assert.Equal(t, domain.LinkEventCount{Time: day, Type: domain.LinkEventQRDisplayed, Count: 2}, findLinkEventCount(counts, day, domain.LinkEventQRDisplayed))
+	assert.Equal(t, domain.LinkEventCount{Time: day.AddDate(0, 0, 1), Type: domain.LinkEventQRDisplayed, Count: 1}, findLinkEventCount(counts, day.AddDate(0, 0, 1), domain.LinkEventQRDisplayed))
+
+	req = ports.LinkStatsRequest{SchemaID: &schemaID, From: day, To: day.AddDate(0, 0, 1), Interval: domain.LinkStatsDay}
+	counts, err = linkStore.CountEvents(ctx, *did, req)
+	require.NoError(t, err)
+	assert.Equal(t, 3, findLinkEventCount(counts, day, domain.LinkEventQRDisplayed).Count)
+
+	otherIssuer, err := w3c.ParseDID("did:opid:optimism:sepolia:2qDDDKmo436EZGCBAvkqZjADYoNRJszkG7UymZeCHQ")
+	require.NoError(t, err)
+	counts, err = linkStore.CountEvents(ctx, *otherIssuer, req)
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+}
+
+func findLinkEventCount(counts []*domain.LinkEventCount, bucket time.Time, eventType domain.LinkEventType) domain.LinkEventCount {
+	for _, count := range counts {
+		if count.Time.Equal(bucket) && count.Type == eventType {
+			return *count
+		}
+	}
+	return domain.LinkEventCount{}
+}
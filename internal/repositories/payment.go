@@ -0,0 +1,217 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+var (
+	// ErrCredentialPriceNotFound credential price does not exist
+	ErrCredentialPriceNotFound = errors.New("credential price not found")
+	// ErrCredentialPriceDuplicated the schema or link already has a price
+	ErrCredentialPriceDuplicated = errors.New("the schema or link already has a price")
+	// ErrPaymentRequestNotFound payment request does not exist
+	ErrPaymentRequestNotFound = errors.New("payment request not found")
+	// ErrPaymentTransactionUsed the transaction already paid another payment request
+	ErrPaymentTransactionUsed = errors.New("the transaction already paid another payment request")
+)
+
+const credentialPriceFields = `credential_prices.id, credential_prices.issuer_id, credential_prices.schema_id, schemas.type,
+	credential_prices.link_id, credential_prices.amount, credential_prices.blockchain, credential_prices.network,
+	credential_prices.recipient, credential_prices.description, credential_prices.created_at`
+
+const paymentRequestFields = `id, issuer_id, user_id, link_id, amount, blockchain, network, chain_id, recipient, description,
+	status, tx_id, credential_attributes, created_at, paid_at`
+
+type payment struct{}
+
+// NewPayment returns a new credential prices and payment requests repository
+func NewPayment() ports.PaymentRepository {
+	return &payment{}
+}
+
+func (p *payment) SavePrice(ctx context.Context, conn db.Querier, price *domain.CredentialPrice) error {
+	sql := `INSERT INTO credential_prices (id, issuer_id, schema_id, link_id, amount, blockchain, network, recipient, description, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := conn.Exec(ctx, sql, price.ID, price.IssuerDID.String(), price.SchemaID, price.LinkID, price.Amount.String(),
+		price.Blockchain, price.Network, price.Recipient, price.Description, price.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrCredentialPriceDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *payment) GetPrices(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]*domain.CredentialPrice, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM credential_prices
+			JOIN schemas ON schemas.id = credential_prices.schema_id
+			WHERE credential_prices.issuer_id = $1
+			ORDER BY credential_prices.created_at DESC`, credentialPriceFields)
+	rows, err := conn.Query(ctx, sql, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prices := make([]*domain.CredentialPrice, 0)
+	for rows.Next() {
+		price, err := scanCredentialPrice(rows)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+	return prices, rows.Err()
+}
+
+// GetLinkPrice returns the price of the link or, if the link has no price, the price of its schema
+func (p *payment) GetLinkPrice(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaID uuid.UUID, linkID uuid.UUID) (*domain.CredentialPrice, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM credential_prices
+			JOIN schemas ON schemas.id = credential_prices.schema_id
+			WHERE credential_prices.issuer_id = $1 AND credential_prices.schema_id = $2
+			AND (credential_prices.link_id = $3 OR credential_prices.link_id IS NULL)
+			ORDER BY credential_prices.link_id NULLS LAST
+			LIMIT 1`, credentialPriceFields)
+	price, err := scanCredentialPrice(conn.QueryRow(ctx, sql, issuerDID.String(), schemaID, linkID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCredentialPriceNotFound
+	}
+	return price, err
+}
+
+func (p *payment) DeletePrice(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
+	sql := `DELETE FROM credential_prices WHERE id = $1 AND issuer_id = $2`
+	cmd, err := conn.Exec(ctx, sql, id, issuerDID.String())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrCredentialPriceNotFound
+	}
+	return nil
+}
+
+// SaveRequest creates the payment request or updates its status, transaction and credential attributes
+func (p *payment) SaveRequest(ctx context.Context, conn db.Querier, request *domain.PaymentRequest) error {
+	credentialAttributes := pgtype.JSONB{Status: pgtype.Null}
+	if request.CredentialSubject != nil {
+		if err := credentialAttributes.Set(request.CredentialSubject); err != nil {
+			return fmt.Errorf("cannot set credential subject values: %w", err)
+		}
+	}
+	sql := `INSERT INTO payment_requests (id, issuer_id, user_id, link_id, amount, blockchain, network, chain_id, recipient,
+				description, status, tx_id, created_at, paid_at, credential_attributes)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			ON CONFLICT (id) DO UPDATE SET status = $11, tx_id = $12, paid_at = $14, credential_attributes = $15`
+	_, err := conn.Exec(ctx, sql, request.ID, request.IssuerDID.String(), request.UserDID.String(), request.LinkID,
+		request.Amount.String(), request.Blockchain, request.Network, request.ChainID, request.Recipient, request.Description,
+		request.Status, request.TxID, request.CreatedAt, request.PaidAt, credentialAttributes)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrPaymentTransactionUsed
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *payment) GetRequest(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.PaymentRequest, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM payment_requests WHERE id = $1 AND issuer_id = $2`, paymentRequestFields)
+	request, err := scanPaymentRequest(conn.QueryRow(ctx, sql, id, issuerDID.String()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPaymentRequestNotFound
+	}
+	return request, err
+}
+
+// GetLastRequest returns the paid request of the user for the link or, if there is none, the last one
+func (p *payment) GetLastRequest(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID) (*domain.PaymentRequest, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM payment_requests
+			WHERE issuer_id = $1 AND user_id = $2 AND link_id = $3
+			ORDER BY status = $4 DESC, created_at DESC
+			LIMIT 1`, paymentRequestFields)
+	request, err := scanPaymentRequest(conn.QueryRow(ctx, sql, issuerDID.String(), userDID.String(), linkID, domain.PaymentRequestStatusPaid))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPaymentRequestNotFound
+	}
+	return request, err
+}
+
+func scanCredentialPrice(row pgx.Row) (*domain.CredentialPrice, error) {
+	var (
+		price     domain.CredentialPrice
+		issuerDID string
+		amount    string
+	)
+	if err := row.Scan(&price.ID, &issuerDID, &price.SchemaID, &price.SchemaType, &price.LinkID, &amount, &price.Blockchain,
+		&price.Network, &price.Recipient, &price.Description, &price.CreatedAt); err != nil {
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	price.IssuerDID = *did
+	if price.Amount, err = parseAmount(amount); err != nil {
+		return nil, err
+	}
+	return &price, nil
+}
+
+func scanPaymentRequest(row pgx.Row) (*domain.PaymentRequest, error) {
+	var (
+		request              domain.PaymentRequest
+		issuerDID            string
+		userDID              string
+		amount               string
+		credentialAttributes pgtype.JSONB
+	)
+	if err := row.Scan(&request.ID, &issuerDID, &userDID, &request.LinkID, &amount, &request.Blockchain, &request.Network,
+		&request.ChainID, &request.Recipient, &request.Description, &request.Status, &request.TxID, &credentialAttributes,
+		&request.CreatedAt, &request.PaidAt); err != nil {
+		return nil, err
+	}
+	if credentialAttributes.Status == pgtype.Present {
+		if err := credentialAttributes.AssignTo(&request.CredentialSubject); err != nil {
+			return nil, fmt.Errorf("cannot assign credential subject values: %w", err)
+		}
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	request.IssuerDID = *did
+	did, err = w3c.ParseDID(userDID)
+	if err != nil {
+		return nil, err
+	}
+	request.UserDID = *did
+	if request.Amount, err = parseAmount(amount); err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func parseAmount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %s", amount)
+	}
+	return value, nil
+}
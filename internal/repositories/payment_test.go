@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+)
+
+func TestPaymentRequests(t *testing.T) {
+	ctx := context.Background()
+	didStr := "did:opid:optimism:sepolia:2qKvkJ9PW4XcGYvvbe3GtsYjL1ZPDnmEF1Mmoc7Lus"
+	holderDID := "did:polygonid:polygon:amoy:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasebA8hbYz"
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", didStr, "BJJ")
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(didStr)
+	require.NoError(t, err)
+	holder, err := w3c.ParseDID(holderDID)
+	require.NoError(t, err)
+
+	schemaID := insertSchemaForLink(ctx, didStr, NewSchema(*storage), t)
+	linkID, err := NewLink(*storage).Save(ctx, storage.Pgx, domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{}, nil, nil, nil))
+	require.NoError(t, err)
+
+	paymentStore := NewPayment()
+	price := domain.NewCredentialPrice(*did, schemaID, linkID, big.NewInt(1000), "polygon", "amoy", "0x8aF6A3F6c9B4bB8B9f1Fe3E0a6c3B4d4A4fD4c21", nil)
+	require.NoError(t, paymentStore.SavePrice(ctx, storage.Pgx, price))
+
+	t.Run("without credential attributes", func(t *testing.T) {
+		request := domain.NewPaymentRequest(price, *holder, *linkID, "80002", nil)
+		require.NoError(t, paymentStore.SaveRequest(ctx, storage.Pgx, request))
+		got, err := paymentStore.GetRequest(ctx, storage.Pgx, *did, request.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.CredentialSubject)
+	})
+
+	t.Run("with the credential attributes derived from the proof", func(t *testing.T) {
+		request := domain.NewPaymentRequest(price, *holder, *linkID, "80002", domain.CredentialSubject{"documentType": float64(2)})
+		require.NoError(t, paymentStore.SaveRequest(ctx, storage.Pgx, request))
+		got, err := paymentStore.GetRequest(ctx, storage.Pgx, *did, request.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.CredentialSubject{"documentType": float64(2)}, got.CredentialSubject)
+
+		request.CredentialSubject = domain.CredentialSubject{"documentType": float64(3)}
+		require.NoError(t, paymentStore.SaveRequest(ctx, storage.Pgx, request))
+		got, err = paymentStore.GetLastRequest(ctx, storage.Pgx, *did, *holder, *linkID)
+		require.NoError(t, err)
+		assert.Equal(t, request.ID, got.ID)
+		assert.Equal(t, domain.CredentialSubject{"documentType": float64(3)}, got.CredentialSubject)
+	})
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
+	"github.com/wakeup-labs/issuer-node/internal/db"
+)
+
+// ErrProposalRuleNotFound proposal rule does not exist
+var ErrProposalRuleNotFound = errors.New("credential proposal rule not found")
+
+const proposalRuleFields = `credential_proposal_rules.id, credential_proposal_rules.issuer_id, credential_proposal_rules.schema_id, schemas.type,
+	credential_proposal_rules.type, credential_proposal_rules.link_id, credential_proposal_rules.url, credential_proposal_rules.description,
+	credential_proposal_rules.created_at`
+
+type proposalRule struct{}
+
+// NewProposalRule returns a new credential proposal rules repository
+func NewProposalRule() ports.ProposalRuleRepository {
+	return &proposalRule{}
+}
+
+func (p *proposalRule) Save(ctx context.Context, conn db.Querier, rule *domain.ProposalRule) error {
+	sql := `INSERT INTO credential_proposal_rules (id, issuer_id, schema_id, type, link_id, url, description, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := conn.Exec(ctx, sql, rule.ID, rule.IssuerDID.String(), rule.SchemaID, rule.Type, rule.LinkID, rule.URL, rule.Description, rule.CreatedAt)
+	return err
+}
+
+func (p *proposalRule) GetAll(ctx context.Context, conn db.Querier, issuerDID w3c.DID) ([]*domain.ProposalRule, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM credential_proposal_rules
+			JOIN schemas ON schemas.id = credential_proposal_rules.schema_id
+			WHERE credential_proposal_rules.issuer_id = $1
+			ORDER BY credential_proposal_rules.created_at DESC`, proposalRuleFields)
+	return p.query(ctx, conn, sql, issuerDID.String())
+}
+
+// GetBySchemaType returns the rules of the schemas of the given type, oldest first
+func (p *proposalRule) GetBySchemaType(ctx context.Context, conn db.Querier, issuerDID w3c.DID, schemaType string) ([]*domain.ProposalRule, error) {
+	sql := fmt.Sprintf(`SELECT %s FROM credential_proposal_rules
+			JOIN schemas ON schemas.id = credential_proposal_rules.schema_id
+			WHERE credential_proposal_rules.issuer_id = $1 AND schemas.type = $2
+			ORDER BY credential_proposal_rules.created_at`, proposalRuleFields)
+	return p.query(ctx, conn, sql, issuerDID.String(), schemaType)
+}
+
+func (p *proposalRule) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
+	sql := `DELETE FROM credential_proposal_rules WHERE id = $1 AND issuer_id = $2`
+	cmd, err := conn.Exec(ctx, sql, id, issuerDID.String())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrProposalRuleNotFound
+	}
+	return nil
+}
+
+func (p *proposalRule) query(ctx context.Context, conn db.Querier, sql string, args ...interface{}) ([]*domain.ProposalRule, error) {
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*domain.ProposalRule, 0)
+	for rows.Next() {
+		rule, err := scanProposalRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func scanProposalRule(row pgx.Row) (*domain.ProposalRule, error) {
+	var (
+		rule      domain.ProposalRule
+		issuerDID string
+	)
+	if err := row.Scan(&rule.ID, &issuerDID, &rule.SchemaID, &rule.SchemaType, &rule.Type, &rule.LinkID, &rule.URL,
+		&rule.Description, &rule.CreatedAt); err != nil {
+		return nil, err
+	}
+	did, err := w3c.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	rule.IssuerDID = *did
+	return &rule, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/jackc/pgx/v4"
 
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
+	"github.com/wakeup-labs/issuer-node/internal/core/ports"
 	"github.com/wakeup-labs/issuer-node/internal/db"
 )
 
@@ -84,42 +85,69 @@ func (r *schema) toFullTextSearchDocument(sType string, attrs domain.SchemaWords
 }
 
 // GetAll returns all the schemas that match any of the words that are included in the query string.
-// For each word, it will search for attributes that start with it or include it following postgres full text search tokenization
-func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error) {
-	var err error
-	var rows pgx.Rows
-	sqlArgs := make([]interface{}, 0)
-	sqlQuery := `SELECT id, issuer_id, url, type, words, hash, created_at,version,title,description
+// For each word, it will search for attributes that start with it or include it following postgres full text search tokenization.
+// The schemas are paginated only if the filter has a page, otherwise all of them are returned with their number.
+func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, filter *ports.SchemasFilter) ([]domain.Schema, uint, error) {
+	sqlArgs := []interface{}{issuerDID.String()}
+	sqlQuery := `SELECT ##QUERYFIELDS##
 	FROM schemas
 	WHERE issuer_id=$1`
-	sqlArgs = append(sqlArgs, issuerDID.String())
-	if query != nil && *query != "" {
-		terms := tokenizeQuery(*query)
+	if filter.CreatedFrom != nil {
+		sqlArgs = append(sqlArgs, *filter.CreatedFrom)
+		sqlQuery += fmt.Sprintf(" AND created_at >= $%d", len(sqlArgs))
+	}
+	if filter.CreatedTo != nil {
+		sqlArgs = append(sqlArgs, *filter.CreatedTo)
+		sqlQuery += fmt.Sprintf(" AND created_at < $%d", len(sqlArgs))
+	}
+	if filter.Query != "" {
+		terms := tokenizeQuery(filter.Query)
 		sqlQuery += " AND (" + buildPartialQueryLikes("schemas.words", "OR", 1+len(sqlArgs), len(terms)) + ")"
 		for _, term := range terms {
 			sqlArgs = append(sqlArgs, term)
 		}
 	}
-	sqlQuery += " ORDER BY created_at DESC"
 
-	rows, err = r.conn.Pgx.Query(ctx, sqlQuery, sqlArgs...)
+	var count uint
+	if filter.Pagination.Page != nil {
+		countQuery := strings.Replace(sqlQuery, "##QUERYFIELDS##", "count(*)", 1)
+		if err := r.conn.Pgx.QueryRow(ctx, countQuery, sqlArgs...).Scan(&count); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	sqlQuery = strings.Replace(sqlQuery, "##QUERYFIELDS##", "id, issuer_id, url, type, words, hash, created_at,version,title,description", 1)
+	_ = filter.OrderBy.Add(ports.SchemasCreatedAt, true)
+	sqlQuery += " ORDER BY " + filter.OrderBy.String()
+	if filter.Pagination.Page != nil {
+		sqlQuery += fmt.Sprintf(" OFFSET %d LIMIT %d", filter.Pagination.GetOffset(), filter.Pagination.GetLimit())
+	}
+
+	rows, err := r.conn.Pgx.Query(ctx, sqlQuery, sqlArgs...)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 	schemaCol := make([]domain.Schema, 0)
 	s := dbSchema{}
 	for rows.Next() {
 		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		item, err := toSchemaDomain(&s)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		schemaCol = append(schemaCol, *item)
 	}
-	return schemaCol, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Pagination.Page == nil {
+		count = uint(len(schemaCol))
+	}
+	return schemaCol, count, nil
 }
 
 // GetByID searches and returns an schema by id
@@ -19,6 +19,7 @@ import (
 	"github.com/wakeup-labs/issuer-node/internal/core/domain"
 	"github.com/wakeup-labs/issuer-node/internal/core/ports"
 	"github.com/wakeup-labs/issuer-node/internal/db/tests"
+	"github.com/wakeup-labs/issuer-node/internal/sqltools"
 )
 
 func TestGetSchema(t *testing.T) {
@@ -282,14 +283,41 @@ func TestGetAllFullTextSearch(t *testing.T) {
 		// TODO: Add partial like tests
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			collection, err := store.GetAll(ctx, *did, tc.query)
+			collection, total, err := store.GetAll(ctx, *did, ports.NewSchemasFilter(tc.query, nil, nil, nil, nil, nil))
 			require.NoError(t, err)
 			require.Len(t, collection, len(tc.expected.collection))
+			assert.Equal(t, uint(len(tc.expected.collection)), total)
 			for i := range collection {
 				assert.Equal(t, tc.expected.collection[i].Words, collection[i].Words)
 			}
 		})
 	}
+
+	t.Run("paginated and sorted by type", func(t *testing.T) {
+		orderBy := sqltools.OrderByFilters{}
+		require.NoError(t, orderBy.Add(ports.SchemasType, false))
+		collection, total, err := store.GetAll(ctx, *did, ports.NewSchemasFilter(nil, nil, nil, common.ToPointer(uint(1)), common.ToPointer(uint(1)), orderBy))
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), total)
+		require.Len(t, collection, 1)
+		assert.Equal(t, "age", collection[0].Type)
+
+		collection, total, err = store.GetAll(ctx, *did, ports.NewSchemasFilter(nil, nil, nil, common.ToPointer(uint(2)), common.ToPointer(uint(1)), orderBy))
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), total)
+		require.Len(t, collection, 1)
+		assert.Equal(t, "nicePeopleAtWork", collection[0].Type)
+	})
+
+	t.Run("filtered by creation time", func(t *testing.T) {
+		collection, _, err := store.GetAll(ctx, *did, ports.NewSchemasFilter(nil, common.ToPointer(time.Now().Add(time.Hour)), nil, nil, nil, nil))
+		require.NoError(t, err)
+		assert.Empty(t, collection)
+
+		collection, _, err = store.GetAll(ctx, *did, ports.NewSchemasFilter(nil, nil, common.ToPointer(time.Now().Add(time.Hour)), nil, nil, nil))
+		require.NoError(t, err)
+		assert.Len(t, collection, 2)
+	})
 }
 
 func insertSchemaGetAllData(t *testing.T, ctx context.Context, did w3c.DID, store ports.SchemaRepository) {
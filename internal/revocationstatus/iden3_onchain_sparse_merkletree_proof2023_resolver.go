@@ -17,3 +17,13 @@ func (r *iden3OnChainSparseMerkleTreeProof2023Resolver) resolve(credentialStatus
 		RevocationNonce: nonce,
 	}
 }
+
+// OnchainIdentityCredentialStatus returns the status of a credential issued by an on-chain identity contract.
+// The contract resolves the revocation status of its own credentials, so the status is not bound to a state.
+func OnchainIdentityCredentialStatus(issuerDID w3c.DID, nonce uint64, contractAddress ethcommon.Address, chainID string) *verifiable.CredentialStatus {
+	return &verifiable.CredentialStatus{
+		ID:              buildIden3OnchainSMTProofURL(issuerDID, nonce, contractAddress, chainID, ""),
+		Type:            verifiable.Iden3OnchainSparseMerkleTreeProof2023,
+		RevocationNonce: nonce,
+	}
+}
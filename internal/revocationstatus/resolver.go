@@ -3,6 +3,7 @@ package revocationstatus
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
@@ -34,6 +35,16 @@ func NewRevocationStatusResolver(networkResolver network.Resolver) *Resolver {
 	}
 }
 
+// CredentialStatusTypes returns the supported credential status types sorted by name
+func (rsr *Resolver) CredentialStatusTypes() []verifiable.CredentialStatusType {
+	types := make([]verifiable.CredentialStatusType, 0, len(rsr.resolvers))
+	for credentialStatusType := range rsr.resolvers {
+		types = append(types, credentialStatusType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
 // GetCredentialRevocationStatus - return a way to check credential revocation status.
 // If status is not supported, an error is returned.
 // If status is supported, a way to check revocation status is returned.
@@ -15,5 +15,9 @@ func buildRHSRevocationURL(host, issuerState string) string {
 }
 
 func buildIden3OnchainSMTProofURL(issuerDID w3c.DID, nonce uint64, contractAddress ethcommon.Address, chainID string, stateHex string) string {
-	return fmt.Sprintf("%s/credentialStatus?revocationNonce=%v&contractAddress=%s:%s&state=%s", issuerDID.String(), nonce, chainID, contractAddress.Hex(), stateHex)
+	url := fmt.Sprintf("%s/credentialStatus?revocationNonce=%v&contractAddress=%s:%s", issuerDID.String(), nonce, chainID, contractAddress.Hex())
+	if stateHex == "" {
+		return url
+	}
+	return fmt.Sprintf("%s&state=%s", url, stateHex)
 }
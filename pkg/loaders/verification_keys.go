@@ -0,0 +1,37 @@
+package loaders
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/iden3/go-circuits/v2"
+)
+
+const circuitVerificationKeyFile = "verification_key.json"
+
+// VerificationKeys loads the verification keys used to verify the proofs sent by the holders.
+// The key of a circuit is the verification_key.json file of its folder or, as the authV2 circuit is packaged, the <circuitID>.json file.
+type VerificationKeys struct {
+	basePath string
+}
+
+// NewVerificationKeys create loader that returns the verification keys of the circuits of basePath.
+func NewVerificationKeys(basePath string) *VerificationKeys {
+	return &VerificationKeys{basePath: basePath}
+}
+
+// Load verification key by circuit ID.
+func (l *VerificationKeys) Load(circuitID circuits.CircuitID) ([]byte, error) {
+	path := filepath.Join(l.basePath, string(circuitID), circuitVerificationKeyFile)
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, os.ErrNotExist) {
+		path = filepath.Join(l.basePath, string(circuitID), string(circuitID)+".json")
+		data, err = os.ReadFile(filepath.Clean(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed read verification key of circuit '%s' by path '%s': %v", circuitID, path, err)
+	}
+	return data, nil
+}
@@ -31,6 +31,8 @@ const connectionParser = getStrictParser<ConnectionInput, Connection>()(
     credentials: getListParser(credentialParser),
     id: z.string(),
     issuerID: z.string(),
+    metadata: z.record(z.string()),
+    tags: z.array(z.string()),
     userID: z.string(),
   })
 );
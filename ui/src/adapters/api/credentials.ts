@@ -11,14 +11,10 @@ import {
   messageParser,
   serializeSorters,
 } from "src/adapters/api";
-import {
-  datetimeParser,
-  getListParser,
-  getResourceParser,
-  getStrictParser,
-} from "src/adapters/parsers";
+import { datetimeParser, getResourceParser, getStrictParser } from "src/adapters/parsers";
 import {
   Credential,
+  CredentialDeliveryStatus,
   Env,
   IssuedMessage,
   Json,
@@ -27,12 +23,18 @@ import {
   ProofType,
   RefreshService,
 } from "src/domain";
-import { API_VERSION, QUERY_SEARCH_PARAM, STATUS_SEARCH_PARAM } from "src/utils/constants";
-import { List, Resource } from "src/utils/types";
+import {
+  API_VERSION,
+  DELIVERY_STATUS_SEARCH_PARAM,
+  QUERY_SEARCH_PARAM,
+  STATUS_SEARCH_PARAM,
+} from "src/utils/constants";
+import { Resource } from "src/utils/types";
 
 // Credentials
 
 type CredentialInput = Pick<Credential, "id" | "revoked" | "schemaHash"> & {
+  deliveryStatus?: CredentialDeliveryStatus | null;
   proofTypes: ProofType[];
   vc: {
     credentialSchema: {
@@ -53,6 +55,10 @@ type CredentialInput = Pick<Credential, "id" | "revoked" | "schemaHash"> & {
 export const credentialParser = getStrictParser<CredentialInput, Credential>()(
   z
     .object({
+      deliveryStatus: z
+        .union([z.literal("offered"), z.literal("fetched"), z.literal("acked")])
+        .nullable()
+        .default(null),
       id: z.string(),
       proofTypes: z.array(z.nativeEnum(ProofType)),
       revoked: z.boolean(),
@@ -81,6 +87,7 @@ export const credentialParser = getStrictParser<CredentialInput, Credential>()(
     })
     .transform(
       ({
+        deliveryStatus,
         id,
         proofTypes,
         revoked,
@@ -101,6 +108,7 @@ export const credentialParser = getStrictParser<CredentialInput, Credential>()(
 
         return {
           credentialSubject,
+          deliveryStatus,
           expirationDate,
           expired,
           id,
@@ -124,6 +132,10 @@ export const credentialStatusParser = getStrictParser<CredentialStatus>()(
   z.union([z.literal("all"), z.literal("revoked"), z.literal("expired")])
 );
 
+export const credentialDeliveryStatusParser = getStrictParser<CredentialDeliveryStatus>()(
+  z.union([z.literal("offered"), z.literal("fetched"), z.literal("acked")])
+);
+
 export async function getCredential({
   credentialID,
   env,
@@ -154,13 +166,14 @@ export async function getCredential({
 export async function getCredentials({
   env,
   identifier,
-  params: { credentialSubject, maxResults, page, query, sorters, status },
+  params: { credentialSubject, deliveryStatus, maxResults, page, query, sorters, status },
   signal,
 }: {
   env: Env;
   identifier: string;
   params: {
     credentialSubject?: string;
+    deliveryStatus?: CredentialDeliveryStatus;
     maxResults?: number;
     page?: number;
     query?: string;
@@ -180,6 +193,9 @@ export async function getCredentials({
         ...(credentialSubject !== undefined ? { credentialSubject } : {}),
         ...(query !== undefined ? { [QUERY_SEARCH_PARAM]: query } : {}),
         ...(status !== undefined && status !== "all" ? { [STATUS_SEARCH_PARAM]: status } : {}),
+        ...(deliveryStatus !== undefined
+          ? { [DELIVERY_STATUS_SEARCH_PARAM]: deliveryStatus }
+          : {}),
         ...(maxResults !== undefined ? { max_results: maxResults.toString() } : {}),
         ...(page !== undefined ? { page: page.toString() } : {}),
         ...(sorters !== undefined && sorters.length ? { sort: serializeSorters(sorters) } : {}),
@@ -335,20 +351,27 @@ export async function getLink({
   }
 }
 
+const linkSortFields: Partial<Record<keyof Link, string>> = {
+  expiration: "validUntil",
+};
+
 export async function getLinks({
   env,
   identifier,
-  params: { query, status },
+  params: { maxResults, page, query, sorters, status },
   signal,
 }: {
   env: Env;
   identifier: string;
   params: {
+    maxResults?: number;
+    page?: number;
     query?: string;
+    sorters?: Sorter[];
     status?: LinkStatus;
   };
   signal?: AbortSignal;
-}): Promise<Response<List<Link>>> {
+}): Promise<Response<Resource<Link>>> {
   try {
     const response = await axios({
       baseURL: env.api.url,
@@ -359,18 +382,23 @@ export async function getLinks({
       params: new URLSearchParams({
         ...(query !== undefined ? { [QUERY_SEARCH_PARAM]: query } : {}),
         ...(status !== undefined ? { [STATUS_SEARCH_PARAM]: status } : {}),
+        ...(maxResults !== undefined ? { max_results: maxResults.toString() } : {}),
+        ...(page !== undefined ? { page: page.toString() } : {}),
+        ...(sorters !== undefined && sorters.length
+          ? {
+              sort: serializeSorters(
+                sorters.map(({ field, order }) => ({
+                  field: linkSortFields[field as keyof Link] || field,
+                  order,
+                }))
+              ),
+            }
+          : {}),
       }),
       signal,
       url: `${API_VERSION}/identities/${identifier}/credentials/links`,
     });
-    return buildSuccessResponse(
-      getListParser(linkParser)
-        .transform(({ failed, successful }) => ({
-          failed,
-          successful: successful.sort((a, b) => b.createdAt.getTime() - a.createdAt.getTime()),
-        }))
-        .parse(response.data)
-    );
+    return buildSuccessResponse(getResourceParser(linkParser).parse(response.data));
   } catch (error) {
     return buildErrorResponse(error);
   }
@@ -6,6 +6,7 @@ import {
   Radio,
   RadioChangeEvent,
   Row,
+  Select,
   Space,
   Table,
   TableColumnsType,
@@ -18,7 +19,11 @@ import { useCallback, useEffect, useState } from "react";
 import { Link, generatePath, useNavigate, useSearchParams } from "react-router-dom";
 
 import { Sorter, parseSorters, serializeSorters } from "src/adapters/api";
-import { credentialStatusParser, getCredentials } from "src/adapters/api/credentials";
+import {
+  credentialDeliveryStatusParser,
+  credentialStatusParser,
+  getCredentials,
+} from "src/adapters/api/credentials";
 import { positiveIntegerFromStringParser } from "src/adapters/parsers";
 import { tableSorterParser } from "src/adapters/parsers/view";
 import IconCreditCardPlus from "src/assets/icons/credit-card-plus.svg?react";
@@ -34,7 +39,7 @@ import { NoResults } from "src/components/shared/NoResults";
 import { TableCard } from "src/components/shared/TableCard";
 import { useEnvContext } from "src/contexts/Env";
 import { useIdentityContext } from "src/contexts/Identity";
-import { AppError, Credential } from "src/domain";
+import { AppError, Credential, CredentialDeliveryStatus } from "src/domain";
 import { ROUTES } from "src/routes";
 import { AsyncTask, isAsyncTaskDataAvailable, isAsyncTaskStarting } from "src/utils/async";
 import { isAbortedError, makeRequestAbortable } from "src/utils/browser";
@@ -43,6 +48,8 @@ import {
   DEFAULT_PAGINATION_PAGE,
   DEFAULT_PAGINATION_TOTAL,
   DELETE,
+  DELIVERY,
+  DELIVERY_STATUS_SEARCH_PARAM,
   DETAILS,
   DOTS_DROPDOWN_WIDTH,
   EXPIRATION,
@@ -60,6 +67,12 @@ import {
 import { notifyParseError, notifyParseErrors } from "src/utils/error";
 import { formatDate } from "src/utils/forms";
 
+const DELIVERY_STATUS_LABELS: Record<CredentialDeliveryStatus, string> = {
+  acked: "Acknowledged",
+  fetched: "Fetched",
+  offered: "Not collected",
+};
+
 export function CredentialsTable() {
   const env = useEnvContext();
   const { identifier } = useIdentityContext();
@@ -75,6 +88,7 @@ export function CredentialsTable() {
   const [searchParams, setSearchParams] = useSearchParams();
 
   const statusParam = searchParams.get(STATUS_SEARCH_PARAM);
+  const deliveryStatusParam = searchParams.get(DELIVERY_STATUS_SEARCH_PARAM);
   const queryParam = searchParams.get(QUERY_SEARCH_PARAM);
   const paginationPageParam = searchParams.get(PAGINATION_PAGE_PARAM);
   const paginationMaxResultsParam = searchParams.get(PAGINATION_MAX_RESULTS_PARAM);
@@ -83,6 +97,10 @@ export function CredentialsTable() {
   const sorters = parseSorters(sortParam);
   const parsedStatusParam = credentialStatusParser.safeParse(statusParam);
   const credentialStatus = parsedStatusParam.success ? parsedStatusParam.data : "all";
+  const parsedDeliveryStatusParam = credentialDeliveryStatusParser.safeParse(deliveryStatusParam);
+  const deliveryStatus = parsedDeliveryStatusParam.success
+    ? parsedDeliveryStatusParam.data
+    : undefined;
   const paginationPageParsed = positiveIntegerFromStringParser.safeParse(paginationPageParam);
   const paginationMaxResultsParsed =
     positiveIntegerFromStringParser.safeParse(paginationMaxResultsParam);
@@ -162,6 +180,20 @@ export function CredentialsTable() {
       sortOrder: sorters.find(({ field }) => field === "revoked")?.order,
       title: REVOCATION,
     },
+    {
+      dataIndex: "deliveryStatus",
+      key: "deliveryStatus",
+      render: (deliveryStatus: Credential["deliveryStatus"]) =>
+        deliveryStatus ? (
+          <Tag color={deliveryStatus === "offered" ? "warning" : "success"}>
+            {DELIVERY_STATUS_LABELS[deliveryStatus]}
+          </Tag>
+        ) : (
+          "-"
+        ),
+      responsive: ["md"],
+      title: DELIVERY,
+    },
     {
       dataIndex: "id",
       key: "id",
@@ -255,6 +287,7 @@ export function CredentialsTable() {
           maxResults: paginationMaxResults,
           page: paginationPage,
           query: queryParam || undefined,
+          deliveryStatus,
           sorters: parseSorters(sortParam),
           status: credentialStatus,
         },
@@ -279,6 +312,7 @@ export function CredentialsTable() {
     },
     [
       credentialStatus,
+      deliveryStatus,
       env,
       paginationMaxResults,
       paginationPage,
@@ -325,6 +359,18 @@ export function CredentialsTable() {
     }
   };
 
+  const handleDeliveryStatusChange = (value?: CredentialDeliveryStatus) => {
+    const params = new URLSearchParams(searchParams);
+
+    if (value === undefined) {
+      params.delete(DELIVERY_STATUS_SEARCH_PARAM);
+    } else {
+      params.set(DELIVERY_STATUS_SEARCH_PARAM, value);
+    }
+
+    setSearchParams(params);
+  };
+
   useEffect(() => {
     const { aborter } = makeRequestAbortable(fetchCredentials);
 
@@ -407,14 +453,29 @@ export function CredentialsTable() {
               <Tag>{paginationTotal}</Tag>
             </Space>
 
-            {(!showDefaultContent || credentialStatus !== "all") && (
-              <Radio.Group onChange={handleStatusChange} value={credentialStatus}>
-                <Radio.Button value="all">All</Radio.Button>
+            {(!showDefaultContent || credentialStatus !== "all" || deliveryStatus) && (
+              <Space size="middle">
+                <Select
+                  allowClear
+                  onChange={handleDeliveryStatusChange}
+                  placeholder={DELIVERY}
+                  value={deliveryStatus}
+                >
+                  {Object.entries(DELIVERY_STATUS_LABELS).map(([value, label]) => (
+                    <Select.Option key={value} value={value}>
+                      {label}
+                    </Select.Option>
+                  ))}
+                </Select>
+
+                <Radio.Group onChange={handleStatusChange} value={credentialStatus}>
+                  <Radio.Button value="all">All</Radio.Button>
 
-                <Radio.Button value="revoked">Revoked</Radio.Button>
+                  <Radio.Button value="revoked">Revoked</Radio.Button>
 
-                <Radio.Button value="expired">Expired</Radio.Button>
-              </Radio.Group>
+                  <Radio.Button value="expired">Expired</Radio.Button>
+                </Radio.Group>
+              </Space>
             )}
           </Row>
         }
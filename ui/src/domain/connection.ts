@@ -6,5 +6,7 @@ export type Connection = {
   credentials: List<Credential>;
   id: string;
   issuerID: string;
+  metadata: Record<string, string>;
+  tags: string[];
   userID: string;
 };
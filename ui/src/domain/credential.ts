@@ -10,8 +10,11 @@ export type RefreshService = {
   type: "Iden3RefreshService2023";
 };
 
+export type CredentialDeliveryStatus = "offered" | "fetched" | "acked";
+
 export type Credential = {
   credentialSubject: Record<string, unknown>;
+  deliveryStatus: CredentialDeliveryStatus | null;
   expirationDate: Date | null;
   expired: boolean;
   id: string;
@@ -4,6 +4,7 @@ export type { Connection } from "src/domain/connection";
 
 export type {
   Credential,
+  CredentialDeliveryStatus,
   CredentialsTabIDs,
   IssuedMessage,
   Link,
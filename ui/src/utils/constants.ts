@@ -7,6 +7,7 @@ export const CONNECTIONS = "Connections";
 export const CREDENTIAL_LINK = "Credential link";
 export const CREDENTIALS = "Credentials";
 export const DELETE = "Delete";
+export const DELIVERY = "Delivery";
 export const DETAILS = "Details";
 export const ERROR_MESSAGE = "Something went wrong";
 export const EXPIRATION = "Expiration";
@@ -36,6 +37,7 @@ export const NOT_PUBLISHED_STATE = "State not published";
 export const FINALIZE_SETUP = "Finalize setup";
 
 // URL params
+export const DELIVERY_STATUS_SEARCH_PARAM = "deliveryStatus";
 export const DID_SEARCH_PARAM = "did";
 export const QUERY_SEARCH_PARAM = "query";
 export const SCHEMA_SEARCH_PARAM = "schema";